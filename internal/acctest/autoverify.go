@@ -0,0 +1,252 @@
+package acctest
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// envVarSkipAutoVerify disables the verification steps which Test and ParallelTest add to every test case.
+const envVarSkipAutoVerify = "TF_ACC_SKIP_AUTO_VERIFY"
+
+// autoImportException configures the automatic import step for a resource type that cannot be imported and verified by default.
+type autoImportException struct {
+	ignore []string // Attributes not compared with state, typically arguments that are not returned by the AWS API.
+	skip   bool     // Whether to skip the import step, e.g. when the resource's import ID is not its ID.
+}
+
+var (
+	// autoImportExceptions are keyed by resource type.
+	// Resources of other importable types are imported by their ID and compared with their state.
+	autoImportExceptions = map[string]autoImportException{
+		"aws_db_instance":                 {ignore: []string{"apply_immediately", "delete_automated_backups", "final_snapshot_identifier", "password", "skip_final_snapshot"}},
+		"aws_iam_group_policy_attachment": {skip: true},
+		"aws_iam_role":                    {ignore: []string{"force_detach_policies"}},
+		"aws_iam_role_policy_attachment":  {skip: true},
+		"aws_iam_user":                    {ignore: []string{"force_destroy"}},
+		"aws_iam_user_policy_attachment":  {skip: true},
+		"aws_kms_key":                     {ignore: []string{"bypass_policy_lockout_safety_check", "deletion_window_in_days"}},
+		"aws_lambda_function":             {ignore: []string{"filename", "publish"}},
+		"aws_lambda_permission":           {skip: true},
+		"aws_s3_bucket":                   {ignore: []string{"force_destroy"}},
+		"aws_secretsmanager_secret":       {ignore: []string{"force_overwrite_replica_secret", "recovery_window_in_days"}},
+	}
+
+	autoVerifySkippedTestsLock sync.Mutex
+	autoVerifySkippedTests     = make(map[string]struct{})
+)
+
+// SkipAutoVerify disables the automatic import and empty plan steps for the calling test.
+// It must be called before Test or ParallelTest.
+func SkipAutoVerify(t *testing.T) {
+	autoVerifySkippedTestsLock.Lock()
+	defer autoVerifySkippedTestsLock.Unlock()
+
+	autoVerifySkippedTests[t.Name()] = struct{}{}
+}
+
+func isAutoVerifyEnabled(t *testing.T) bool {
+	if os.Getenv(envVarSkipAutoVerify) != "" {
+		return false
+	}
+
+	autoVerifySkippedTestsLock.Lock()
+	defer autoVerifySkippedTestsLock.Unlock()
+
+	_, ok := autoVerifySkippedTests[t.Name()]
+
+	return !ok
+}
+
+// autoVerifySteps returns the test steps with verification steps added after each configuration step:
+//   - An empty plan step, which re-plans the configuration and expects no changes.
+//   - An import step for each managed resource in the configuration that supports import,
+//     which imports the resource and compares the result with its state.
+//     A resource that a step of the test case imports is imported in the same way as by that step,
+//     otherwise by its ID, as configured by its type's entry in autoImportExceptions.
+//
+// No steps are added after a step that expects an error or a non-empty plan, or is not a configuration step.
+// No import step is added for a resource that the next step already imports.
+func autoVerifySteps(steps []resource.TestStep) []resource.TestStep {
+	var result []resource.TestStep
+
+	importSteps := make(map[string]resource.TestStep)
+	for _, step := range steps {
+		if step.ImportState && step.ResourceName != "" && step.ExpectError == nil {
+			if _, ok := importSteps[step.ResourceName]; !ok {
+				importSteps[step.ResourceName] = step
+			}
+		}
+	}
+
+	for i, step := range steps {
+		result = append(result, step)
+
+		if !isAutoVerifiableStep(step) {
+			continue
+		}
+
+		result = append(result, resource.TestStep{
+			Config:                   step.Config,
+			ExternalProviders:        step.ExternalProviders,
+			PlanOnly:                 true,
+			ProtoV5ProviderFactories: step.ProtoV5ProviderFactories,
+			ProtoV6ProviderFactories: step.ProtoV6ProviderFactories,
+			ProviderFactories:        step.ProviderFactories,
+			SkipFunc:                 step.SkipFunc,
+		})
+
+		resourceAddresses, err := managedResourceAddresses(step.Config)
+
+		if err != nil {
+			// Leave the configuration error to be reported by the configuration step itself.
+			continue
+		}
+
+		for _, resourceAddress := range resourceAddresses {
+			if i+1 < len(steps) && steps[i+1].ImportState && steps[i+1].ResourceName == resourceAddress.name {
+				continue
+			}
+
+			importStep, ok := autoImportStep(resourceAddress, importSteps)
+
+			if !ok {
+				continue
+			}
+
+			importStep.Config = step.Config
+			importStep.ExternalProviders = step.ExternalProviders
+			importStep.ProtoV5ProviderFactories = step.ProtoV5ProviderFactories
+			importStep.ProtoV6ProviderFactories = step.ProtoV6ProviderFactories
+			importStep.ProviderFactories = step.ProviderFactories
+			importStep.SkipFunc = step.SkipFunc
+
+			result = append(result, importStep)
+		}
+	}
+
+	return result
+}
+
+func isAutoVerifiableStep(step resource.TestStep) bool {
+	return step.Config != "" &&
+		step.ExpectError == nil &&
+		!step.ExpectNonEmptyPlan &&
+		!step.PlanOnly &&
+		!step.Destroy &&
+		!step.ImportState &&
+		!step.RefreshState
+}
+
+// autoImportStep returns the import step for the specified resource, without its configuration,
+// and whether the resource is verified by the automatic import step.
+// The resource is imported and verified in the same way as by an existing import step of the test case,
+// otherwise by its ID, unless its type is excepted.
+func autoImportStep(resourceAddress resourceAddress, importSteps map[string]resource.TestStep) (resource.TestStep, bool) {
+	if v, ok := importSteps[resourceAddress.name]; ok {
+		return resource.TestStep{
+			ImportState:             true,
+			ImportStateCheck:        v.ImportStateCheck,
+			ImportStateId:           v.ImportStateId,
+			ImportStateIdFunc:       v.ImportStateIdFunc,
+			ImportStateIdPrefix:     v.ImportStateIdPrefix,
+			ImportStateVerify:       v.ImportStateVerify,
+			ImportStateVerifyIgnore: v.ImportStateVerifyIgnore,
+			ResourceName:            resourceAddress.name,
+		}, true
+	}
+
+	v := autoImportExceptions[resourceAddress.typeName]
+
+	if v.skip || !isImportableResourceType(resourceAddress.typeName) {
+		return resource.TestStep{}, false
+	}
+
+	return resource.TestStep{
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: v.ignore,
+		ResourceName:            resourceAddress.name,
+	}, true
+}
+
+type resourceAddress struct {
+	name     string // e.g. "aws_sqs_queue.test".
+	typeName string // e.g. "aws_sqs_queue".
+}
+
+// managedResourceAddresses returns the addresses of the managed resources declared in the root module of a configuration.
+// Resources which use count or for_each are not returned as their instance addresses are only known after apply.
+func managedResourceAddresses(config string) ([]resourceAddress, error) {
+	file, diags := hclsyntax.ParseConfig([]byte(config), "config.tf", hcl.InitialPos)
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	var resourceAddresses []resourceAddress
+
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "resource" || len(block.Labels) != 2 {
+			continue
+		}
+
+		if _, ok := block.Body.Attributes["count"]; ok {
+			continue
+		}
+
+		if _, ok := block.Body.Attributes["for_each"]; ok {
+			continue
+		}
+
+		resourceAddresses = append(resourceAddresses, resourceAddress{
+			name:     block.Labels[0] + "." + block.Labels[1],
+			typeName: block.Labels[0],
+		})
+	}
+
+	return resourceAddresses, nil
+}
+
+var (
+	importableResourceTypes     map[string]bool
+	importableResourceTypesOnce sync.Once
+)
+
+// isImportableResourceType returns whether the provider supports importing resources of the specified type.
+func isImportableResourceType(resourceType string) bool {
+	importableResourceTypesOnce.Do(func() {
+		importableResourceTypes = make(map[string]bool)
+
+		for typeName, r := range Provider.ResourcesMap {
+			importableResourceTypes[typeName] = r.Importer != nil
+		}
+
+		ctx := context.Background()
+
+		for _, sp := range Provider.Meta().(*conns.AWSClient).ServicePackages {
+			for _, factory := range sp.FrameworkResources(ctx) {
+				r, err := factory(ctx)
+
+				if err != nil {
+					continue
+				}
+
+				response := fwresource.MetadataResponse{}
+				r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+
+				_, ok := r.(fwresource.ResourceWithImportState)
+				importableResourceTypes[response.TypeName] = ok
+			}
+		}
+	})
+
+	return importableResourceTypes[resourceType]
+}
@@ -0,0 +1,300 @@
+package acctest_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAutoVerifySteps(t *testing.T) {
+	t.Parallel()
+
+	const queueConfig = `
+resource "aws_sqs_queue" "test" {
+  name = "test"
+}
+`
+	const queuePolicyConfig = `
+resource "aws_sqs_queue_policy" "test" {
+  queue_url = "https://sqs.us-west-2.amazonaws.com/123456789012/test"
+  policy    = "{}"
+}
+`
+
+	testCases := []struct {
+		Name     string
+		Steps    []resource.TestStep
+		Expected []string
+	}{
+		{
+			Name: "managed resources",
+			Steps: []resource.TestStep{
+				{
+					Config: queueConfig + `
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = "test"
+}
+
+resource "aws_sns_topic" "counted" {
+  count = 2
+}
+
+resource "aws_sns_topic" "iterated" {
+  for_each = toset(["a", "b"])
+}
+
+resource "null_resource" "test" {}
+
+module "test" {
+  source = "./test"
+}
+`,
+				},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+				"import aws_sqs_queue.test",
+				"import aws_s3_bucket.test ignore [force_destroy]",
+			},
+		},
+		{
+			Name: "multiple config steps",
+			Steps: []resource.TestStep{
+				{Config: queueConfig},
+				{Config: queueConfig},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+				"import aws_sqs_queue.test",
+				"config",
+				"plan",
+				"import aws_sqs_queue.test",
+			},
+		},
+		{
+			Name: "existing import step",
+			Steps: []resource.TestStep{
+				{Config: queueConfig},
+				{
+					ResourceName:      "aws_sqs_queue.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+				"import aws_sqs_queue.test",
+			},
+		},
+		{
+			Name: "expect non-empty plan",
+			Steps: []resource.TestStep{
+				{
+					Config:             queueConfig,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+			Expected: []string{
+				"config",
+			},
+		},
+		{
+			Name: "expect error",
+			Steps: []resource.TestStep{
+				{
+					Config:      queueConfig,
+					ExpectError: regexp.MustCompile(`error`),
+				},
+			},
+			Expected: []string{
+				"config",
+			},
+		},
+		{
+			Name: "plan only",
+			Steps: []resource.TestStep{
+				{
+					Config:   queueConfig,
+					PlanOnly: true,
+				},
+			},
+			Expected: []string{
+				"plan",
+			},
+		},
+		{
+			Name: "importable resource type",
+			Steps: []resource.TestStep{
+				{Config: queuePolicyConfig},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+				"import aws_sqs_queue_policy.test",
+			},
+		},
+		{
+			Name: "skipped resource type",
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "test"
+  policy_arn = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+}
+`,
+				},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+			},
+		},
+		{
+			Name: "skipped resource type with import step",
+			Steps: []resource.TestStep{
+				{
+					Config: `
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "test"
+  policy_arn = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+}
+`,
+				},
+				{
+					ResourceName:      "aws_iam_role_policy_attachment.test",
+					ImportState:       true,
+					ImportStateIdFunc: testAccAutoVerifyImportStateIdFunc("aws_iam_role_policy_attachment.test"),
+					ImportStateVerify: true,
+				},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+				"import aws_iam_role_policy_attachment.test id-func",
+			},
+		},
+		{
+			Name: "seeded from import step",
+			Steps: []resource.TestStep{
+				{Config: queuePolicyConfig},
+				{Config: queuePolicyConfig},
+				{
+					ResourceName:            "aws_sqs_queue_policy.test",
+					ImportState:             true,
+					ImportStateIdFunc:       testAccAutoVerifyImportStateIdFunc("aws_sqs_queue_policy.test"),
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"policy"},
+				},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+				"import aws_sqs_queue_policy.test id-func ignore [policy]",
+				"config",
+				"plan",
+				"import aws_sqs_queue_policy.test id-func ignore [policy]",
+			},
+		},
+		{
+			Name: "seeded from import step expecting error",
+			Steps: []resource.TestStep{
+				{Config: queueConfig},
+				{Config: queueConfig},
+				{
+					ResourceName:  "aws_sqs_queue.test",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`error`),
+				},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+				"import aws_sqs_queue.test",
+				"config",
+				"plan",
+				"import aws_sqs_queue.test id invalid",
+			},
+		},
+		{
+			Name: "invalid config",
+			Steps: []resource.TestStep{
+				{
+					Config: `resource "aws_sqs_queue" "test" {`,
+				},
+			},
+			Expected: []string{
+				"config",
+				"plan",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, step := range acctest.AutoVerifySteps(testCase.Steps) {
+				got = append(got, describeTestStep(step))
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func describeTestStep(step resource.TestStep) string {
+	switch {
+	case step.ImportState:
+		description := "import " + step.ResourceName
+
+		if step.ImportStateId != "" {
+			description += " id " + step.ImportStateId
+		}
+
+		if step.ImportStateIdFunc != nil {
+			description += " id-func"
+		}
+
+		if step.ImportStateIdPrefix != "" {
+			description += " id-prefix " + step.ImportStateIdPrefix
+		}
+
+		if len(step.ImportStateVerifyIgnore) > 0 {
+			description += fmt.Sprintf(" ignore [%s]", strings.Join(step.ImportStateVerifyIgnore, " "))
+		}
+
+		return description
+	case step.PlanOnly:
+		return "plan"
+	default:
+		return "config"
+	}
+}
+
+func testAccAutoVerifyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
@@ -1,6 +1,39 @@
 package acctest
 
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
 // Exports for use in tests only.
 var (
+	AutoVerifySteps  = autoVerifySteps
 	CloseVCRRecorder = closeVCRRecorder
+	VCRFormsEqual    = vcrFormsEqual
 )
+
+func NewVCRRetryer(retryer aws.Retryer) aws.Retryer {
+	return &vcrRetryer{Retryer: retryer}
+}
+
+// RedactVCRInteractions redacts the specified interactions as if they were all saved to the same cassette.
+func RedactVCRInteractions(accountID string, interactions ...*cassette.Interaction) error {
+	redactor := newVCRRedactor()
+	redactor.addLiteral(vcrRedactionRuleAccountID, accountID)
+
+	for _, i := range interactions {
+		if err := redactor.redactInteraction(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RedactLiveVCRRequest redacts a live request body as it is before comparison with a recorded request body during replay.
+func RedactLiveVCRRequest(recorded, live string) string {
+	redactor := newVCRRedactor()
+	redactor.addPlaceholders(recorded)
+
+	return redactor.redact(live)
+}
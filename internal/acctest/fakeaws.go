@@ -0,0 +1,150 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// PreCheckFakeAWS skips a unit test that runs against a fake AWS server if the Terraform CLI is not available locally.
+// The testing framework would otherwise attempt to download the Terraform CLI, which needs network access.
+// In continuous integration, where the CI environment variable is set, the test fails instead so that it can't be skipped unnoticed.
+func PreCheckFakeAWS(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		return
+	}
+
+	if _, err := exec.LookPath("terraform"); err != nil {
+		if os.Getenv("CI") != "" {
+			t.Fatal("Terraform CLI not found in PATH and TF_ACC_TERRAFORM_PATH not set")
+		}
+
+		t.Skip("skipping test; Terraform CLI not found in PATH and TF_ACC_TERRAFORM_PATH not set")
+	}
+}
+
+// FakeAWSProvider returns a provider configured to send all AWS API requests to the specified fake AWS server.
+// Use it in the checks of unit tests whose configurations use the server's ProviderConfig,
+// e.g. with CheckResourceDisappears or a TestCheckWithProviderFunc.
+func FakeAWSProvider(ctx context.Context, t *testing.T, s *fakeaws.Server) *schema.Provider {
+	t.Helper()
+
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"access_key":              fakeaws.AccessKey,
+		"emulator":                true,
+		"endpoint_url":            s.URL,
+		"region":                  fakeaws.DefaultRegion,
+		"secret_key":              fakeaws.SecretKey,
+		"skip_metadata_api_check": "true",
+	}))
+
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+// CheckFakeAWSCallsAtLeast verifies that the fake AWS server received at least the specified number of calls to an operation,
+// e.g. that a resource retried reads of a newly created resource hidden by fakeaws.WithEventualConsistency.
+func CheckFakeAWSCallsAtLeast(s *fakeaws.Server, service, operation string, n int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if got := s.Calls(service, operation); got < n {
+			return fmt.Errorf("%s %s called %d times, expected at least %d", service, operation, got, n)
+		}
+
+		return nil
+	}
+}
+
+// FakeAWSLifecycle describes a unit test of a resource's lifecycle against a fake AWS server, see FakeAWSLifecycleTest.
+type FakeAWSLifecycle struct {
+	// CheckDestroy verifies that the resource has been destroyed.
+	CheckDestroy TestCheckWithProviderFunc
+	// CheckExists returns a function that verifies that the resource exists using the specified provider.
+	CheckExists func(providerF func() *schema.Provider) resource.TestCheckFunc
+	// Config and UpdateConfig are the resource's configurations before and after update, without the provider configuration.
+	Config       string
+	UpdateConfig string
+	// Checks and UpdateChecks verify the resource's state after create and after update.
+	Checks       []resource.TestCheckFunc
+	UpdateChecks []resource.TestCheckFunc
+	// ImportStateVerifyIgnore are the attributes not compared with state after import.
+	ImportStateVerifyIgnore []string
+	// ReadService and ReadOperation, if set, are the service and API operation that read the resource.
+	// The server then hides the new resource from its first reads, and the test verifies that Create retried the read.
+	ReadService   string
+	ReadOperation string
+	// Resource is the resource that is deleted outside Terraform to verify that its removal is detected.
+	Resource *schema.Resource
+	// ResourceName is the address of the resource, e.g. "aws_sqs_queue.test".
+	ResourceName string
+}
+
+// fakeAWSHiddenReads is the number of reads that a new resource is hidden from when FakeAWSLifecycle.ReadOperation is set.
+const fakeAWSHiddenReads = 2
+
+// FakeAWSLifecycleTest runs a unit test against a fake AWS server that creates, imports, updates and then
+// deletes a resource outside Terraform, expecting the resource's removal to be planned.
+func FakeAWSLifecycleTest(ctx context.Context, t *testing.T, c FakeAWSLifecycle) {
+	t.Helper()
+
+	var optFns []fakeaws.OptionsFunc
+	if c.ReadOperation != "" {
+		optFns = append(optFns, fakeaws.WithEventualConsistency(fakeAWSHiddenReads))
+	}
+
+	s := fakeaws.NewTestServer(t, optFns...)
+	p := FakeAWSProvider(ctx, t, s)
+	providerF := func() *schema.Provider { return p }
+
+	checks := []resource.TestCheckFunc{c.CheckExists(providerF)}
+	if c.ReadOperation != "" {
+		checks = append(checks, CheckFakeAWSCallsAtLeast(s, c.ReadService, c.ReadOperation, fakeAWSHiddenReads+1))
+	}
+	checks = append(checks, c.Checks...)
+
+	updateChecks := append([]resource.TestCheckFunc{c.CheckExists(providerF)}, c.UpdateChecks...)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { PreCheckFakeAWS(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories,
+		CheckDestroy:             CheckWithProviders(c.CheckDestroy, &[]*schema.Provider{p}),
+		Steps: []resource.TestStep{
+			{
+				Config: s.ProviderConfig() + c.Config,
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+			{
+				ResourceName:            c.ResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: c.ImportStateVerifyIgnore,
+			},
+			{
+				Config: s.ProviderConfig() + c.UpdateConfig,
+				Check:  resource.ComposeTestCheckFunc(updateChecks...),
+			},
+			{
+				Config:             s.ProviderConfig() + c.UpdateConfig,
+				Check:              CheckResourceDisappears(ctx, p, c.Resource, c.ResourceName),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
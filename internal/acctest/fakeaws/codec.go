@@ -0,0 +1,411 @@
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	privateprotocol "github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+)
+
+// The AWS SDK for Go only implements the client side of each protocol.
+// decodeQuery and encodeXML are the server-side counterparts of its Query request serializer and XML response deserializer.
+
+// decodeQuery decodes Query protocol parameters into the input shape v.
+func decodeQuery(form url.Values, v interface{}) error {
+	return decodeQueryValue(form, reflect.ValueOf(v).Elem(), "", "")
+}
+
+func decodeQueryValue(form url.Values, v reflect.Value, prefix string, tag reflect.StructTag) error {
+	switch shapeType(v.Type(), tag) {
+	case "structure":
+		if prefix != "" && !hasPrefix(form, prefix+".") {
+			return nil
+		}
+
+		if v.Kind() == reflect.Ptr {
+			v.Set(reflect.New(v.Type().Elem()))
+			v = v.Elem()
+		}
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			name := queryName(field)
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+
+			if err := decodeQueryValue(form, v.Field(i), name, field.Tag); err != nil {
+				return err
+			}
+		}
+
+	case "list":
+		if _, ok := form[prefix]; ok {
+			// An empty list.
+			v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+			return nil
+		}
+
+		if tag.Get("flattened") == "" {
+			if listName := tag.Get("locationNameList"); listName != "" {
+				prefix += "." + listName
+			} else {
+				prefix += ".member"
+			}
+		}
+
+		for i := 1; ; i++ {
+			itemPrefix := prefix + "." + strconv.Itoa(i)
+
+			if _, ok := form[itemPrefix]; !ok && !hasPrefix(form, itemPrefix+".") {
+				break
+			}
+
+			item := reflect.New(v.Type().Elem()).Elem()
+
+			if err := decodeQueryValue(form, item, itemPrefix, ""); err != nil {
+				return err
+			}
+
+			v.Set(reflect.Append(v, item))
+		}
+
+	case "map":
+		if _, ok := form[prefix]; ok {
+			// An empty map.
+			v.Set(reflect.MakeMap(v.Type()))
+			return nil
+		}
+
+		if tag.Get("flattened") == "" {
+			prefix += ".entry"
+		}
+
+		kname, vname := "key", "value"
+		if n := tag.Get("locationNameKey"); n != "" {
+			kname = n
+		}
+		if n := tag.Get("locationNameValue"); n != "" {
+			vname = n
+		}
+
+		for i := 1; ; i++ {
+			entryPrefix := prefix + "." + strconv.Itoa(i)
+			key, ok := form[entryPrefix+"."+kname]
+
+			if !ok {
+				break
+			}
+
+			if v.IsNil() {
+				v.Set(reflect.MakeMap(v.Type()))
+			}
+
+			value := reflect.New(v.Type().Elem()).Elem()
+
+			if err := decodeQueryValue(form, value, entryPrefix+"."+vname, ""); err != nil {
+				return err
+			}
+
+			v.SetMapIndex(reflect.ValueOf(key[0]), value)
+		}
+
+	default:
+		values, ok := form[prefix]
+
+		if !ok {
+			return nil
+		}
+
+		return decodeScalar(v, values[0], tag)
+	}
+
+	return nil
+}
+
+func decodeScalar(v reflect.Value, s string, tag reflect.StructTag) error {
+	switch v.Interface().(type) {
+	case *string:
+		v.Set(reflect.ValueOf(&s))
+	case []byte:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(b))
+	case *bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&b))
+	case *int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&n))
+	case *float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&f))
+	case *time.Time:
+		format := tag.Get("timestampFormat")
+		if format == "" {
+			format = privateprotocol.ISO8601TimeFormatName
+		}
+		t, err := privateprotocol.ParseTime(format, s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&t))
+	default:
+		return fmt.Errorf("unsupported type: %s", v.Type())
+	}
+
+	return nil
+}
+
+// queryName returns the name of a structure member in Query protocol parameters.
+func queryName(field reflect.StructField) string {
+	if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+		return field.Tag.Get("locationNameList")
+	}
+
+	if name := field.Tag.Get("locationName"); name != "" {
+		return name
+	}
+
+	return field.Name
+}
+
+func hasPrefix(form url.Values, prefix string) bool {
+	for k := range form {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shapeType returns the type of a shape: "structure", "list", "map" or "" for scalars.
+func shapeType(t reflect.Type, tag reflect.StructTag) string {
+	if v := tag.Get("type"); v == "structure" || v == "list" || v == "map" {
+		return v
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if t != reflect.TypeOf(time.Time{}) {
+			return "structure"
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() != reflect.Uint8 {
+			return "list"
+		}
+	case reflect.Map:
+		return "map"
+	}
+
+	return ""
+}
+
+// encodeXML writes the output shape v as the XML element name.
+func encodeXML(w io.Writer, name string, v interface{}) error {
+	e := xml.NewEncoder(w)
+
+	if err := encodeXMLValue(e, name, reflect.ValueOf(v), ""); err != nil {
+		return err
+	}
+
+	return e.Flush()
+}
+
+func encodeXMLValue(e *xml.Encoder, name string, v reflect.Value, tag reflect.StructTag) error {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+	}
+
+	if tag.Get("location") != "" || tag.Get("xmlAttribute") != "" {
+		return nil
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+
+	switch shapeType(v.Type(), tag) {
+	case "structure":
+		v = reflect.Indirect(v)
+		t := v.Type()
+
+		// Members serialized as XML attributes.
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if field.PkgPath != "" || field.Tag.Get("xmlAttribute") == "" || v.Field(i).IsNil() {
+				continue
+			}
+
+			attrName := field.Tag.Get("locationName")
+			if prefix, _, ok := strings.Cut(attrName, ":"); ok && prefix == "xsi" {
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: "http://www.w3.org/2001/XMLSchema-instance"})
+			}
+
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: attrName}, Value: fmt.Sprint(reflect.Indirect(v.Field(i)).Interface())})
+		}
+
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			if err := encodeXMLValue(e, queryName(field), v.Field(i), field.Tag); err != nil {
+				return err
+			}
+		}
+
+		return e.EncodeToken(start.End())
+
+	case "list":
+		if v.IsNil() {
+			return nil
+		}
+
+		if tag.Get("flattened") != "" {
+			for i := 0; i < v.Len(); i++ {
+				if err := encodeXMLValue(e, name, v.Index(i), ""); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		memberName := "member"
+		if n := tag.Get("locationNameList"); n != "" {
+			memberName = n
+		}
+
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			if err := encodeXMLValue(e, memberName, v.Index(i), ""); err != nil {
+				return err
+			}
+		}
+
+		return e.EncodeToken(start.End())
+
+	case "map":
+		if v.IsNil() {
+			return nil
+		}
+
+		kname, vname := "key", "value"
+		if n := tag.Get("locationNameKey"); n != "" {
+			kname = n
+		}
+		if n := tag.Get("locationNameValue"); n != "" {
+			vname = n
+		}
+
+		var keys []string
+		for _, k := range v.MapKeys() {
+			keys = append(keys, k.String())
+		}
+		sort.Strings(keys)
+
+		flattened := tag.Get("flattened") != ""
+
+		if !flattened {
+			if err := e.EncodeToken(start); err != nil {
+				return err
+			}
+		}
+
+		for _, k := range keys {
+			entryName := "entry"
+			if flattened {
+				entryName = name
+			}
+
+			entry := xml.StartElement{Name: xml.Name{Local: entryName}}
+
+			if err := e.EncodeToken(entry); err != nil {
+				return err
+			}
+			if err := e.EncodeElement(k, xml.StartElement{Name: xml.Name{Local: kname}}); err != nil {
+				return err
+			}
+			if err := encodeXMLValue(e, vname, v.MapIndex(reflect.ValueOf(k)), ""); err != nil {
+				return err
+			}
+			if err := e.EncodeToken(entry.End()); err != nil {
+				return err
+			}
+		}
+
+		if !flattened {
+			return e.EncodeToken(start.End())
+		}
+
+		return nil
+
+	default:
+		var s string
+
+		switch value := reflect.Indirect(v).Interface().(type) {
+		case []byte:
+			s = base64.StdEncoding.EncodeToString(value)
+		case time.Time:
+			format := tag.Get("timestampFormat")
+			if format == "" {
+				format = privateprotocol.ISO8601TimeFormatName
+			}
+			s = privateprotocol.FormatTime(format, value)
+		case float64:
+			s = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			s = fmt.Sprint(value)
+		}
+
+		return e.EncodeElement(s, start)
+	}
+}
+
+// decodeXML decodes a REST-XML request body into the input shape v.
+func decodeXML(body []byte, v interface{}) error {
+	return xmlutil.UnmarshalXML(v, xml.NewDecoder(bytes.NewReader(body)), "")
+}
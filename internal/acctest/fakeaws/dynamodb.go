@@ -0,0 +1,543 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type dynamoDBService struct {
+	server *Server
+	tables *store[dynamoDBTable]
+}
+
+type dynamoDBTable struct {
+	continuousBackups *dynamodb.ContinuousBackupsDescription
+	description       *dynamodb.TableDescription
+	items             map[string]map[string]*dynamodb.AttributeValue
+	tags              tags
+	timeToLive        *dynamodb.TimeToLiveDescription
+}
+
+func newDynamoDBService(server *Server) *service {
+	s := &dynamoDBService{
+		server: server,
+		tables: newStore[dynamoDBTable](),
+	}
+
+	return &service{
+		name:         names.DynamoDB,
+		protocol:     protocolJSON,
+		jsonVersion:  "1.0",
+		targetPrefix: "DynamoDB_20120810",
+		operations: map[string]handlerFunc{
+			"CreateTable":                         handle(s.createTable),
+			"DeleteItem":                          handle(s.deleteItem),
+			"DeleteTable":                         handle(s.deleteTable),
+			"DescribeContinuousBackups":           handle(s.describeContinuousBackups),
+			"DescribeContributorInsights":         handle(s.describeContributorInsights),
+			"DescribeKinesisStreamingDestination": handle(s.describeKinesisStreamingDestination),
+			"DescribeTable":                       handle(s.describeTable),
+			"DescribeTimeToLive":                  handle(s.describeTimeToLive),
+			"GetItem":                             handle(s.getItem),
+			"ListTables":                          handle(s.listTables),
+			"ListTagsOfResource":                  handle(s.listTagsOfResource),
+			"PutItem":                             handle(s.putItem),
+			"TagResource":                         handle(s.tagResource),
+			"UntagResource":                       handle(s.untagResource),
+			"UpdateContinuousBackups":             handle(s.updateContinuousBackups),
+			"UpdateTable":                         handle(s.updateTable),
+			"UpdateTimeToLive":                    handle(s.updateTimeToLive),
+		},
+	}
+}
+
+func (s *dynamoDBService) createTable(r *request, input *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+	name := aws.StringValue(input.TableName)
+
+	if s.tables.exists(name) {
+		return nil, newAPIError(http.StatusBadRequest, dynamodb.ErrCodeResourceInUseException, fmt.Sprintf("Table already exists: %s", name))
+	}
+
+	arn := r.arn("dynamodb", r.region, "table/"+name)
+	billingMode := aws.StringValue(input.BillingMode)
+	if billingMode == "" {
+		billingMode = dynamodb.BillingModeProvisioned
+	}
+
+	description := &dynamodb.TableDescription{
+		AttributeDefinitions: input.AttributeDefinitions,
+		BillingModeSummary: &dynamodb.BillingModeSummary{
+			BillingMode: aws.String(billingMode),
+		},
+		CreationDateTime:      aws.Time(time.Now()),
+		ItemCount:             aws.Int64(0),
+		KeySchema:             input.KeySchema,
+		ProvisionedThroughput: dynamoDBProvisionedThroughputDescription(input.ProvisionedThroughput),
+		TableArn:              aws.String(arn),
+		TableId:               aws.String(fmt.Sprintf("%08d-0000-0000-0000-000000000000", r.server.nextID())),
+		TableName:             aws.String(name),
+		TableSizeBytes:        aws.Int64(0),
+		TableStatus:           aws.String(dynamodb.TableStatusActive),
+	}
+
+	for _, v := range input.GlobalSecondaryIndexes {
+		description.GlobalSecondaryIndexes = append(description.GlobalSecondaryIndexes, &dynamodb.GlobalSecondaryIndexDescription{
+			IndexArn:              aws.String(arn + "/index/" + aws.StringValue(v.IndexName)),
+			IndexName:             v.IndexName,
+			IndexStatus:           aws.String(dynamodb.IndexStatusActive),
+			KeySchema:             v.KeySchema,
+			Projection:            v.Projection,
+			ProvisionedThroughput: dynamoDBProvisionedThroughputDescription(v.ProvisionedThroughput),
+		})
+	}
+
+	for _, v := range input.LocalSecondaryIndexes {
+		description.LocalSecondaryIndexes = append(description.LocalSecondaryIndexes, &dynamodb.LocalSecondaryIndexDescription{
+			IndexArn:   aws.String(arn + "/index/" + aws.StringValue(v.IndexName)),
+			IndexName:  v.IndexName,
+			KeySchema:  v.KeySchema,
+			Projection: v.Projection,
+		})
+	}
+
+	if v := input.SSESpecification; v != nil && aws.BoolValue(v.Enabled) {
+		description.SSEDescription = dynamoDBSSEDescription(r, v)
+	}
+
+	if v := input.StreamSpecification; v != nil && aws.BoolValue(v.StreamEnabled) {
+		description.LatestStreamArn = aws.String(fmt.Sprintf("%s/stream/%s", arn, time.Now().UTC().Format("2006-01-02T15:04:05.000")))
+		description.LatestStreamLabel = aws.String(time.Now().UTC().Format("2006-01-02T15:04:05.000"))
+		description.StreamSpecification = v
+	}
+
+	if v := input.TableClass; v != nil {
+		description.TableClassSummary = &dynamodb.TableClassSummary{
+			TableClass: v,
+		}
+	}
+
+	table := &dynamoDBTable{
+		continuousBackups: &dynamodb.ContinuousBackupsDescription{
+			ContinuousBackupsStatus: aws.String(dynamodb.ContinuousBackupsStatusEnabled),
+			PointInTimeRecoveryDescription: &dynamodb.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus: aws.String(dynamodb.PointInTimeRecoveryStatusDisabled),
+			},
+		},
+		description: description,
+		items:       make(map[string]map[string]*dynamodb.AttributeValue),
+		timeToLive: &dynamodb.TimeToLiveDescription{
+			TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled),
+		},
+	}
+
+	for _, tag := range input.Tags {
+		table.tags = table.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	s.tables.create(name, table, r.server.options.EventualConsistencyReads)
+
+	return &dynamodb.CreateTableOutput{
+		TableDescription: description,
+	}, nil
+}
+
+func (s *dynamoDBService) describeTable(r *request, input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.DescribeTableOutput{
+		Table: table.description,
+	}, nil
+}
+
+func (s *dynamoDBService) updateTable(r *request, input *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	description := table.description
+
+	if v := input.AttributeDefinitions; len(v) > 0 {
+		description.AttributeDefinitions = v
+	}
+
+	if v := input.BillingMode; v != nil {
+		description.BillingModeSummary = &dynamodb.BillingModeSummary{
+			BillingMode:                       v,
+			LastUpdateToPayPerRequestDateTime: aws.Time(time.Now()),
+		}
+	}
+
+	if v := input.ProvisionedThroughput; v != nil {
+		description.ProvisionedThroughput = dynamoDBProvisionedThroughputDescription(v)
+	}
+
+	if v := input.SSESpecification; v != nil {
+		if aws.BoolValue(v.Enabled) {
+			description.SSEDescription = dynamoDBSSEDescription(r, v)
+		} else {
+			description.SSEDescription = nil
+		}
+	}
+
+	if v := input.StreamSpecification; v != nil {
+		if aws.BoolValue(v.StreamEnabled) {
+			description.LatestStreamArn = aws.String(fmt.Sprintf("%s/stream/%s", aws.StringValue(description.TableArn), time.Now().UTC().Format("2006-01-02T15:04:05.000")))
+			description.LatestStreamLabel = aws.String(time.Now().UTC().Format("2006-01-02T15:04:05.000"))
+			description.StreamSpecification = v
+		} else {
+			description.StreamSpecification = nil
+		}
+	}
+
+	if v := input.TableClass; v != nil {
+		description.TableClassSummary = &dynamodb.TableClassSummary{
+			LastUpdateDateTime: aws.Time(time.Now()),
+			TableClass:         v,
+		}
+	}
+
+	for _, update := range input.GlobalSecondaryIndexUpdates {
+		switch {
+		case update.Create != nil:
+			v := update.Create
+			description.GlobalSecondaryIndexes = append(description.GlobalSecondaryIndexes, &dynamodb.GlobalSecondaryIndexDescription{
+				IndexArn:              aws.String(aws.StringValue(description.TableArn) + "/index/" + aws.StringValue(v.IndexName)),
+				IndexName:             v.IndexName,
+				IndexStatus:           aws.String(dynamodb.IndexStatusActive),
+				KeySchema:             v.KeySchema,
+				Projection:            v.Projection,
+				ProvisionedThroughput: dynamoDBProvisionedThroughputDescription(v.ProvisionedThroughput),
+			})
+		case update.Update != nil:
+			for _, gsi := range description.GlobalSecondaryIndexes {
+				if aws.StringValue(gsi.IndexName) == aws.StringValue(update.Update.IndexName) {
+					gsi.ProvisionedThroughput = dynamoDBProvisionedThroughputDescription(update.Update.ProvisionedThroughput)
+				}
+			}
+		case update.Delete != nil:
+			var gsis []*dynamodb.GlobalSecondaryIndexDescription
+			for _, gsi := range description.GlobalSecondaryIndexes {
+				if aws.StringValue(gsi.IndexName) != aws.StringValue(update.Delete.IndexName) {
+					gsis = append(gsis, gsi)
+				}
+			}
+			description.GlobalSecondaryIndexes = gsis
+		}
+	}
+
+	return &dynamodb.UpdateTableOutput{
+		TableDescription: description,
+	}, nil
+}
+
+func (s *dynamoDBService) deleteTable(r *request, input *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.tables.delete(aws.StringValue(input.TableName))
+
+	description := *table.description
+	description.TableStatus = aws.String(dynamodb.TableStatusDeleting)
+
+	return &dynamodb.DeleteTableOutput{
+		TableDescription: &description,
+	}, nil
+}
+
+func (s *dynamoDBService) listTables(r *request, input *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	output := &dynamodb.ListTablesOutput{
+		TableNames: []*string{},
+	}
+
+	for _, table := range s.tables.list() {
+		output.TableNames = append(output.TableNames, table.description.TableName)
+	}
+
+	return output, nil
+}
+
+func (s *dynamoDBService) describeContinuousBackups(r *request, input *dynamodb.DescribeContinuousBackupsInput) (*dynamodb.DescribeContinuousBackupsOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, dynamoDBTableNotFoundError(dynamodb.ErrCodeTableNotFoundException, input.TableName)
+	}
+
+	return &dynamodb.DescribeContinuousBackupsOutput{
+		ContinuousBackupsDescription: table.continuousBackups,
+	}, nil
+}
+
+func (s *dynamoDBService) updateContinuousBackups(r *request, input *dynamodb.UpdateContinuousBackupsInput) (*dynamodb.UpdateContinuousBackupsOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, dynamoDBTableNotFoundError(dynamodb.ErrCodeTableNotFoundException, input.TableName)
+	}
+
+	status := dynamodb.PointInTimeRecoveryStatusDisabled
+	if v := input.PointInTimeRecoverySpecification; v != nil && aws.BoolValue(v.PointInTimeRecoveryEnabled) {
+		status = dynamodb.PointInTimeRecoveryStatusEnabled
+	}
+
+	table.continuousBackups.PointInTimeRecoveryDescription = &dynamodb.PointInTimeRecoveryDescription{
+		PointInTimeRecoveryStatus: aws.String(status),
+	}
+
+	return &dynamodb.UpdateContinuousBackupsOutput{
+		ContinuousBackupsDescription: table.continuousBackups,
+	}, nil
+}
+
+func (s *dynamoDBService) describeTimeToLive(r *request, input *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.DescribeTimeToLiveOutput{
+		TimeToLiveDescription: table.timeToLive,
+	}, nil
+}
+
+func (s *dynamoDBService) updateTimeToLive(r *request, input *dynamodb.UpdateTimeToLiveInput) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	specification := input.TimeToLiveSpecification
+
+	if aws.BoolValue(specification.Enabled) {
+		table.timeToLive = &dynamodb.TimeToLiveDescription{
+			AttributeName:    specification.AttributeName,
+			TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusEnabled),
+		}
+	} else {
+		table.timeToLive = &dynamodb.TimeToLiveDescription{
+			TimeToLiveStatus: aws.String(dynamodb.TimeToLiveStatusDisabled),
+		}
+	}
+
+	return &dynamodb.UpdateTimeToLiveOutput{
+		TimeToLiveSpecification: specification,
+	}, nil
+}
+
+func (s *dynamoDBService) describeContributorInsights(r *request, input *dynamodb.DescribeContributorInsightsInput) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	if _, err := s.findTable(input.TableName); err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.DescribeContributorInsightsOutput{
+		ContributorInsightsStatus: aws.String(dynamodb.ContributorInsightsStatusDisabled),
+		IndexName:                 input.IndexName,
+		TableName:                 input.TableName,
+	}, nil
+}
+
+func (s *dynamoDBService) describeKinesisStreamingDestination(r *request, input *dynamodb.DescribeKinesisStreamingDestinationInput) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
+	if _, err := s.findTable(input.TableName); err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.DescribeKinesisStreamingDestinationOutput{
+		KinesisDataStreamDestinations: []*dynamodb.KinesisDataStreamDestination{},
+		TableName:                     input.TableName,
+	}, nil
+}
+
+func (s *dynamoDBService) tagResource(r *request, input *dynamodb.TagResourceInput) (*dynamodb.TagResourceOutput, error) {
+	table, err := s.findTableByARN(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		table.tags = table.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	return &dynamodb.TagResourceOutput{}, nil
+}
+
+func (s *dynamoDBService) untagResource(r *request, input *dynamodb.UntagResourceInput) (*dynamodb.UntagResourceOutput, error) {
+	table, err := s.findTableByARN(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &dynamodb.UntagResourceOutput{}, nil
+}
+
+func (s *dynamoDBService) listTagsOfResource(r *request, input *dynamodb.ListTagsOfResourceInput) (*dynamodb.ListTagsOfResourceOutput, error) {
+	table, err := s.findTableByARN(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &dynamodb.ListTagsOfResourceOutput{
+		Tags: []*dynamodb.Tag{},
+	}
+
+	for _, k := range sortedKeys(table.tags) {
+		output.Tags = append(output.Tags, &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(table.tags[k]),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *dynamoDBService) putItem(r *request, input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Item)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.items[key] = input.Item
+	table.description.ItemCount = aws.Int64(int64(len(table.items)))
+
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (s *dynamoDBService) getItem(r *request, input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamodb.GetItemOutput{
+		Item: table.items[key],
+	}, nil
+}
+
+func (s *dynamoDBService) deleteItem(r *request, input *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	table, err := s.findTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(table.items, key)
+	table.description.ItemCount = aws.Int64(int64(len(table.items)))
+
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func (s *dynamoDBService) findTable(name *string) (*dynamoDBTable, error) {
+	table, ok := s.tables.get(aws.StringValue(name))
+
+	if !ok {
+		return nil, dynamoDBTableNotFoundError(dynamodb.ErrCodeResourceNotFoundException, name)
+	}
+
+	return table, nil
+}
+
+func (s *dynamoDBService) findTableByARN(arn *string) (*dynamoDBTable, error) {
+	for name, table := range s.tables.items {
+		if aws.StringValue(table.description.TableArn) == aws.StringValue(arn) {
+			return s.findTable(aws.String(name))
+		}
+	}
+
+	return nil, newAPIError(http.StatusBadRequest, dynamodb.ErrCodeResourceNotFoundException, fmt.Sprintf("Requested resource not found: ResourceArn: %s not found", aws.StringValue(arn)))
+}
+
+// itemKey returns a string uniquely identifying an item by the values of its key attributes.
+func (t *dynamoDBTable) itemKey(item map[string]*dynamodb.AttributeValue) (string, error) {
+	var values []*dynamodb.AttributeValue
+
+	for _, element := range t.description.KeySchema {
+		v, ok := item[aws.StringValue(element.AttributeName)]
+
+		if !ok {
+			return "", newAPIError(http.StatusBadRequest, "ValidationException", "One of the required keys was not given a value")
+		}
+
+		values = append(values, v)
+	}
+
+	b, err := json.Marshal(values)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func dynamoDBProvisionedThroughputDescription(v *dynamodb.ProvisionedThroughput) *dynamodb.ProvisionedThroughputDescription {
+	description := &dynamodb.ProvisionedThroughputDescription{
+		NumberOfDecreasesToday: aws.Int64(0),
+		ReadCapacityUnits:      aws.Int64(0),
+		WriteCapacityUnits:     aws.Int64(0),
+	}
+
+	if v != nil {
+		description.ReadCapacityUnits = v.ReadCapacityUnits
+		description.WriteCapacityUnits = v.WriteCapacityUnits
+	}
+
+	return description
+}
+
+func dynamoDBSSEDescription(r *request, v *dynamodb.SSESpecification) *dynamodb.SSEDescription {
+	kmsKeyARN := aws.StringValue(v.KMSMasterKeyId)
+	if kmsKeyARN == "" {
+		kmsKeyARN = r.arn("kms", r.region, "alias/aws/dynamodb")
+	}
+
+	return &dynamodb.SSEDescription{
+		KMSMasterKeyArn: aws.String(kmsKeyARN),
+		SSEType:         aws.String(dynamodb.SSETypeKms),
+		Status:          aws.String(dynamodb.SSEStatusEnabled),
+	}
+}
+
+func dynamoDBTableNotFoundError(code string, name *string) *apiError {
+	return newAPIError(http.StatusBadRequest, code, fmt.Sprintf("Requested resource not found: Table: %s not found", aws.StringValue(name)))
+}
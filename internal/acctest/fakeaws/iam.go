@@ -0,0 +1,705 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type iamService struct {
+	policies *store[iamPolicy]
+	roles    *store[iamRole]
+	server   *Server
+}
+
+type iamRole struct {
+	attachedPolicyARNs []string
+	inlinePolicies     map[string]string
+	role               *iam.Role
+	tags               tags
+}
+
+type iamPolicy struct {
+	nextVersion int
+	policy      *iam.Policy
+	tags        tags
+	versions    map[string]*iam.PolicyVersion
+}
+
+func newIAMService(server *Server) *service {
+	s := &iamService{
+		policies: newStore[iamPolicy](),
+		roles:    newStore[iamRole](),
+		server:   server,
+	}
+
+	return &service{
+		name:     names.IAM,
+		protocol: protocolQuery,
+		xmlns:    "https://iam.amazonaws.com/doc/2010-05-08/",
+		operations: map[string]handlerFunc{
+			"AttachRolePolicy":              handle(s.attachRolePolicy),
+			"CreatePolicy":                  handle(s.createPolicy),
+			"CreatePolicyVersion":           handle(s.createPolicyVersion),
+			"CreateRole":                    handle(s.createRole),
+			"DeletePolicy":                  handle(s.deletePolicy),
+			"DeletePolicyVersion":           handle(s.deletePolicyVersion),
+			"DeleteRole":                    handle(s.deleteRole),
+			"DeleteRolePermissionsBoundary": handle(s.deleteRolePermissionsBoundary),
+			"DeleteRolePolicy":              handle(s.deleteRolePolicy),
+			"DetachRolePolicy":              handle(s.detachRolePolicy),
+			"GetPolicy":                     handle(s.getPolicy),
+			"GetPolicyVersion":              handle(s.getPolicyVersion),
+			"GetRole":                       handle(s.getRole),
+			"GetRolePolicy":                 handle(s.getRolePolicy),
+			"ListAttachedRolePolicies":      handle(s.listAttachedRolePolicies),
+			"ListInstanceProfilesForRole":   handle(s.listInstanceProfilesForRole),
+			"ListPolicyTags":                handle(s.listPolicyTags),
+			"ListPolicyVersions":            handle(s.listPolicyVersions),
+			"ListRolePolicies":              handle(s.listRolePolicies),
+			"ListRoleTags":                  handle(s.listRoleTags),
+			"ListRoles":                     handle(s.listRoles),
+			"PutRolePermissionsBoundary":    handle(s.putRolePermissionsBoundary),
+			"PutRolePolicy":                 handle(s.putRolePolicy),
+			"TagPolicy":                     handle(s.tagPolicy),
+			"TagRole":                       handle(s.tagRole),
+			"UntagPolicy":                   handle(s.untagPolicy),
+			"UntagRole":                     handle(s.untagRole),
+			"UpdateAssumeRolePolicy":        handle(s.updateAssumeRolePolicy),
+			"UpdateRole":                    handle(s.updateRole),
+			"UpdateRoleDescription":         handle(s.updateRoleDescription),
+		},
+	}
+}
+
+func (s *iamService) createRole(r *request, input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+	name := aws.StringValue(input.RoleName)
+
+	if s.roles.exists(name) {
+		return nil, newAPIError(http.StatusConflict, iam.ErrCodeEntityAlreadyExistsException, fmt.Sprintf("Role with name %s already exists.", name))
+	}
+
+	path := aws.StringValue(input.Path)
+	if path == "" {
+		path = "/"
+	}
+
+	maxSessionDuration := aws.Int64Value(input.MaxSessionDuration)
+	if maxSessionDuration == 0 {
+		maxSessionDuration = 3600
+	}
+
+	role := &iamRole{
+		inlinePolicies: make(map[string]string),
+		role: &iam.Role{
+			Arn:                      aws.String(r.arn("iam", "", "role"+path+name)),
+			AssumeRolePolicyDocument: aws.String(url.QueryEscape(aws.StringValue(input.AssumeRolePolicyDocument))),
+			CreateDate:               aws.Time(time.Now().UTC()),
+			Description:              input.Description,
+			MaxSessionDuration:       aws.Int64(maxSessionDuration),
+			Path:                     aws.String(path),
+			RoleId:                   aws.String(fmt.Sprintf("AROA%016d", r.server.nextID())),
+			RoleLastUsed:             &iam.RoleLastUsed{},
+			RoleName:                 aws.String(name),
+		},
+	}
+
+	if v := input.PermissionsBoundary; v != nil {
+		role.role.PermissionsBoundary = &iam.AttachedPermissionsBoundary{
+			PermissionsBoundaryArn:  v,
+			PermissionsBoundaryType: aws.String(iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy),
+		}
+	}
+
+	for _, tag := range input.Tags {
+		role.tags = role.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	s.roles.create(name, role, r.server.options.EventualConsistencyReads)
+
+	return &iam.CreateRoleOutput{
+		Role: role.output(),
+	}, nil
+}
+
+func (s *iamService) deleteRole(r *request, input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.inlinePolicies) > 0 || len(role.attachedPolicyARNs) > 0 {
+		return nil, newAPIError(http.StatusConflict, iam.ErrCodeDeleteConflictException, "Cannot delete entity, must detach all policies first.")
+	}
+
+	s.roles.delete(aws.StringValue(role.role.RoleName))
+
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+func (s *iamService) getRole(r *request, input *iam.GetRoleInput) (*iam.GetRoleOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetRoleOutput{
+		Role: role.output(),
+	}, nil
+}
+
+func (s *iamService) listRoles(r *request, input *iam.ListRolesInput) (*iam.ListRolesOutput, error) {
+	output := &iam.ListRolesOutput{
+		IsTruncated: aws.Bool(false),
+		Roles:       []*iam.Role{},
+	}
+
+	for _, role := range s.roles.list() {
+		if strings.HasPrefix(aws.StringValue(role.role.Path), aws.StringValue(input.PathPrefix)) {
+			output.Roles = append(output.Roles, role.output())
+		}
+	}
+
+	return output, nil
+}
+
+func (s *iamService) updateRole(r *request, input *iam.UpdateRoleInput) (*iam.UpdateRoleOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Description != nil {
+		role.role.Description = input.Description
+	}
+
+	if input.MaxSessionDuration != nil {
+		role.role.MaxSessionDuration = input.MaxSessionDuration
+	}
+
+	return &iam.UpdateRoleOutput{}, nil
+}
+
+func (s *iamService) updateRoleDescription(r *request, input *iam.UpdateRoleDescriptionInput) (*iam.UpdateRoleDescriptionOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.Description = input.Description
+
+	return &iam.UpdateRoleDescriptionOutput{
+		Role: role.output(),
+	}, nil
+}
+
+func (s *iamService) updateAssumeRolePolicy(r *request, input *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.AssumeRolePolicyDocument = aws.String(url.QueryEscape(aws.StringValue(input.PolicyDocument)))
+
+	return &iam.UpdateAssumeRolePolicyOutput{}, nil
+}
+
+func (s *iamService) putRolePermissionsBoundary(r *request, input *iam.PutRolePermissionsBoundaryInput) (*iam.PutRolePermissionsBoundaryOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.PermissionsBoundary = &iam.AttachedPermissionsBoundary{
+		PermissionsBoundaryArn:  input.PermissionsBoundary,
+		PermissionsBoundaryType: aws.String(iam.PermissionsBoundaryAttachmentTypePermissionsBoundaryPolicy),
+	}
+
+	return &iam.PutRolePermissionsBoundaryOutput{}, nil
+}
+
+func (s *iamService) deleteRolePermissionsBoundary(r *request, input *iam.DeleteRolePermissionsBoundaryInput) (*iam.DeleteRolePermissionsBoundaryOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.PermissionsBoundary = nil
+
+	return &iam.DeleteRolePermissionsBoundaryOutput{}, nil
+}
+
+func (s *iamService) putRolePolicy(r *request, input *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.inlinePolicies[aws.StringValue(input.PolicyName)] = aws.StringValue(input.PolicyDocument)
+
+	return &iam.PutRolePolicyOutput{}, nil
+}
+
+func (s *iamService) getRolePolicy(r *request, input *iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	document, ok := role.inlinePolicies[aws.StringValue(input.PolicyName)]
+
+	if !ok {
+		return nil, iamNoSuchEntityError(fmt.Sprintf("The role policy with name %s cannot be found.", aws.StringValue(input.PolicyName)))
+	}
+
+	return &iam.GetRolePolicyOutput{
+		PolicyDocument: aws.String(url.QueryEscape(document)),
+		PolicyName:     input.PolicyName,
+		RoleName:       role.role.RoleName,
+	}, nil
+}
+
+func (s *iamService) deleteRolePolicy(r *request, input *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := role.inlinePolicies[aws.StringValue(input.PolicyName)]; !ok {
+		return nil, iamNoSuchEntityError(fmt.Sprintf("The role policy with name %s cannot be found.", aws.StringValue(input.PolicyName)))
+	}
+
+	delete(role.inlinePolicies, aws.StringValue(input.PolicyName))
+
+	return &iam.DeleteRolePolicyOutput{}, nil
+}
+
+func (s *iamService) listRolePolicies(r *request, input *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListRolePoliciesOutput{
+		IsTruncated: aws.Bool(false),
+		PolicyNames: aws.StringSlice(sortedKeys(role.inlinePolicies)),
+	}, nil
+}
+
+func (s *iamService) attachRolePolicy(r *request, input *iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.StringValue(input.PolicyArn)
+
+	// AWS managed policies are not modeled and are assumed to exist.
+	if !strings.HasPrefix(arn, "arn:aws:iam::aws:policy/") {
+		policy, err := s.findPolicy(input.PolicyArn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		policy.policy.AttachmentCount = aws.Int64(aws.Int64Value(policy.policy.AttachmentCount) + 1)
+	}
+
+	for _, v := range role.attachedPolicyARNs {
+		if v == arn {
+			return &iam.AttachRolePolicyOutput{}, nil
+		}
+	}
+
+	role.attachedPolicyARNs = append(role.attachedPolicyARNs, arn)
+
+	return &iam.AttachRolePolicyOutput{}, nil
+}
+
+func (s *iamService) detachRolePolicy(r *request, input *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.StringValue(input.PolicyArn)
+
+	for i, v := range role.attachedPolicyARNs {
+		if v == arn {
+			role.attachedPolicyARNs = append(role.attachedPolicyARNs[:i], role.attachedPolicyARNs[i+1:]...)
+
+			if policy, ok := s.policies.items[arn]; ok {
+				policy.policy.AttachmentCount = aws.Int64(aws.Int64Value(policy.policy.AttachmentCount) - 1)
+			}
+
+			return &iam.DetachRolePolicyOutput{}, nil
+		}
+	}
+
+	return nil, iamNoSuchEntityError(fmt.Sprintf("Policy %s was not found.", arn))
+}
+
+func (s *iamService) listAttachedRolePolicies(r *request, input *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &iam.ListAttachedRolePoliciesOutput{
+		AttachedPolicies: []*iam.AttachedPolicy{},
+		IsTruncated:      aws.Bool(false),
+	}
+
+	for _, arn := range role.attachedPolicyARNs {
+		output.AttachedPolicies = append(output.AttachedPolicies, &iam.AttachedPolicy{
+			PolicyArn:  aws.String(arn),
+			PolicyName: aws.String(arn[strings.LastIndex(arn, "/")+1:]),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *iamService) listInstanceProfilesForRole(r *request, input *iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error) {
+	if _, err := s.findRole(input.RoleName); err != nil {
+		return nil, err
+	}
+
+	// Instance profiles are not modeled.
+	return &iam.ListInstanceProfilesForRoleOutput{
+		InstanceProfiles: []*iam.InstanceProfile{},
+		IsTruncated:      aws.Bool(false),
+	}, nil
+}
+
+func (s *iamService) listRoleTags(r *request, input *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListRoleTagsOutput{
+		IsTruncated: aws.Bool(false),
+		Tags:        iamTags(role.tags),
+	}, nil
+}
+
+func (s *iamService) tagRole(r *request, input *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		role.tags = role.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	return &iam.TagRoleOutput{}, nil
+}
+
+func (s *iamService) untagRole(r *request, input *iam.UntagRoleInput) (*iam.UntagRoleOutput, error) {
+	role, err := s.findRole(input.RoleName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &iam.UntagRoleOutput{}, nil
+}
+
+func (s *iamService) createPolicy(r *request, input *iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error) {
+	name := aws.StringValue(input.PolicyName)
+
+	path := aws.StringValue(input.Path)
+	if path == "" {
+		path = "/"
+	}
+
+	arn := r.arn("iam", "", "policy"+path+name)
+
+	if s.policies.exists(arn) {
+		return nil, newAPIError(http.StatusConflict, iam.ErrCodeEntityAlreadyExistsException, fmt.Sprintf("A policy called %s already exists. Duplicate names are not allowed.", name))
+	}
+
+	now := aws.Time(time.Now().UTC())
+	policy := &iamPolicy{
+		nextVersion: 2,
+		policy: &iam.Policy{
+			Arn:              aws.String(arn),
+			AttachmentCount:  aws.Int64(0),
+			CreateDate:       now,
+			DefaultVersionId: aws.String("v1"),
+			Description:      input.Description,
+			IsAttachable:     aws.Bool(true),
+			Path:             aws.String(path),
+			PolicyId:         aws.String(fmt.Sprintf("ANPA%016d", r.server.nextID())),
+			PolicyName:       aws.String(name),
+			UpdateDate:       now,
+		},
+		versions: map[string]*iam.PolicyVersion{
+			"v1": {
+				CreateDate:       now,
+				Document:         aws.String(url.QueryEscape(aws.StringValue(input.PolicyDocument))),
+				IsDefaultVersion: aws.Bool(true),
+				VersionId:        aws.String("v1"),
+			},
+		},
+	}
+
+	for _, tag := range input.Tags {
+		policy.tags = policy.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	s.policies.create(arn, policy, r.server.options.EventualConsistencyReads)
+
+	return &iam.CreatePolicyOutput{
+		Policy: policy.output(),
+	}, nil
+}
+
+func (s *iamService) deletePolicy(r *request, input *iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.Int64Value(policy.policy.AttachmentCount) > 0 || len(policy.versions) > 1 {
+		return nil, newAPIError(http.StatusConflict, iam.ErrCodeDeleteConflictException, "Cannot delete a policy attached to entities or with non-default versions.")
+	}
+
+	s.policies.delete(aws.StringValue(policy.policy.Arn))
+
+	return &iam.DeletePolicyOutput{}, nil
+}
+
+func (s *iamService) getPolicy(r *request, input *iam.GetPolicyInput) (*iam.GetPolicyOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetPolicyOutput{
+		Policy: policy.output(),
+	}, nil
+}
+
+func (s *iamService) createPolicyVersion(r *request, input *iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.versions) >= 5 {
+		return nil, newAPIError(http.StatusConflict, iam.ErrCodeLimitExceededException, "A managed policy can have up to 5 versions.")
+	}
+
+	versionID := "v" + strconv.Itoa(policy.nextVersion)
+	policy.nextVersion++
+
+	version := &iam.PolicyVersion{
+		CreateDate:       aws.Time(time.Now().UTC()),
+		Document:         aws.String(url.QueryEscape(aws.StringValue(input.PolicyDocument))),
+		IsDefaultVersion: aws.Bool(false),
+		VersionId:        aws.String(versionID),
+	}
+	policy.versions[versionID] = version
+
+	if aws.BoolValue(input.SetAsDefault) {
+		for _, v := range policy.versions {
+			v.IsDefaultVersion = aws.Bool(false)
+		}
+
+		version.IsDefaultVersion = aws.Bool(true)
+		policy.policy.DefaultVersionId = aws.String(versionID)
+		policy.policy.UpdateDate = version.CreateDate
+	}
+
+	return &iam.CreatePolicyVersionOutput{
+		PolicyVersion: version,
+	}, nil
+}
+
+func (s *iamService) deletePolicyVersion(r *request, input *iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := aws.StringValue(input.VersionId)
+	version, ok := policy.versions[versionID]
+
+	if !ok {
+		return nil, iamNoSuchEntityError(fmt.Sprintf("Policy version %s does not exist.", versionID))
+	}
+
+	if aws.BoolValue(version.IsDefaultVersion) {
+		return nil, newAPIError(http.StatusConflict, iam.ErrCodeDeleteConflictException, "Cannot delete the default version of a policy.")
+	}
+
+	delete(policy.versions, versionID)
+
+	return &iam.DeletePolicyVersionOutput{}, nil
+}
+
+func (s *iamService) getPolicyVersion(r *request, input *iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := policy.versions[aws.StringValue(input.VersionId)]
+
+	if !ok {
+		return nil, iamNoSuchEntityError(fmt.Sprintf("Policy version %s does not exist.", aws.StringValue(input.VersionId)))
+	}
+
+	return &iam.GetPolicyVersionOutput{
+		PolicyVersion: version,
+	}, nil
+}
+
+func (s *iamService) listPolicyVersions(r *request, input *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &iam.ListPolicyVersionsOutput{
+		IsTruncated: aws.Bool(false),
+		Versions:    []*iam.PolicyVersion{},
+	}
+
+	for _, k := range sortedKeys(policy.versions) {
+		v := policy.versions[k]
+		output.Versions = append(output.Versions, &iam.PolicyVersion{
+			CreateDate:       v.CreateDate,
+			IsDefaultVersion: v.IsDefaultVersion,
+			VersionId:        v.VersionId,
+		})
+	}
+
+	return output, nil
+}
+
+func (s *iamService) listPolicyTags(r *request, input *iam.ListPolicyTagsInput) (*iam.ListPolicyTagsOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListPolicyTagsOutput{
+		IsTruncated: aws.Bool(false),
+		Tags:        iamTags(policy.tags),
+	}, nil
+}
+
+func (s *iamService) tagPolicy(r *request, input *iam.TagPolicyInput) (*iam.TagPolicyOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		policy.tags = policy.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	return &iam.TagPolicyOutput{}, nil
+}
+
+func (s *iamService) untagPolicy(r *request, input *iam.UntagPolicyInput) (*iam.UntagPolicyOutput, error) {
+	policy, err := s.findPolicy(input.PolicyArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &iam.UntagPolicyOutput{}, nil
+}
+
+func (s *iamService) findRole(name *string) (*iamRole, error) {
+	role, ok := s.roles.get(aws.StringValue(name))
+
+	if !ok {
+		return nil, iamNoSuchEntityError(fmt.Sprintf("The role with name %s cannot be found.", aws.StringValue(name)))
+	}
+
+	return role, nil
+}
+
+func (s *iamService) findPolicy(arn *string) (*iamPolicy, error) {
+	policy, ok := s.policies.get(aws.StringValue(arn))
+
+	if !ok {
+		return nil, iamNoSuchEntityError(fmt.Sprintf("Policy %s does not exist or is not attachable.", aws.StringValue(arn)))
+	}
+
+	return policy, nil
+}
+
+// output returns the role as returned by GetRole.
+func (r *iamRole) output() *iam.Role {
+	output := *r.role
+	output.Tags = iamTags(r.tags)
+
+	return &output
+}
+
+// output returns the policy as returned by GetPolicy.
+func (p *iamPolicy) output() *iam.Policy {
+	output := *p.policy
+	output.Tags = iamTags(p.tags)
+
+	return &output
+}
+
+func iamTags(t tags) []*iam.Tag {
+	var iamTags []*iam.Tag
+
+	for _, k := range sortedKeys(t) {
+		iamTags = append(iamTags, &iam.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(t[k]),
+		})
+	}
+
+	return iamTags
+}
+
+func iamNoSuchEntityError(message string) *apiError {
+	return newAPIError(http.StatusNotFound, iam.ErrCodeNoSuchEntityException, message)
+}
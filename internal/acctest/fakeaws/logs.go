@@ -0,0 +1,185 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type logsService struct {
+	destinations *store[logsDestination]
+	server       *Server
+}
+
+type logsDestination struct {
+	destination *cloudwatchlogs.Destination
+	tags        tags
+}
+
+func newLogsService(server *Server) *service {
+	s := &logsService{
+		destinations: newStore[logsDestination](),
+		server:       server,
+	}
+
+	return &service{
+		name:         names.Logs,
+		protocol:     protocolJSON,
+		jsonVersion:  "1.1",
+		targetPrefix: "Logs_20140328",
+		operations: map[string]handlerFunc{
+			"DeleteDestination":    handle(s.deleteDestination),
+			"DescribeDestinations": handle(s.describeDestinations),
+			"ListTagsForResource":  handle(s.listTagsForResource),
+			"PutDestination":       handle(s.putDestination),
+			"PutDestinationPolicy": handle(s.putDestinationPolicy),
+			"TagResource":          handle(s.tagResource),
+			"UntagResource":        handle(s.untagResource),
+		},
+	}
+}
+
+func (s *logsService) putDestination(r *request, input *cloudwatchlogs.PutDestinationInput) (*cloudwatchlogs.PutDestinationOutput, error) {
+	name := aws.StringValue(input.DestinationName)
+
+	if destination, ok := s.destinations.items[name]; ok {
+		destination.destination.RoleArn = input.RoleArn
+		destination.destination.TargetArn = input.TargetArn
+
+		return &cloudwatchlogs.PutDestinationOutput{
+			Destination: destination.destination,
+		}, nil
+	}
+
+	destination := &logsDestination{
+		destination: &cloudwatchlogs.Destination{
+			Arn:             aws.String(r.arn("logs", r.region, "destination:"+name)),
+			CreationTime:    aws.Int64(time.Now().UnixMilli()),
+			DestinationName: aws.String(name),
+			RoleArn:         input.RoleArn,
+			TargetArn:       input.TargetArn,
+		},
+	}
+
+	for k, v := range input.Tags {
+		destination.tags = destination.tags.set(k, aws.StringValue(v))
+	}
+
+	s.destinations.create(name, destination, r.server.options.EventualConsistencyReads)
+
+	return &cloudwatchlogs.PutDestinationOutput{
+		Destination: destination.destination,
+	}, nil
+}
+
+func (s *logsService) putDestinationPolicy(r *request, input *cloudwatchlogs.PutDestinationPolicyInput) (*cloudwatchlogs.PutDestinationPolicyOutput, error) {
+	destination, err := s.findDestination(aws.StringValue(input.DestinationName))
+
+	if err != nil {
+		return nil, err
+	}
+
+	destination.destination.AccessPolicy = input.AccessPolicy
+
+	return &cloudwatchlogs.PutDestinationPolicyOutput{}, nil
+}
+
+func (s *logsService) describeDestinations(r *request, input *cloudwatchlogs.DescribeDestinationsInput) (*cloudwatchlogs.DescribeDestinationsOutput, error) {
+	output := &cloudwatchlogs.DescribeDestinationsOutput{
+		Destinations: []*cloudwatchlogs.Destination{},
+	}
+	prefix := aws.StringValue(input.DestinationNamePrefix)
+
+	for _, name := range sortedKeys(s.destinations.items) {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		if destination, ok := s.destinations.get(name); ok {
+			output.Destinations = append(output.Destinations, destination.destination)
+		}
+	}
+
+	return output, nil
+}
+
+func (s *logsService) deleteDestination(r *request, input *cloudwatchlogs.DeleteDestinationInput) (*cloudwatchlogs.DeleteDestinationOutput, error) {
+	name := aws.StringValue(input.DestinationName)
+
+	if _, err := s.findDestination(name); err != nil {
+		return nil, err
+	}
+
+	s.destinations.delete(name)
+
+	return &cloudwatchlogs.DeleteDestinationOutput{}, nil
+}
+
+func (s *logsService) listTagsForResource(r *request, input *cloudwatchlogs.ListTagsForResourceInput) (*cloudwatchlogs.ListTagsForResourceOutput, error) {
+	destination, err := s.findDestinationByARN(aws.StringValue(input.ResourceArn))
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &cloudwatchlogs.ListTagsForResourceOutput{
+		Tags: make(map[string]*string),
+	}
+
+	for k, v := range destination.tags {
+		output.Tags[k] = aws.String(v)
+	}
+
+	return output, nil
+}
+
+func (s *logsService) tagResource(r *request, input *cloudwatchlogs.TagResourceInput) (*cloudwatchlogs.TagResourceOutput, error) {
+	destination, err := s.findDestinationByARN(aws.StringValue(input.ResourceArn))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.Tags {
+		destination.tags = destination.tags.set(k, aws.StringValue(v))
+	}
+
+	return &cloudwatchlogs.TagResourceOutput{}, nil
+}
+
+func (s *logsService) untagResource(r *request, input *cloudwatchlogs.UntagResourceInput) (*cloudwatchlogs.UntagResourceOutput, error) {
+	destination, err := s.findDestinationByARN(aws.StringValue(input.ResourceArn))
+
+	if err != nil {
+		return nil, err
+	}
+
+	destination.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &cloudwatchlogs.UntagResourceOutput{}, nil
+}
+
+func (s *logsService) findDestination(name string) (*logsDestination, error) {
+	destination, ok := s.destinations.items[name]
+
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, cloudwatchlogs.ErrCodeResourceNotFoundException, fmt.Sprintf("The specified destination does not exist: %s", name))
+	}
+
+	return destination, nil
+}
+
+func (s *logsService) findDestinationByARN(arn string) (*logsDestination, error) {
+	for _, destination := range s.destinations.items {
+		if aws.StringValue(destination.destination.Arn) == arn {
+			return destination, nil
+		}
+	}
+
+	return nil, newAPIError(http.StatusBadRequest, cloudwatchlogs.ErrCodeResourceNotFoundException, fmt.Sprintf("The specified resource does not exist: %s", arn))
+}
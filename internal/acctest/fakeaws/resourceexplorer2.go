@@ -0,0 +1,374 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type resourceExplorer2Service struct {
+	defaultViews map[string]string // Region -> default view ARN.
+	indexes      *store[resourceExplorer2Index]
+	server       *Server
+	views        *store[resourceExplorer2View]
+}
+
+type resourceExplorer2Index struct {
+	arn           string
+	createdAt     time.Time
+	indexType     string
+	lastUpdatedAt time.Time
+	tags          tags
+}
+
+type resourceExplorer2View struct {
+	region string
+	tags   tags
+	view   *resourceexplorer2.View
+}
+
+func newResourceExplorer2Service(server *Server) *service {
+	s := &resourceExplorer2Service{
+		defaultViews: make(map[string]string),
+		indexes:      newStore[resourceExplorer2Index](),
+		server:       server,
+		views:        newStore[resourceExplorer2View](),
+	}
+
+	return &service{
+		name:         names.ResourceExplorer2,
+		signingName:  "resource-explorer-2",
+		protocol:     protocolRESTJSON,
+		operationFor: resourceExplorer2Operation,
+		operations: map[string]handlerFunc{
+			"AssociateDefaultView":    handle(s.associateDefaultView),
+			"CreateIndex":             handle(s.createIndex),
+			"CreateView":              handle(s.createView),
+			"DeleteIndex":             handle(s.deleteIndex),
+			"DeleteView":              handle(s.deleteView),
+			"DisassociateDefaultView": handle(s.disassociateDefaultView),
+			"GetDefaultView":          handle(s.getDefaultView),
+			"GetIndex":                handle(s.getIndex),
+			"GetView":                 handle(s.getView),
+			"ListTagsForResource":     handle(s.listTagsForResource),
+			"TagResource":             handle(s.tagResource),
+			"UntagResource":           handle(s.untagResource),
+			"UpdateIndexType":         handle(s.updateIndexType),
+			"UpdateView":              handle(s.updateView),
+		},
+	}
+}
+
+// resourceExplorer2Operation returns the name of the operation for a request.
+// Tagging operations are addressed by resource, all other operations by name.
+func resourceExplorer2Operation(r *request) string {
+	if strings.HasPrefix(r.URL.Path, "/tags/") {
+		switch r.Method {
+		case http.MethodGet:
+			return "ListTagsForResource"
+		case http.MethodPost:
+			return "TagResource"
+		case http.MethodDelete:
+			return "UntagResource"
+		}
+
+		return ""
+	}
+
+	if r.Method != http.MethodPost {
+		return ""
+	}
+
+	return strings.TrimPrefix(r.URL.Path, "/")
+}
+
+// resourceExplorer2ResourceARN returns the ARN of the resource addressed by a tagging operation.
+func resourceExplorer2ResourceARN(r *request) string {
+	arn, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/tags/"))
+
+	if err != nil {
+		return ""
+	}
+
+	return arn
+}
+
+func (s *resourceExplorer2Service) createIndex(r *request, input *resourceexplorer2.CreateIndexInput) (*resourceexplorer2.CreateIndexOutput, error) {
+	if s.indexes.exists(r.region) {
+		return nil, newAPIError(http.StatusConflict, resourceexplorer2.ErrCodeConflictException, fmt.Sprintf("an index already exists in %s", r.region))
+	}
+
+	now := time.Now().UTC()
+	index := &resourceExplorer2Index{
+		arn:           r.arn("resource-explorer-2", r.region, fmt.Sprintf("index/%08d-0000-0000-0000-000000000000", r.server.nextID())),
+		createdAt:     now,
+		indexType:     resourceexplorer2.IndexTypeLocal,
+		lastUpdatedAt: now,
+	}
+
+	for k, v := range input.Tags {
+		index.tags = index.tags.set(k, aws.StringValue(v))
+	}
+
+	s.indexes.create(r.region, index, r.server.options.EventualConsistencyReads)
+
+	return &resourceexplorer2.CreateIndexOutput{
+		Arn:       aws.String(index.arn),
+		CreatedAt: aws.Time(index.createdAt),
+		State:     aws.String(resourceexplorer2.IndexStateActive),
+	}, nil
+}
+
+func (s *resourceExplorer2Service) getIndex(r *request, input *resourceexplorer2.GetIndexInput) (*resourceexplorer2.GetIndexOutput, error) {
+	index, ok := s.indexes.get(r.region)
+
+	if !ok {
+		return nil, s.indexNotFoundError(r)
+	}
+
+	output := &resourceexplorer2.GetIndexOutput{
+		Arn:           aws.String(index.arn),
+		CreatedAt:     aws.Time(index.createdAt),
+		LastUpdatedAt: aws.Time(index.lastUpdatedAt),
+		State:         aws.String(resourceexplorer2.IndexStateActive),
+		Tags:          make(map[string]*string),
+		Type:          aws.String(index.indexType),
+	}
+
+	for k, v := range index.tags {
+		output.Tags[k] = aws.String(v)
+	}
+
+	return output, nil
+}
+
+func (s *resourceExplorer2Service) updateIndexType(r *request, input *resourceexplorer2.UpdateIndexTypeInput) (*resourceexplorer2.UpdateIndexTypeOutput, error) {
+	index, ok := s.indexes.items[r.region]
+
+	if !ok || index.arn != aws.StringValue(input.Arn) {
+		return nil, s.indexNotFoundError(r)
+	}
+
+	index.indexType = aws.StringValue(input.Type)
+	index.lastUpdatedAt = time.Now().UTC()
+
+	return &resourceexplorer2.UpdateIndexTypeOutput{
+		Arn:           aws.String(index.arn),
+		LastUpdatedAt: aws.Time(index.lastUpdatedAt),
+		State:         aws.String(resourceexplorer2.IndexStateActive),
+		Type:          aws.String(index.indexType),
+	}, nil
+}
+
+func (s *resourceExplorer2Service) deleteIndex(r *request, input *resourceexplorer2.DeleteIndexInput) (*resourceexplorer2.DeleteIndexOutput, error) {
+	index, ok := s.indexes.items[r.region]
+
+	if !ok || index.arn != aws.StringValue(input.Arn) {
+		return nil, s.indexNotFoundError(r)
+	}
+
+	s.indexes.delete(r.region)
+
+	return &resourceexplorer2.DeleteIndexOutput{
+		Arn:           aws.String(index.arn),
+		LastUpdatedAt: aws.Time(time.Now().UTC()),
+		State:         aws.String(resourceexplorer2.IndexStateDeleted),
+	}, nil
+}
+
+func (s *resourceExplorer2Service) createView(r *request, input *resourceexplorer2.CreateViewInput) (*resourceexplorer2.CreateViewOutput, error) {
+	if !s.indexes.exists(r.region) {
+		return nil, s.indexNotFoundError(r)
+	}
+
+	name := aws.StringValue(input.ViewName)
+
+	for _, view := range s.views.items {
+		if view.region == r.region && strings.Contains(aws.StringValue(view.view.ViewArn), ":view/"+name+"/") {
+			return nil, newAPIError(http.StatusConflict, resourceexplorer2.ErrCodeConflictException, fmt.Sprintf("a view named %s already exists", name))
+		}
+	}
+
+	arn := r.arn("resource-explorer-2", r.region, fmt.Sprintf("view/%s/%08d-0000-0000-0000-000000000000", name, r.server.nextID()))
+	view := &resourceExplorer2View{
+		region: r.region,
+		view: &resourceexplorer2.View{
+			Filters:            input.Filters,
+			IncludedProperties: input.IncludedProperties,
+			LastUpdatedAt:      aws.Time(time.Now().UTC()),
+			Owner:              aws.String(r.accountID),
+			Scope:              aws.String(fmt.Sprintf("arn:aws:iam::%s:root", r.accountID)),
+			ViewArn:            aws.String(arn),
+		},
+	}
+
+	for k, v := range input.Tags {
+		view.tags = view.tags.set(k, aws.StringValue(v))
+	}
+
+	s.views.create(arn, view, r.server.options.EventualConsistencyReads)
+
+	return &resourceexplorer2.CreateViewOutput{
+		View: view.view,
+	}, nil
+}
+
+func (s *resourceExplorer2Service) getView(r *request, input *resourceexplorer2.GetViewInput) (*resourceexplorer2.GetViewOutput, error) {
+	view, ok := s.views.get(aws.StringValue(input.ViewArn))
+
+	if !ok {
+		return nil, s.viewNotFoundError(aws.StringValue(input.ViewArn))
+	}
+
+	output := &resourceexplorer2.GetViewOutput{
+		Tags: make(map[string]*string),
+		View: view.view,
+	}
+
+	for k, v := range view.tags {
+		output.Tags[k] = aws.String(v)
+	}
+
+	return output, nil
+}
+
+func (s *resourceExplorer2Service) updateView(r *request, input *resourceexplorer2.UpdateViewInput) (*resourceexplorer2.UpdateViewOutput, error) {
+	view, ok := s.views.items[aws.StringValue(input.ViewArn)]
+
+	if !ok {
+		return nil, s.viewNotFoundError(aws.StringValue(input.ViewArn))
+	}
+
+	if input.Filters != nil {
+		view.view.Filters = input.Filters
+	}
+	view.view.IncludedProperties = input.IncludedProperties
+	view.view.LastUpdatedAt = aws.Time(time.Now().UTC())
+
+	return &resourceexplorer2.UpdateViewOutput{
+		View: view.view,
+	}, nil
+}
+
+func (s *resourceExplorer2Service) deleteView(r *request, input *resourceexplorer2.DeleteViewInput) (*resourceexplorer2.DeleteViewOutput, error) {
+	arn := aws.StringValue(input.ViewArn)
+
+	if !s.views.exists(arn) {
+		return nil, s.viewNotFoundError(arn)
+	}
+
+	s.views.delete(arn)
+
+	for region, defaultViewARN := range s.defaultViews {
+		if defaultViewARN == arn {
+			delete(s.defaultViews, region)
+		}
+	}
+
+	return &resourceexplorer2.DeleteViewOutput{
+		ViewArn: aws.String(arn),
+	}, nil
+}
+
+func (s *resourceExplorer2Service) associateDefaultView(r *request, input *resourceexplorer2.AssociateDefaultViewInput) (*resourceexplorer2.AssociateDefaultViewOutput, error) {
+	arn := aws.StringValue(input.ViewArn)
+
+	if !s.views.exists(arn) {
+		return nil, s.viewNotFoundError(arn)
+	}
+
+	s.defaultViews[r.region] = arn
+
+	return &resourceexplorer2.AssociateDefaultViewOutput{
+		ViewArn: aws.String(arn),
+	}, nil
+}
+
+func (s *resourceExplorer2Service) disassociateDefaultView(r *request, input *resourceexplorer2.DisassociateDefaultViewInput) (*resourceexplorer2.DisassociateDefaultViewOutput, error) {
+	delete(s.defaultViews, r.region)
+
+	return &resourceexplorer2.DisassociateDefaultViewOutput{}, nil
+}
+
+func (s *resourceExplorer2Service) getDefaultView(r *request, input *resourceexplorer2.GetDefaultViewInput) (*resourceexplorer2.GetDefaultViewOutput, error) {
+	output := &resourceexplorer2.GetDefaultViewOutput{}
+
+	if arn, ok := s.defaultViews[r.region]; ok {
+		output.ViewArn = aws.String(arn)
+	}
+
+	return output, nil
+}
+
+func (s *resourceExplorer2Service) listTagsForResource(r *request, input *resourceexplorer2.ListTagsForResourceInput) (*resourceexplorer2.ListTagsForResourceOutput, error) {
+	t, err := s.findTags(resourceExplorer2ResourceARN(r))
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &resourceexplorer2.ListTagsForResourceOutput{
+		Tags: make(map[string]*string),
+	}
+
+	for k, v := range *t {
+		output.Tags[k] = aws.String(v)
+	}
+
+	return output, nil
+}
+
+func (s *resourceExplorer2Service) tagResource(r *request, input *resourceexplorer2.TagResourceInput) (*resourceexplorer2.TagResourceOutput, error) {
+	t, err := s.findTags(resourceExplorer2ResourceARN(r))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.Tags {
+		*t = t.set(k, aws.StringValue(v))
+	}
+
+	return &resourceexplorer2.TagResourceOutput{}, nil
+}
+
+func (s *resourceExplorer2Service) untagResource(r *request, input *resourceexplorer2.UntagResourceInput) (*resourceexplorer2.UntagResourceOutput, error) {
+	t, err := s.findTags(resourceExplorer2ResourceARN(r))
+
+	if err != nil {
+		return nil, err
+	}
+
+	t.remove(r.URL.Query()["tagKeys"]...)
+
+	return &resourceexplorer2.UntagResourceOutput{}, nil
+}
+
+// findTags returns the tags of the index or view with the specified ARN.
+func (s *resourceExplorer2Service) findTags(arn string) (*tags, error) {
+	for _, index := range s.indexes.items {
+		if index.arn == arn {
+			return &index.tags, nil
+		}
+	}
+
+	if view, ok := s.views.items[arn]; ok {
+		return &view.tags, nil
+	}
+
+	return nil, newAPIError(http.StatusNotFound, resourceexplorer2.ErrCodeResourceNotFoundException, fmt.Sprintf("resource %s not found", arn))
+}
+
+func (s *resourceExplorer2Service) indexNotFoundError(r *request) *apiError {
+	return newAPIError(http.StatusNotFound, resourceexplorer2.ErrCodeResourceNotFoundException, fmt.Sprintf("no index exists in %s", r.region))
+}
+
+func (s *resourceExplorer2Service) viewNotFoundError(arn string) *apiError {
+	return newAPIError(http.StatusNotFound, resourceexplorer2.ErrCodeResourceNotFoundException, fmt.Sprintf("view %s not found", arn))
+}
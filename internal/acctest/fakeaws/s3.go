@@ -0,0 +1,840 @@
+package fakeaws
+
+import (
+	"bytes"
+	"crypto/md5" //nolint:gosec // S3 ETags are MD5 digests.
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const s3Namespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+type s3Service struct {
+	buckets *store[s3Bucket]
+	server  *Server
+}
+
+type s3Bucket struct {
+	creationDate time.Time
+	name         string
+	objects      map[string]*s3Object
+	region       string
+	subresources map[string][]byte
+}
+
+type s3Object struct {
+	body         []byte
+	contentType  string
+	etag         string
+	lastModified time.Time
+	metadata     map[string]string
+	tags         tags
+}
+
+// s3HandlerFunc handles an S3 operation on a bucket or an object.
+type s3HandlerFunc func(r *request, bucket, key string) (*restResponse, error)
+
+// s3BucketSubresource describes a bucket configuration subresource, e.g. `?policy`,
+// whose Get, Put and Delete operations store and return the request body as-is.
+type s3BucketSubresource struct {
+	operation    string // Operation name without the Get, Put or Delete verb.
+	notFoundCode string // Error code returned by Get when the configuration is not set.
+	defaultBody  func(r *request, bucket *s3Bucket) string
+}
+
+var s3BucketSubresources = map[string]s3BucketSubresource{
+	"accelerate": {
+		operation: "BucketAccelerateConfiguration",
+		defaultBody: func(*request, *s3Bucket) string {
+			return `<AccelerateConfiguration xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"acl": {
+		operation:   "BucketAcl",
+		defaultBody: s3DefaultBucketACL,
+	},
+	"cors": {
+		operation:    "BucketCors",
+		notFoundCode: "NoSuchCORSConfiguration",
+	},
+	"encryption": {
+		operation: "BucketEncryption",
+		defaultBody: func(*request, *s3Bucket) string {
+			return `<ServerSideEncryptionConfiguration xmlns="` + s3Namespace + `"><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`
+		},
+	},
+	"lifecycle": {
+		operation:    "BucketLifecycleConfiguration",
+		notFoundCode: "NoSuchLifecycleConfiguration",
+	},
+	"logging": {
+		operation: "BucketLogging",
+		defaultBody: func(*request, *s3Bucket) string {
+			return `<BucketLoggingStatus xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"notification": {
+		operation: "BucketNotificationConfiguration",
+		defaultBody: func(*request, *s3Bucket) string {
+			return `<NotificationConfiguration xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"object-lock": {
+		operation:    "ObjectLockConfiguration",
+		notFoundCode: "ObjectLockConfigurationNotFoundError",
+	},
+	"ownershipControls": {
+		operation:    "BucketOwnershipControls",
+		notFoundCode: "OwnershipControlsNotFoundError",
+	},
+	"policy": {
+		operation:    "BucketPolicy",
+		notFoundCode: "NoSuchBucketPolicy",
+	},
+	"publicAccessBlock": {
+		operation:    "PublicAccessBlock",
+		notFoundCode: "NoSuchPublicAccessBlockConfiguration",
+	},
+	"replication": {
+		operation:    "BucketReplication",
+		notFoundCode: "ReplicationConfigurationNotFoundError",
+	},
+	"requestPayment": {
+		operation: "BucketRequestPayment",
+		defaultBody: func(*request, *s3Bucket) string {
+			return `<RequestPaymentConfiguration xmlns="` + s3Namespace + `"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`
+		},
+	},
+	"tagging": {
+		operation:    "BucketTagging",
+		notFoundCode: "NoSuchTagSet",
+	},
+	"versioning": {
+		operation: "BucketVersioning",
+		defaultBody: func(*request, *s3Bucket) string {
+			return `<VersioningConfiguration xmlns="` + s3Namespace + `"/>`
+		},
+	},
+	"website": {
+		operation:    "BucketWebsite",
+		notFoundCode: "NoSuchWebsiteConfiguration",
+	},
+}
+
+func newS3Service(server *Server) *service {
+	s := &s3Service{
+		buckets: newStore[s3Bucket](),
+		server:  server,
+	}
+
+	return &service{
+		name:     names.S3,
+		protocol: protocolRESTXML,
+		route:    s.route,
+	}
+}
+
+// route maps a path-style request to an S3 operation.
+func (s *s3Service) route(r *request) (string, restHandlerFunc) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	var operation string
+	var handler s3HandlerFunc
+
+	switch {
+	case bucket == "":
+		if r.Method == http.MethodGet {
+			operation, handler = "ListBuckets", s.listBuckets
+		}
+	case key == "":
+		operation, handler = s.bucketRoute(r.Method, query)
+	default:
+		operation, handler = s.objectRoute(r, query)
+	}
+
+	if handler == nil {
+		return "", nil
+	}
+
+	return operation, func(r *request) (*restResponse, error) {
+		return handler(r, bucket, key)
+	}
+}
+
+func (s *s3Service) bucketRoute(method string, query url.Values) (string, s3HandlerFunc) {
+	for name, subresource := range s3BucketSubresources {
+		if _, ok := query[name]; !ok {
+			continue
+		}
+
+		name, subresource := name, subresource
+
+		switch method {
+		case http.MethodGet:
+			return "Get" + subresource.operation, func(r *request, bucket, _ string) (*restResponse, error) {
+				return s.getBucketSubresource(r, bucket, name, subresource)
+			}
+		case http.MethodPut:
+			return "Put" + subresource.operation, func(r *request, bucket, _ string) (*restResponse, error) {
+				return s.putBucketSubresource(r, bucket, name)
+			}
+		case http.MethodDelete:
+			return "Delete" + subresource.operation, func(r *request, bucket, _ string) (*restResponse, error) {
+				return s.deleteBucketSubresource(r, bucket, name)
+			}
+		}
+
+		return "", nil
+	}
+
+	_, location := query["location"]
+	_, versions := query["versions"]
+	_, del := query["delete"]
+
+	switch method {
+	case http.MethodGet:
+		switch {
+		case location:
+			return "GetBucketLocation", s.getBucketLocation
+		case versions:
+			return "ListObjectVersions", s.listObjectVersions
+		case !s3IsListObjectsQuery(query):
+			// Unsupported subresource.
+			return "", nil
+		case query.Get("list-type") == "2":
+			return "ListObjectsV2", s.listObjectsV2
+		default:
+			return "ListObjects", s.listObjects
+		}
+	case http.MethodPost:
+		if del {
+			return "DeleteObjects", s.deleteObjects
+		}
+	case http.MethodPut:
+		if len(query) == 0 {
+			return "CreateBucket", s.createBucket
+		}
+	case http.MethodDelete:
+		if len(query) == 0 {
+			return "DeleteBucket", s.deleteBucket
+		}
+	case http.MethodHead:
+		return "HeadBucket", s.headBucket
+	}
+
+	return "", nil
+}
+
+func (s *s3Service) objectRoute(r *request, query url.Values) (string, s3HandlerFunc) {
+	if _, ok := query["tagging"]; ok {
+		switch r.Method {
+		case http.MethodGet:
+			return "GetObjectTagging", s.getObjectTagging
+		case http.MethodPut:
+			return "PutObjectTagging", s.putObjectTagging
+		case http.MethodDelete:
+			return "DeleteObjectTagging", s.deleteObjectTagging
+		}
+
+		return "", nil
+	}
+
+	if len(query) > 0 && query.Get("versionId") == "" {
+		// Unsupported subresource.
+		return "", nil
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return "GetObject", s.getObject
+	case http.MethodHead:
+		return "HeadObject", s.headObject
+	case http.MethodPut:
+		if r.Header.Get("X-Amz-Copy-Source") != "" {
+			return "CopyObject", s.copyObject
+		}
+
+		return "PutObject", s.putObject
+	case http.MethodDelete:
+		return "DeleteObject", s.deleteObject
+	}
+
+	return "", nil
+}
+
+func (s *s3Service) listBuckets(r *request, _, _ string) (*restResponse, error) {
+	output := &s3.ListBucketsOutput{
+		Buckets: []*s3.Bucket{},
+		Owner:   s3Owner(r),
+	}
+
+	for _, bucket := range s.buckets.list() {
+		output.Buckets = append(output.Buckets, &s3.Bucket{
+			CreationDate: aws.Time(bucket.creationDate),
+			Name:         aws.String(bucket.name),
+		})
+	}
+
+	return s3XMLResponse("ListAllMyBucketsResult", output)
+}
+
+func (s *s3Service) createBucket(r *request, name, _ string) (*restResponse, error) {
+	if s.buckets.exists(name) {
+		return nil, newAPIError(http.StatusConflict, s3.ErrCodeBucketAlreadyOwnedByYou, "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	region := r.region
+
+	if len(bytes.TrimSpace(r.body)) > 0 {
+		var configuration s3.CreateBucketConfiguration
+
+		if err := r.decode(&configuration); err != nil {
+			return nil, err
+		}
+
+		if v := aws.StringValue(configuration.LocationConstraint); v != "" {
+			region = v
+		}
+	}
+
+	s.buckets.create(name, &s3Bucket{
+		creationDate: time.Now(),
+		name:         name,
+		objects:      make(map[string]*s3Object),
+		region:       region,
+		subresources: make(map[string][]byte),
+	}, r.server.options.EventualConsistencyReads)
+
+	return &restResponse{
+		header: http.Header{"Location": []string{"/" + name}},
+	}, nil
+}
+
+func (s *s3Service) headBucket(r *request, name, _ string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &restResponse{
+		header: http.Header{"X-Amz-Bucket-Region": []string{bucket.region}},
+	}, nil
+}
+
+func (s *s3Service) deleteBucket(r *request, name, _ string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.objects) > 0 {
+		return nil, newAPIError(http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+	}
+
+	s.buckets.delete(name)
+
+	return &restResponse{
+		statusCode: http.StatusNoContent,
+	}, nil
+}
+
+func (s *s3Service) getBucketLocation(r *request, name, _ string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Buckets in us-east-1 have a null location constraint.
+	region := bucket.region
+	if region == DefaultRegion {
+		region = ""
+	}
+
+	return &restResponse{
+		body: []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><LocationConstraint xmlns="%s">%s</LocationConstraint>`, s3Namespace, region)),
+	}, nil
+}
+
+func (s *s3Service) getBucketSubresource(r *request, name, subresourceName string, subresource s3BucketSubresource) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := bucket.subresources[subresourceName]
+
+	if !ok {
+		if subresource.defaultBody == nil {
+			return nil, newAPIError(http.StatusNotFound, subresource.notFoundCode, fmt.Sprintf("The %s configuration does not exist", subresourceName))
+		}
+
+		body = []byte(subresource.defaultBody(r, bucket))
+	}
+
+	response := &restResponse{
+		body: body,
+	}
+
+	if subresourceName == "policy" {
+		response.header = http.Header{"Content-Type": []string{"application/json"}}
+	}
+
+	return response, nil
+}
+
+func (s *s3Service) putBucketSubresource(r *request, name, subresourceName string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A canned ACL is specified in a header and leaves the default ACL in place.
+	if len(bytes.TrimSpace(r.body)) > 0 {
+		bucket.subresources[subresourceName] = r.body
+	}
+
+	return &restResponse{}, nil
+}
+
+func (s *s3Service) deleteBucketSubresource(r *request, name, subresourceName string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(bucket.subresources, subresourceName)
+
+	return &restResponse{
+		statusCode: http.StatusNoContent,
+	}, nil
+}
+
+func (s *s3Service) listObjects(r *request, name, _ string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query()
+	contents, commonPrefixes := bucket.listObjects(query.Get("prefix"), query.Get("delimiter"))
+
+	return s3XMLResponse("ListBucketResult", &s3.ListObjectsOutput{
+		CommonPrefixes: commonPrefixes,
+		Contents:       contents,
+		IsTruncated:    aws.Bool(false),
+		MaxKeys:        aws.Int64(1000),
+		Name:           aws.String(name),
+		Prefix:         aws.String(query.Get("prefix")),
+	})
+}
+
+func (s *s3Service) listObjectsV2(r *request, name, _ string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query()
+	contents, commonPrefixes := bucket.listObjects(query.Get("prefix"), query.Get("delimiter"))
+
+	return s3XMLResponse("ListBucketResult", &s3.ListObjectsV2Output{
+		CommonPrefixes: commonPrefixes,
+		Contents:       contents,
+		IsTruncated:    aws.Bool(false),
+		KeyCount:       aws.Int64(int64(len(contents))),
+		MaxKeys:        aws.Int64(1000),
+		Name:           aws.String(name),
+		Prefix:         aws.String(query.Get("prefix")),
+	})
+}
+
+func (s *s3Service) listObjectVersions(r *request, name, _ string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query()
+	contents, commonPrefixes := bucket.listObjects(query.Get("prefix"), query.Get("delimiter"))
+	output := &s3.ListObjectVersionsOutput{
+		CommonPrefixes: commonPrefixes,
+		IsTruncated:    aws.Bool(false),
+		MaxKeys:        aws.Int64(1000),
+		Name:           aws.String(name),
+		Prefix:         aws.String(query.Get("prefix")),
+	}
+
+	// Object versioning is not simulated, each object has a single null version.
+	for _, object := range contents {
+		output.Versions = append(output.Versions, &s3.ObjectVersion{
+			ETag:         object.ETag,
+			IsLatest:     aws.Bool(true),
+			Key:          object.Key,
+			LastModified: object.LastModified,
+			Owner:        s3Owner(r),
+			Size:         object.Size,
+			StorageClass: aws.String(s3.ObjectVersionStorageClassStandard),
+			VersionId:    aws.String("null"),
+		})
+	}
+
+	return s3XMLResponse("ListVersionsResult", output)
+}
+
+func (s *s3Service) deleteObjects(r *request, name, _ string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var input s3.Delete
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	output := &s3.DeleteObjectsOutput{}
+
+	for _, object := range input.Objects {
+		delete(bucket.objects, aws.StringValue(object.Key))
+
+		if !aws.BoolValue(input.Quiet) {
+			output.Deleted = append(output.Deleted, &s3.DeletedObject{
+				Key:       object.Key,
+				VersionId: object.VersionId,
+			})
+		}
+	}
+
+	return s3XMLResponse("DeleteResult", output)
+}
+
+func (s *s3Service) putObject(r *request, name, key string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object := &s3Object{
+		body:         r.body,
+		contentType:  r.Header.Get("Content-Type"),
+		lastModified: time.Now(),
+		metadata:     make(map[string]string),
+	}
+
+	if object.contentType == "" {
+		object.contentType = "binary/octet-stream"
+	}
+
+	for k, v := range r.Header {
+		if name := strings.ToLower(k); strings.HasPrefix(name, "x-amz-meta-") {
+			object.metadata[strings.TrimPrefix(name, "x-amz-meta-")] = v[0]
+		}
+	}
+
+	if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+		values, err := url.ParseQuery(v)
+
+		if err != nil {
+			return nil, newAPIError(http.StatusBadRequest, "InvalidArgument", err.Error())
+		}
+
+		for k := range values {
+			object.tags = object.tags.set(k, values.Get(k))
+		}
+	}
+
+	sum := md5.Sum(object.body) //nolint:gosec // S3 ETags are MD5 digests.
+	object.etag = strconv.Quote(hex.EncodeToString(sum[:]))
+	bucket.objects[key] = object
+
+	return &restResponse{
+		header: http.Header{"Etag": []string{object.etag}},
+	}, nil
+}
+
+func (s *s3Service) copyObject(r *request, name, key string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidArgument", err.Error())
+	}
+
+	sourceBucketName, sourceKey, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+	sourceBucket, err := s.findBucket(sourceBucketName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sourceObject, ok := sourceBucket.objects[sourceKey]
+
+	if !ok {
+		return nil, s3NoSuchKeyError()
+	}
+
+	object := *sourceObject
+	object.lastModified = time.Now()
+	bucket.objects[key] = &object
+
+	return s3XMLResponse("CopyObjectResult", &s3.CopyObjectResult{
+		ETag:         aws.String(object.etag),
+		LastModified: aws.Time(object.lastModified),
+	})
+}
+
+func (s *s3Service) getObject(r *request, name, key string) (*restResponse, error) {
+	object, err := s.findObject(name, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &restResponse{
+		body:   object.body,
+		header: object.header(),
+	}, nil
+}
+
+func (s *s3Service) headObject(r *request, name, key string) (*restResponse, error) {
+	object, err := s.findObject(name, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &restResponse{
+		header: object.header(),
+	}, nil
+}
+
+func (s *s3Service) deleteObject(r *request, name, key string) (*restResponse, error) {
+	bucket, err := s.findBucket(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(bucket.objects, key)
+
+	return &restResponse{
+		statusCode: http.StatusNoContent,
+	}, nil
+}
+
+func (s *s3Service) getObjectTagging(r *request, name, key string) (*restResponse, error) {
+	object, err := s.findObject(name, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &s3.GetObjectTaggingOutput{
+		TagSet: []*s3.Tag{},
+	}
+
+	for _, k := range sortedKeys(object.tags) {
+		output.TagSet = append(output.TagSet, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(object.tags[k]),
+		})
+	}
+
+	return s3XMLResponse("Tagging", output)
+}
+
+func (s *s3Service) putObjectTagging(r *request, name, key string) (*restResponse, error) {
+	object, err := s.findObject(name, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var input s3.Tagging
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	object.tags = nil
+
+	for _, tag := range input.TagSet {
+		object.tags = object.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	return &restResponse{}, nil
+}
+
+func (s *s3Service) deleteObjectTagging(r *request, name, key string) (*restResponse, error) {
+	object, err := s.findObject(name, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object.tags = nil
+
+	return &restResponse{
+		statusCode: http.StatusNoContent,
+	}, nil
+}
+
+func (s *s3Service) findBucket(name string) (*s3Bucket, error) {
+	bucket, ok := s.buckets.get(name)
+
+	if !ok {
+		return nil, newAPIError(http.StatusNotFound, s3.ErrCodeNoSuchBucket, "The specified bucket does not exist")
+	}
+
+	return bucket, nil
+}
+
+func (s *s3Service) findObject(bucketName, key string) (*s3Object, error) {
+	bucket, err := s.findBucket(bucketName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := bucket.objects[key]
+
+	if !ok {
+		return nil, s3NoSuchKeyError()
+	}
+
+	return object, nil
+}
+
+// listObjects returns the bucket's objects whose keys start with prefix,
+// rolling up keys that contain delimiter after the prefix into common prefixes.
+func (b *s3Bucket) listObjects(prefix, delimiter string) ([]*s3.Object, []*s3.CommonPrefix) {
+	var contents []*s3.Object
+	var commonPrefixes []*s3.CommonPrefix
+	seen := make(map[string]bool)
+
+	for _, key := range sortedKeys(b.objects) {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		if delimiter != "" {
+			if i := strings.Index(strings.TrimPrefix(key, prefix), delimiter); i >= 0 {
+				commonPrefix := key[:len(prefix)+i+len(delimiter)]
+
+				if !seen[commonPrefix] {
+					seen[commonPrefix] = true
+					commonPrefixes = append(commonPrefixes, &s3.CommonPrefix{Prefix: aws.String(commonPrefix)})
+				}
+
+				continue
+			}
+		}
+
+		object := b.objects[key]
+		contents = append(contents, &s3.Object{
+			ETag:         aws.String(object.etag),
+			Key:          aws.String(key),
+			LastModified: aws.Time(object.lastModified),
+			Size:         aws.Int64(int64(len(object.body))),
+			StorageClass: aws.String(s3.ObjectStorageClassStandard),
+		})
+	}
+
+	return contents, commonPrefixes
+}
+
+func (o *s3Object) header() http.Header {
+	header := http.Header{
+		"Content-Length": []string{strconv.Itoa(len(o.body))},
+		"Content-Type":   []string{o.contentType},
+		"Etag":           []string{o.etag},
+		"Last-Modified":  []string{o.lastModified.UTC().Format(http.TimeFormat)},
+	}
+
+	for _, k := range sortedKeys(o.metadata) {
+		header.Set("X-Amz-Meta-"+k, o.metadata[k])
+	}
+
+	if len(o.tags) > 0 {
+		header.Set("X-Amz-Tagging-Count", strconv.Itoa(len(o.tags)))
+	}
+
+	return header
+}
+
+func s3XMLResponse(name string, output interface{}) (*restResponse, error) {
+	var body bytes.Buffer
+
+	body.WriteString(xml.Header)
+
+	if err := encodeXML(&body, name, output); err != nil {
+		return nil, newAPIError(http.StatusInternalServerError, "InternalError", err.Error())
+	}
+
+	return &restResponse{
+		body: body.Bytes(),
+	}, nil
+}
+
+func s3Owner(r *request) *s3.Owner {
+	return &s3.Owner{
+		DisplayName: aws.String("fakeaws"),
+		ID:          aws.String(s3CanonicalUserID(r.accountID)),
+	}
+}
+
+// s3CanonicalUserID returns a stable canonical user ID for an AWS account.
+func s3CanonicalUserID(accountID string) string {
+	return fmt.Sprintf("%064x", []byte(accountID))
+}
+
+func s3DefaultBucketACL(r *request, _ *s3Bucket) string {
+	id := s3CanonicalUserID(r.accountID)
+
+	return fmt.Sprintf(`<AccessControlPolicy xmlns="%[1]s"><Owner><ID>%[2]s</ID><DisplayName>fakeaws</DisplayName></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>%[2]s</ID><DisplayName>fakeaws</DisplayName></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`, s3Namespace, id)
+}
+
+func s3NoSuchKeyError() *apiError {
+	return newAPIError(http.StatusNotFound, s3.ErrCodeNoSuchKey, "The specified key does not exist.")
+}
+
+// s3IsListObjectsQuery returns whether a bucket GET request's query string only contains object listing parameters.
+func s3IsListObjectsQuery(query url.Values) bool {
+	for k := range query {
+		switch k {
+		case "continuation-token", "delimiter", "encoding-type", "fetch-owner", "list-type", "marker", "max-keys", "prefix", "start-after":
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,545 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	secretsManagerVersionStageCurrent  = "AWSCURRENT"
+	secretsManagerVersionStagePrevious = "AWSPREVIOUS"
+)
+
+type secretsManagerService struct {
+	secrets *store[secretsManagerSecret]
+	server  *Server
+}
+
+type secretsManagerSecret struct {
+	description *secretsmanager.DescribeSecretOutput
+	policy      *string
+	tags        tags
+	versions    map[string]*secretsManagerSecretVersion
+}
+
+type secretsManagerSecretVersion struct {
+	createdDate  time.Time
+	secretBinary []byte
+	secretString *string
+	stages       []string
+}
+
+func newSecretsManagerService(server *Server) *service {
+	s := &secretsManagerService{
+		secrets: newStore[secretsManagerSecret](),
+		server:  server,
+	}
+
+	return &service{
+		name:         names.SecretsManager,
+		protocol:     protocolJSON,
+		jsonVersion:  "1.1",
+		targetPrefix: "secretsmanager",
+		operations: map[string]handlerFunc{
+			"CreateSecret":             handle(s.createSecret),
+			"DeleteResourcePolicy":     handle(s.deleteResourcePolicy),
+			"DeleteSecret":             handle(s.deleteSecret),
+			"DescribeSecret":           handle(s.describeSecret),
+			"GetResourcePolicy":        handle(s.getResourcePolicy),
+			"GetSecretValue":           handle(s.getSecretValue),
+			"ListSecretVersionIds":     handle(s.listSecretVersionIDs),
+			"ListSecrets":              handle(s.listSecrets),
+			"PutResourcePolicy":        handle(s.putResourcePolicy),
+			"PutSecretValue":           handle(s.putSecretValue),
+			"RestoreSecret":            handle(s.restoreSecret),
+			"TagResource":              handle(s.tagResource),
+			"UntagResource":            handle(s.untagResource),
+			"UpdateSecret":             handle(s.updateSecret),
+			"UpdateSecretVersionStage": handle(s.updateSecretVersionStage),
+		},
+	}
+}
+
+func (s *secretsManagerService) createSecret(r *request, input *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error) {
+	name := aws.StringValue(input.Name)
+
+	for _, secret := range s.secrets.items {
+		if aws.StringValue(secret.description.Name) == name {
+			if secret.description.DeletedDate != nil {
+				return nil, newAPIError(http.StatusBadRequest, secretsmanager.ErrCodeInvalidRequestException, "You can't create this secret because a secret with this name is already scheduled for deletion.")
+			}
+
+			return nil, newAPIError(http.StatusBadRequest, secretsmanager.ErrCodeResourceExistsException, fmt.Sprintf("The operation failed because the secret %s already exists.", name))
+		}
+	}
+
+	now := time.Now()
+	arn := r.arn("secretsmanager", r.region, fmt.Sprintf("secret:%s-%06d", name, r.server.nextID()%1000000))
+	secret := &secretsManagerSecret{
+		description: &secretsmanager.DescribeSecretOutput{
+			ARN:             aws.String(arn),
+			CreatedDate:     aws.Time(now),
+			Description:     input.Description,
+			KmsKeyId:        input.KmsKeyId,
+			LastChangedDate: aws.Time(now),
+			Name:            aws.String(name),
+			RotationEnabled: aws.Bool(false),
+		},
+		versions: make(map[string]*secretsManagerSecretVersion),
+	}
+
+	for _, tag := range input.Tags {
+		secret.tags = secret.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	output := &secretsmanager.CreateSecretOutput{
+		ARN:  aws.String(arn),
+		Name: aws.String(name),
+	}
+
+	if input.SecretString != nil || input.SecretBinary != nil {
+		output.VersionId = aws.String(secret.putVersion(r, input.ClientRequestToken, input.SecretString, input.SecretBinary, nil))
+	}
+
+	s.secrets.create(arn, secret, r.server.options.EventualConsistencyReads)
+
+	return output, nil
+}
+
+func (s *secretsManagerService) deleteSecret(r *request, input *secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.StringValue(secret.description.ARN)
+	deletionDate := time.Now()
+
+	if aws.BoolValue(input.ForceDeleteWithoutRecovery) {
+		s.secrets.delete(arn)
+	} else {
+		recoveryWindowInDays := aws.Int64Value(input.RecoveryWindowInDays)
+		if recoveryWindowInDays == 0 {
+			recoveryWindowInDays = 30
+		}
+
+		deletionDate = deletionDate.AddDate(0, 0, int(recoveryWindowInDays))
+		secret.description.DeletedDate = aws.Time(deletionDate)
+	}
+
+	return &secretsmanager.DeleteSecretOutput{
+		ARN:          aws.String(arn),
+		DeletionDate: aws.Time(deletionDate),
+		Name:         secret.description.Name,
+	}, nil
+}
+
+func (s *secretsManagerService) restoreSecret(r *request, input *secretsmanager.RestoreSecretInput) (*secretsmanager.RestoreSecretOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	secret.description.DeletedDate = nil
+
+	return &secretsmanager.RestoreSecretOutput{
+		ARN:  secret.description.ARN,
+		Name: secret.description.Name,
+	}, nil
+}
+
+func (s *secretsManagerService) describeSecret(r *request, input *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := *secret.description
+	output.Tags = secretsManagerTags(secret.tags)
+	output.VersionIdsToStages = make(map[string][]*string)
+
+	for id, version := range secret.versions {
+		output.VersionIdsToStages[id] = aws.StringSlice(version.stages)
+	}
+
+	return &output, nil
+}
+
+func (s *secretsManagerService) listSecrets(r *request, input *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error) {
+	output := &secretsmanager.ListSecretsOutput{
+		SecretList: []*secretsmanager.SecretListEntry{},
+	}
+
+	for _, secret := range s.secrets.list() {
+		if secret.description.DeletedDate != nil && !aws.BoolValue(input.IncludePlannedDeletion) {
+			continue
+		}
+
+		output.SecretList = append(output.SecretList, &secretsmanager.SecretListEntry{
+			ARN:             secret.description.ARN,
+			CreatedDate:     secret.description.CreatedDate,
+			DeletedDate:     secret.description.DeletedDate,
+			Description:     secret.description.Description,
+			KmsKeyId:        secret.description.KmsKeyId,
+			LastChangedDate: secret.description.LastChangedDate,
+			Name:            secret.description.Name,
+			RotationEnabled: secret.description.RotationEnabled,
+			Tags:            secretsManagerTags(secret.tags),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) updateSecret(r *request, input *secretsmanager.UpdateSecretInput) (*secretsmanager.UpdateSecretOutput, error) {
+	secret, err := s.findActiveSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Description != nil {
+		secret.description.Description = input.Description
+	}
+
+	if input.KmsKeyId != nil {
+		secret.description.KmsKeyId = input.KmsKeyId
+	}
+
+	secret.description.LastChangedDate = aws.Time(time.Now())
+
+	output := &secretsmanager.UpdateSecretOutput{
+		ARN:  secret.description.ARN,
+		Name: secret.description.Name,
+	}
+
+	if input.SecretString != nil || input.SecretBinary != nil {
+		output.VersionId = aws.String(secret.putVersion(r, input.ClientRequestToken, input.SecretString, input.SecretBinary, nil))
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) getSecretValue(r *request, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	secret, err := s.findActiveSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	id, version := secret.findVersion(aws.StringValue(input.VersionId), aws.StringValue(input.VersionStage))
+
+	if version == nil {
+		return nil, newAPIError(http.StatusBadRequest, secretsmanager.ErrCodeResourceNotFoundException, "Secrets Manager can't find the specified secret value.")
+	}
+
+	return &secretsmanager.GetSecretValueOutput{
+		ARN:           secret.description.ARN,
+		CreatedDate:   aws.Time(version.createdDate),
+		Name:          secret.description.Name,
+		SecretBinary:  version.secretBinary,
+		SecretString:  version.secretString,
+		VersionId:     aws.String(id),
+		VersionStages: aws.StringSlice(version.stages),
+	}, nil
+}
+
+func (s *secretsManagerService) putSecretValue(r *request, input *secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error) {
+	secret, err := s.findActiveSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	id := secret.putVersion(r, input.ClientRequestToken, input.SecretString, input.SecretBinary, aws.StringValueSlice(input.VersionStages))
+
+	return &secretsmanager.PutSecretValueOutput{
+		ARN:           secret.description.ARN,
+		Name:          secret.description.Name,
+		VersionId:     aws.String(id),
+		VersionStages: aws.StringSlice(secret.versions[id].stages),
+	}, nil
+}
+
+func (s *secretsManagerService) updateSecretVersionStage(r *request, input *secretsmanager.UpdateSecretVersionStageInput) (*secretsmanager.UpdateSecretVersionStageOutput, error) {
+	secret, err := s.findActiveSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stage := aws.StringValue(input.VersionStage)
+
+	if id := aws.StringValue(input.RemoveFromVersionId); id != "" {
+		if version, ok := secret.versions[id]; ok {
+			version.stages = removeString(version.stages, stage)
+		}
+	}
+
+	if id := aws.StringValue(input.MoveToVersionId); id != "" {
+		version, ok := secret.versions[id]
+
+		if !ok {
+			return nil, newAPIError(http.StatusBadRequest, secretsmanager.ErrCodeResourceNotFoundException, fmt.Sprintf("Secret version %s not found.", id))
+		}
+
+		secret.moveStage(stage, id)
+		version.stages = append(version.stages, stage)
+	}
+
+	return &secretsmanager.UpdateSecretVersionStageOutput{
+		ARN:  secret.description.ARN,
+		Name: secret.description.Name,
+	}, nil
+}
+
+func (s *secretsManagerService) listSecretVersionIDs(r *request, input *secretsmanager.ListSecretVersionIdsInput) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &secretsmanager.ListSecretVersionIdsOutput{
+		ARN:      secret.description.ARN,
+		Name:     secret.description.Name,
+		Versions: []*secretsmanager.SecretVersionsListEntry{},
+	}
+
+	for _, id := range sortedKeys(secret.versions) {
+		version := secret.versions[id]
+
+		if len(version.stages) == 0 && !aws.BoolValue(input.IncludeDeprecated) {
+			continue
+		}
+
+		output.Versions = append(output.Versions, &secretsmanager.SecretVersionsListEntry{
+			CreatedDate:   aws.Time(version.createdDate),
+			VersionId:     aws.String(id),
+			VersionStages: aws.StringSlice(version.stages),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) getResourcePolicy(r *request, input *secretsmanager.GetResourcePolicyInput) (*secretsmanager.GetResourcePolicyOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretsmanager.GetResourcePolicyOutput{
+		ARN:            secret.description.ARN,
+		Name:           secret.description.Name,
+		ResourcePolicy: secret.policy,
+	}, nil
+}
+
+func (s *secretsManagerService) putResourcePolicy(r *request, input *secretsmanager.PutResourcePolicyInput) (*secretsmanager.PutResourcePolicyOutput, error) {
+	secret, err := s.findActiveSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	secret.policy = input.ResourcePolicy
+
+	return &secretsmanager.PutResourcePolicyOutput{
+		ARN:  secret.description.ARN,
+		Name: secret.description.Name,
+	}, nil
+}
+
+func (s *secretsManagerService) deleteResourcePolicy(r *request, input *secretsmanager.DeleteResourcePolicyInput) (*secretsmanager.DeleteResourcePolicyOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	secret.policy = nil
+
+	return &secretsmanager.DeleteResourcePolicyOutput{
+		ARN:  secret.description.ARN,
+		Name: secret.description.Name,
+	}, nil
+}
+
+func (s *secretsManagerService) tagResource(r *request, input *secretsmanager.TagResourceInput) (*secretsmanager.TagResourceOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		secret.tags = secret.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	return &secretsmanager.TagResourceOutput{}, nil
+}
+
+func (s *secretsManagerService) untagResource(r *request, input *secretsmanager.UntagResourceInput) (*secretsmanager.UntagResourceOutput, error) {
+	secret, err := s.findSecret(input.SecretId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	secret.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &secretsmanager.UntagResourceOutput{}, nil
+}
+
+// findSecret returns the secret with the specified name or ARN, including secrets scheduled for deletion.
+func (s *secretsManagerService) findSecret(id *string) (*secretsManagerSecret, error) {
+	v := aws.StringValue(id)
+
+	if !strings.HasPrefix(v, "arn:") {
+		for arn, secret := range s.secrets.items {
+			if aws.StringValue(secret.description.Name) == v {
+				v = arn
+				break
+			}
+		}
+	}
+
+	secret, ok := s.secrets.get(v)
+
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, secretsmanager.ErrCodeResourceNotFoundException, "Secrets Manager can't find the specified secret.")
+	}
+
+	return secret, nil
+}
+
+// findActiveSecret returns the secret with the specified name or ARN, if it is not scheduled for deletion.
+func (s *secretsManagerService) findActiveSecret(id *string) (*secretsManagerSecret, error) {
+	secret, err := s.findSecret(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if secret.description.DeletedDate != nil {
+		return nil, newAPIError(http.StatusBadRequest, secretsmanager.ErrCodeInvalidRequestException, "You can't perform this operation on the secret because it was marked for deletion.")
+	}
+
+	return secret, nil
+}
+
+// putVersion adds a new version of the secret's value and returns its ID.
+// Unless other stages are specified, the new version becomes AWSCURRENT.
+func (s *secretsManagerSecret) putVersion(r *request, clientRequestToken, secretString *string, secretBinary []byte, stages []string) string {
+	id := aws.StringValue(clientRequestToken)
+	if id == "" {
+		id = fmt.Sprintf("%08d-0000-0000-0000-000000000000", r.server.nextID())
+	}
+
+	if _, ok := s.versions[id]; ok {
+		// Idempotent retry.
+		return id
+	}
+
+	if len(stages) == 0 {
+		stages = []string{secretsManagerVersionStageCurrent}
+	}
+
+	for _, stage := range stages {
+		s.moveStage(stage, id)
+	}
+
+	s.versions[id] = &secretsManagerSecretVersion{
+		createdDate:  time.Now(),
+		secretBinary: secretBinary,
+		secretString: secretString,
+		stages:       stages,
+	}
+	s.description.LastChangedDate = aws.Time(time.Now())
+
+	return id
+}
+
+// moveStage removes a staging label from the version that has it, in preparation for attaching it to another version.
+// Moving AWSCURRENT attaches AWSPREVIOUS to the version which had it.
+func (s *secretsManagerSecret) moveStage(stage, toID string) {
+	for id, version := range s.versions {
+		if id == toID || !containsString(version.stages, stage) {
+			continue
+		}
+
+		version.stages = removeString(version.stages, stage)
+
+		if stage == secretsManagerVersionStageCurrent {
+			for _, v := range s.versions {
+				v.stages = removeString(v.stages, secretsManagerVersionStagePrevious)
+			}
+
+			version.stages = append(version.stages, secretsManagerVersionStagePrevious)
+		}
+	}
+}
+
+func (s *secretsManagerSecret) findVersion(id, stage string) (string, *secretsManagerSecretVersion) {
+	if id != "" {
+		return id, s.versions[id]
+	}
+
+	if stage == "" {
+		stage = secretsManagerVersionStageCurrent
+	}
+
+	for id, version := range s.versions {
+		if containsString(version.stages, stage) {
+			return id, version
+		}
+	}
+
+	return "", nil
+}
+
+func secretsManagerTags(t tags) []*secretsmanager.Tag {
+	var secretsManagerTags []*secretsmanager.Tag
+
+	for _, k := range sortedKeys(t) {
+		secretsManagerTags = append(secretsManagerTags, &secretsmanager.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(t[k]),
+		})
+	}
+
+	return secretsManagerTags
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeString(list []string, s string) []string {
+	var result []string
+
+	for _, v := range list {
+		if v != s {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,277 @@
+// Package fakeaws provides an in-memory fake of a core set of AWS services for use in provider unit tests.
+//
+// The fake server speaks the JSON, Query, REST-JSON and REST-XML protocols and keeps per-service state,
+// so that resource Create, Read, Update and Delete functions can be exercised without network access.
+// Point the provider at the fake server using Server.Endpoints or Server.ProviderConfig.
+//
+// The following services are supported: CloudWatch Logs, DynamoDB, IAM, Resource Explorer, S3, Secrets Manager, SNS, SQS, SSM and STS.
+package fakeaws
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID used by the fake server unless overridden.
+	DefaultAccountID = "123456789012"
+	// DefaultRegion is the AWS Region assumed for requests that do not specify one.
+	DefaultRegion = "us-east-1"
+
+	// AccessKey and SecretKey are the static credentials used to sign requests to the fake server.
+	// The fake server does not verify signatures.
+	AccessKey = "mock-access-key"
+	SecretKey = "mock-secret-key"
+)
+
+// Server is an in-memory fake of a core set of AWS services.
+type Server struct {
+	// URL is the base URL of the fake server, e.g. `http://127.0.0.1:12345`.
+	URL string
+
+	httpServer *httptest.Server
+	options    Options
+
+	mu       sync.Mutex
+	calls    map[string]int
+	faults   map[string][]*fault
+	ids      int
+	services map[string]*service
+}
+
+type Options struct {
+	AccountID                string // AWS account ID of the caller.
+	EventualConsistencyReads int    // Number of reads for which a newly created resource is reported as not found.
+}
+
+type OptionsFunc func(*Options)
+
+func WithAccountID(accountID string) OptionsFunc {
+	return func(o *Options) {
+		o.AccountID = accountID
+	}
+}
+
+// WithEventualConsistency makes newly created resources invisible to the specified number of reads,
+// simulating the eventual consistency of AWS APIs.
+func WithEventualConsistency(reads int) OptionsFunc {
+	return func(o *Options) {
+		o.EventualConsistencyReads = reads
+	}
+}
+
+// NewServer starts a new fake server.
+// The caller must call Close when finished to shut it down.
+func NewServer(optFns ...OptionsFunc) *Server {
+	s := &Server{
+		calls:  make(map[string]int),
+		faults: make(map[string][]*fault),
+		options: Options{
+			AccountID: DefaultAccountID,
+		},
+	}
+
+	for _, fn := range optFns {
+		fn(&s.options)
+	}
+
+	s.services = map[string]*service{}
+	for _, svc := range []*service{
+		newDynamoDBService(s),
+		newIAMService(s),
+		newLogsService(s),
+		newResourceExplorer2Service(s),
+		newS3Service(s),
+		newSecretsManagerService(s),
+		newSNSService(s),
+		newSQSService(s),
+		newSSMService(s),
+		newSTSService(s),
+	} {
+		s.services[svc.name] = svc
+	}
+
+	s.httpServer = httptest.NewServer(s)
+	s.URL = s.httpServer.URL
+
+	return s
+}
+
+// NewTestServer starts a new fake server which is shut down when the test completes.
+func NewTestServer(t testing.TB, optFns ...OptionsFunc) *Server {
+	t.Helper()
+
+	s := NewServer(optFns...)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Close shuts down the fake server.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// Endpoints returns service endpoint overrides, keyed by service package name, suitable for `conns.Config.Endpoints`.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(s.services))
+
+	for _, svc := range s.services {
+		endpoints[svc.name] = s.URL
+	}
+
+	return endpoints
+}
+
+// ProviderConfig returns a provider configuration block that sends all AWS API requests to the fake server.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key                  = %[1]q
+  secret_key                  = %[2]q
+  region                      = %[3]q
+  endpoint_url                = %[4]q
+  emulator                    = true
+  skip_metadata_api_check     = true
+}
+`, AccessKey, SecretKey, DefaultRegion, s.URL)
+}
+
+// Calls returns the number of calls made to the specified service operation, e.g. `Calls(names.SQS, "CreateQueue")`.
+func (s *Server) Calls(service, operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls[operationKey(service, operation)]
+}
+
+type fault struct {
+	err   *apiError
+	times int
+}
+
+// InjectError makes the next `times` calls to the specified service operation fail with the specified AWS error code.
+// Use it to exercise retry and error handling logic.
+func (s *Server) InjectError(service, operation string, times int, statusCode int, code, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := operationKey(service, operation)
+	s.faults[key] = append(s.faults[key], &fault{
+		err:   newAPIError(statusCode, code, message),
+		times: times,
+	})
+}
+
+// nextID returns a new identifier, unique within the server.
+// Identifiers are deterministic so that test results are reproducible.
+func (s *Server) nextID() int {
+	s.ids++
+	return s.ids
+}
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/\d{8}/([^/]+)/([^/]+)/aws4_request`)
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, httpReq *http.Request) {
+	body, err := io.ReadAll(httpReq.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	region, signingName := DefaultRegion, ""
+	if m := credentialScopeRegexp.FindStringSubmatch(httpReq.Header.Get("Authorization")); m != nil {
+		region, signingName = m[1], m[2]
+	}
+
+	svc := s.serviceFor(signingName, httpReq)
+
+	if svc == nil {
+		http.Error(w, fmt.Sprintf("unsupported service %q", signingName), http.StatusNotImplemented)
+		return
+	}
+
+	r := &request{
+		Request:   httpReq,
+		accountID: s.options.AccountID,
+		body:      body,
+		region:    region,
+		server:    s,
+		service:   svc,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc.serve(w, r)
+}
+
+// serviceFor returns the service for a request, identified by its signing name or, for unsigned requests, by its target.
+func (s *Server) serviceFor(signingName string, r *http.Request) *service {
+	if svc, ok := s.services[signingName]; ok {
+		return svc
+	}
+
+	for _, svc := range s.services {
+		if svc.signingName != "" && svc.signingName == signingName {
+			return svc
+		}
+	}
+
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		prefix, _, _ := strings.Cut(target, ".")
+
+		for _, svc := range s.services {
+			if svc.targetPrefix == prefix {
+				return svc
+			}
+		}
+	}
+
+	return nil
+}
+
+// call records a call to an operation and returns any injected error.
+// The caller must hold s.mu.
+func (s *Server) call(service, operation string) *apiError {
+	key := operationKey(service, operation)
+	s.calls[key]++
+
+	if faults := s.faults[key]; len(faults) > 0 {
+		f := faults[0]
+		f.times--
+		if f.times <= 0 {
+			s.faults[key] = faults[1:]
+		}
+
+		return f.err
+	}
+
+	return nil
+}
+
+// ServiceNames returns the service package names supported by the fake server.
+func (s *Server) ServiceNames() []string {
+	var serviceNames []string
+
+	for name := range s.services {
+		serviceNames = append(serviceNames, name)
+	}
+
+	sort.Strings(serviceNames)
+
+	return serviceNames
+}
+
+func operationKey(service, operation string) string {
+	return service + ":" + operation
+}
@@ -0,0 +1,959 @@
+package fakeaws_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/resourceexplorer2"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func newSession(t *testing.T, s *fakeaws.Server) *session.Session {
+	t.Helper()
+
+	return session.Must(session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(fakeaws.AccessKey, fakeaws.SecretKey, ""),
+		Endpoint:         aws.String(s.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String(fakeaws.DefaultRegion),
+		S3ForcePathStyle: aws.Bool(true),
+	}))
+}
+
+func TestSTS(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t, fakeaws.WithAccountID("111122223333"))
+	conn := sts.New(newSession(t, s))
+
+	output, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Account), "111122223333"; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := sqs.New(newSession(t, s))
+
+	createOutput, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String("test"),
+		Attributes: aws.StringMap(map[string]string{
+			sqs.QueueAttributeNameVisibilityTimeout: "60",
+		}),
+		Tags: aws.StringMap(map[string]string{
+			"Name": "test",
+		}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	queueURL := aws.StringValue(createOutput.QueueUrl)
+
+	attributesOutput, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       aws.String(queueURL),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(attributesOutput.Attributes[sqs.QueueAttributeNameVisibilityTimeout]), "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+
+	if got, want := aws.StringValue(attributesOutput.Attributes[sqs.QueueAttributeNameQueueArn]), "arn:aws:sqs:us-east-1:123456789012:test"; got != want {
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+
+	tagsOutput, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(queueURL),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(tagsOutput.Tags["Name"]), "test"; got != want {
+		t.Errorf("Tags[Name] = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       aws.String(queueURL),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		t.Errorf("expected %s error, got: %v", sqs.ErrCodeQueueDoesNotExist, err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := sns.New(newSession(t, s))
+
+	createOutput, err := conn.CreateTopic(&sns.CreateTopicInput{
+		Name: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	topicARN := aws.StringValue(createOutput.TopicArn)
+
+	if _, err := conn.SetTopicAttributes(&sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Test"),
+		TopicArn:       aws.String(topicARN),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attributesOutput, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicARN),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(attributesOutput.Attributes["DisplayName"]), "Test"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+
+	if _, err := conn.Subscribe(&sns.SubscribeInput{
+		Endpoint: aws.String("arn:aws:sqs:us-east-1:123456789012:test"),
+		Protocol: aws.String("sqs"),
+		TopicArn: aws.String(topicARN),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	subscriptionsOutput, err := conn.ListSubscriptionsByTopic(&sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicARN),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(subscriptionsOutput.Subscriptions), 1; got != want {
+		t.Errorf("len(Subscriptions) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(&sns.DeleteTopicInput{TopicArn: aws.String(topicARN)}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(&sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicARN),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		t.Errorf("expected %s error, got: %v", sns.ErrCodeNotFoundException, err)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := iam.New(newSession(t, s))
+
+	const assumeRolePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	createOutput, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(assumeRolePolicy),
+		Path:                     aws.String("/test/"),
+		RoleName:                 aws.String("test"),
+		Tags: []*iam.Tag{{
+			Key:   aws.String("Name"),
+			Value: aws.String("test"),
+		}},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(createOutput.Role.Arn), "arn:aws:iam::123456789012:role/test/test"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.PutRolePolicy(&iam.PutRolePolicyInput{
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`),
+		PolicyName:     aws.String("test"),
+		RoleName:       aws.String("test"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
+		t.Errorf("expected %s error, got: %v", iam.ErrCodeDeleteConflictException, err)
+	}
+
+	if _, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+		PolicyName: aws.String("test"),
+		RoleName:   aws.String("test"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		t.Errorf("expected %s error, got: %v", iam.ErrCodeNoSuchEntityException, err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := ssm.New(newSession(t, s))
+
+	for _, name := range []string{"/app/a", "/app/b", "/app/nested/c"} {
+		if _, err := conn.PutParameter(&ssm.PutParameterInput{
+			Name:  aws.String(name),
+			Type:  aws.String(ssm.ParameterTypeString),
+			Value: aws.String(name),
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	_, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String("/app/a"),
+		Type:  aws.String(ssm.ParameterTypeString),
+		Value: aws.String("updated"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterAlreadyExists) {
+		t.Errorf("expected %s error, got: %v", ssm.ErrCodeParameterAlreadyExists, err)
+	}
+
+	if _, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String("/app/a"),
+		Overwrite: aws.Bool(true),
+		Type:      aws.String(ssm.ParameterTypeString),
+		Value:     aws.String("updated"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	getOutput, err := conn.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String("/app/a"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(getOutput.Parameter.Value), "updated"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+
+	if got, want := aws.Int64Value(getOutput.Parameter.Version), int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+
+	for _, testCase := range []struct {
+		recursive bool
+		want      int
+	}{
+		{false, 2},
+		{true, 3},
+	} {
+		output, err := conn.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String("/app"),
+			Recursive: aws.Bool(testCase.recursive),
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := len(output.Parameters); got != testCase.want {
+			t.Errorf("GetParametersByPath(Recursive: %t) returned %d parameters, want %d", testCase.recursive, got, testCase.want)
+		}
+	}
+
+	if _, err := conn.DeleteParameter(&ssm.DeleteParameterInput{Name: aws.String("/app/a")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String("/app/a"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) {
+		t.Errorf("expected %s error, got: %v", ssm.ErrCodeParameterNotFound, err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := dynamodb.New(newSession(t, s))
+
+	createOutput, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{{
+			AttributeName: aws.String("id"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		}},
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema: []*dynamodb.KeySchemaElement{{
+			AttributeName: aws.String("id"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		}},
+		TableName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tableARN := createOutput.TableDescription.TableArn
+
+	if _, err := conn.UpdateTimeToLive(&dynamodb.UpdateTimeToLiveInput{
+		TableName: aws.String("test"),
+		TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+			AttributeName: aws.String("expires"),
+			Enabled:       aws.Bool(true),
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ttlOutput, err := conn.DescribeTimeToLive(&dynamodb.DescribeTimeToLiveInput{
+		TableName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(ttlOutput.TimeToLiveDescription.TimeToLiveStatus), dynamodb.TimeToLiveStatusEnabled; got != want {
+		t.Errorf("TimeToLiveStatus = %q, want %q", got, want)
+	}
+
+	if _, err := conn.TagResource(&dynamodb.TagResourceInput{
+		ResourceArn: tableARN,
+		Tags: []*dynamodb.Tag{{
+			Key:   aws.String("Name"),
+			Value: aws.String("test"),
+		}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{
+		ResourceArn: tableARN,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(tagsOutput.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.PutItem(&dynamodb.PutItemInput{
+		Item: map[string]*dynamodb.AttributeValue{
+			"id":    {S: aws.String("1")},
+			"value": {N: aws.String("42")},
+		},
+		TableName: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	itemOutput, err := conn.GetItem(&dynamodb.GetItemInput{
+		Key: map[string]*dynamodb.AttributeValue{
+			"id": {S: aws.String("1")},
+		},
+		TableName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(itemOutput.Item["value"].N), "42"; got != want {
+		t.Errorf("Item[value] = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String("test"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		t.Errorf("expected %s error, got: %v", dynamodb.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := secretsmanager.New(newSession(t, s))
+
+	createOutput, err := conn.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String("test"),
+		SecretString: aws.String("v1"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secretARN := createOutput.ARN
+	firstVersionID := aws.StringValue(createOutput.VersionId)
+
+	if _, err := conn.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     secretARN,
+		SecretString: aws.String("v2"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, testCase := range []struct {
+		stage string
+		want  string
+	}{
+		{"AWSCURRENT", "v2"},
+		{"AWSPREVIOUS", "v1"},
+	} {
+		output, err := conn.GetSecretValue(&secretsmanager.GetSecretValueInput{
+			SecretId:     aws.String("test"),
+			VersionStage: aws.String(testCase.stage),
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := aws.StringValue(output.SecretString); got != testCase.want {
+			t.Errorf("SecretString(%s) = %q, want %q", testCase.stage, got, testCase.want)
+		}
+	}
+
+	output, err := conn.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId:  secretARN,
+		VersionId: aws.String(firstVersionID),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.SecretString), "v1"; got != want {
+		t.Errorf("SecretString = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteSecret(&secretsmanager.DeleteSecretInput{SecretId: secretARN}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	describeOutput, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{SecretId: secretARN})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if describeOutput.DeletedDate == nil {
+		t.Error("expected DeletedDate to be set")
+	}
+
+	_, err = conn.GetSecretValue(&secretsmanager.GetSecretValueInput{SecretId: secretARN})
+
+	if !tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeInvalidRequestException) {
+		t.Errorf("expected %s error, got: %v", secretsmanager.ErrCodeInvalidRequestException, err)
+	}
+
+	if _, err := conn.RestoreSecret(&secretsmanager.RestoreSecretInput{SecretId: secretARN}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := conn.DeleteSecret(&secretsmanager.DeleteSecretInput{
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+		SecretId:                   secretARN,
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.DescribeSecret(&secretsmanager.DescribeSecretInput{SecretId: secretARN})
+
+	if !tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
+		t.Errorf("expected %s error, got: %v", secretsmanager.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := s3.New(newSession(t, s))
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{
+		Bucket: aws.String("test"),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String("eu-west-1"),
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	locationOutput, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{
+		Bucket: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(locationOutput.LocationConstraint), "eu-west-1"; got != want {
+		t.Errorf("LocationConstraint = %q, want %q", got, want)
+	}
+
+	_, err = conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String("test"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		t.Errorf("expected NoSuchBucketPolicy error, got: %v", err)
+	}
+
+	const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::test/*"}]}`
+
+	if _, err := conn.PutBucketPolicy(&s3.PutBucketPolicyInput{
+		Bucket: aws.String("test"),
+		Policy: aws.String(policy),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	policyOutput, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(policyOutput.Policy), policy; got != want {
+		t.Errorf("Policy = %q, want %q", got, want)
+	}
+
+	if _, err := conn.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket: aws.String("test"),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(s3.BucketVersioningStatusEnabled),
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	versioningOutput, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(versioningOutput.Status), s3.BucketVersioningStatusEnabled; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+
+	for _, key := range []string{"a.txt", "dir/b.txt", "dir/c.txt"} {
+		if _, err := conn.PutObject(&s3.PutObjectInput{
+			Body:        bytes.NewReader([]byte(key)),
+			Bucket:      aws.String("test"),
+			ContentType: aws.String("text/plain"),
+			Key:         aws.String(key),
+			Metadata:    aws.StringMap(map[string]string{"origin": "test"}),
+			Tagging:     aws.String("Name=test"),
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	getOutput, err := conn.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("test"),
+		Key:    aws.String("dir/b.txt"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	body, err := io.ReadAll(getOutput.Body)
+	getOutput.Body.Close()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := string(body), "dir/b.txt"; got != want {
+		t.Errorf("Body = %q, want %q", got, want)
+	}
+
+	if got, want := aws.StringValue(getOutput.ContentType), "text/plain"; got != want {
+		t.Errorf("ContentType = %q, want %q", got, want)
+	}
+
+	if got, want := aws.StringValue(getOutput.Metadata["Origin"]), "test"; got != want {
+		t.Errorf("Metadata[Origin] = %q, want %q", got, want)
+	}
+
+	taggingOutput, err := conn.GetObjectTagging(&s3.GetObjectTaggingInput{
+		Bucket: aws.String("test"),
+		Key:    aws.String("a.txt"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(taggingOutput.TagSet), 1; got != want {
+		t.Errorf("len(TagSet) = %d, want %d", got, want)
+	}
+
+	listOutput, err := conn.ListObjectsV2(&s3.ListObjectsV2Input{
+		Bucket:    aws.String("test"),
+		Delimiter: aws.String("/"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(listOutput.Contents), 1; got != want {
+		t.Errorf("len(Contents) = %d, want %d", got, want)
+	}
+
+	if got, want := len(listOutput.CommonPrefixes), 1; got != want {
+		t.Errorf("len(CommonPrefixes) = %d, want %d", got, want)
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+		t.Errorf("expected BucketNotEmpty error, got: %v", err)
+	}
+
+	if _, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+		Bucket: aws.String("test"),
+		Delete: &s3.Delete{
+			Objects: []*s3.ObjectIdentifier{
+				{Key: aws.String("a.txt")},
+				{Key: aws.String("dir/b.txt")},
+				{Key: aws.String("dir/c.txt")},
+			},
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "NotFound") {
+		t.Errorf("expected NotFound error, got: %v", err)
+	}
+}
+
+func TestLogs(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := cloudwatchlogs.New(newSession(t, s))
+
+	putOutput, err := conn.PutDestination(&cloudwatchlogs.PutDestinationInput{
+		DestinationName: aws.String("test"),
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/test"),
+		TargetArn:       aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	destinationARN := aws.StringValue(putOutput.Destination.Arn)
+
+	if got, want := destinationARN, "arn:aws:logs:us-east-1:123456789012:destination:test"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.TagResource(&cloudwatchlogs.TagResourceInput{
+		ResourceArn: aws.String(destinationARN),
+		Tags:        aws.StringMap(map[string]string{"key1": "value1", "key2": "value2"}),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := conn.UntagResource(&cloudwatchlogs.UntagResourceInput{
+		ResourceArn: aws.String(destinationARN),
+		TagKeys:     aws.StringSlice([]string{"key1"}),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: aws.String(destinationARN),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValueMap(tagsOutput.Tags), map[string]string{"key2": "value2"}; len(got) != len(want) || got["key2"] != want["key2"] {
+		t.Errorf("Tags = %v, want %v", got, want)
+	}
+
+	describeOutput, err := conn.DescribeDestinations(&cloudwatchlogs.DescribeDestinationsInput{
+		DestinationNamePrefix: aws.String("te"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(describeOutput.Destinations), 1; got != want {
+		t.Errorf("len(Destinations) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteDestination(&cloudwatchlogs.DeleteDestinationInput{DestinationName: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.DeleteDestination(&cloudwatchlogs.DeleteDestinationInput{DestinationName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		t.Errorf("expected %s error, got: %v", cloudwatchlogs.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestResourceExplorer2(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := resourceexplorer2.New(newSession(t, s))
+
+	indexOutput, err := conn.CreateIndex(&resourceexplorer2.CreateIndexInput{
+		Tags: aws.StringMap(map[string]string{"key1": "value1"}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	viewOutput, err := conn.CreateView(&resourceexplorer2.CreateViewInput{
+		ViewName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	viewARN := aws.StringValue(viewOutput.View.ViewArn)
+
+	if _, err := conn.TagResource(&resourceexplorer2.TagResourceInput{
+		ResourceArn: aws.String(viewARN),
+		Tags:        aws.StringMap(map[string]string{"key1": "value1", "key2": "value2"}),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := conn.UntagResource(&resourceexplorer2.UntagResourceInput{
+		ResourceArn: aws.String(viewARN),
+		TagKeys:     aws.StringSlice([]string{"key1"}),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	getViewOutput, err := conn.GetView(&resourceexplorer2.GetViewInput{
+		ViewArn: aws.String(viewARN),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValueMap(getViewOutput.Tags), map[string]string{"key2": "value2"}; len(got) != len(want) || got["key2"] != want["key2"] {
+		t.Errorf("Tags = %v, want %v", got, want)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&resourceexplorer2.ListTagsForResourceInput{
+		ResourceArn: indexOutput.Arn,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(tagsOutput.Tags["key1"]), "value1"; got != want {
+		t.Errorf("Tags[key1] = %q, want %q", got, want)
+	}
+
+	if got, want := s.Calls(names.ResourceExplorer2, "TagResource"), 1; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteView(&resourceexplorer2.DeleteViewInput{ViewArn: aws.String(viewARN)}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetView(&resourceexplorer2.GetViewInput{
+		ViewArn: aws.String(viewARN),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, resourceexplorer2.ErrCodeResourceNotFoundException) {
+		t.Errorf("expected %s error, got: %v", resourceexplorer2.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestServer_eventualConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t, fakeaws.WithEventualConsistency(2))
+	conn := ssm.New(newSession(t, s))
+
+	if _, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String("test"),
+		Type:  aws.String(ssm.ParameterTypeString),
+		Value: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := conn.GetParameter(&ssm.GetParameterInput{
+			Name: aws.String("test"),
+		})
+
+		if !tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) {
+			t.Fatalf("read %d: expected %s error, got: %v", i, ssm.ErrCodeParameterNotFound, err)
+		}
+	}
+
+	if _, err := conn.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := s.Calls(names.SSM, "GetParameter"), 3; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+}
+
+func TestServer_InjectError(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := sqs.New(newSession(t, s))
+
+	s.InjectError(names.SQS, "ListQueues", 2, http.StatusBadRequest, "Throttling", "Rate exceeded")
+
+	for i := 0; i < 2; i++ {
+		_, err := conn.ListQueues(&sqs.ListQueuesInput{})
+
+		if !tfawserr.ErrCodeEquals(err, "Throttling") {
+			t.Fatalf("call %d: expected Throttling error, got: %v", i, err)
+		}
+	}
+
+	if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := s.Calls(names.SQS, "ListQueues"), 3; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+}
+
+func TestServer_unsupportedOperation(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+	conn := sqs.New(newSession(t, s))
+
+	_, err := conn.PurgeQueue(&sqs.PurgeQueueInput{
+		QueueUrl: aws.String(s.URL + "/123456789012/test"),
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "PurgeQueue") {
+		t.Errorf("expected unsupported operation error, got: %v", err)
+	}
+}
+
+func TestServer_ProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewTestServer(t)
+
+	config := s.ProviderConfig()
+
+	if !strings.Contains(config, s.URL) {
+		t.Errorf("expected provider configuration to contain %q, got: %s", s.URL, config)
+	}
+
+	endpoints := s.Endpoints()
+
+	for _, name := range s.ServiceNames() {
+		if got, want := endpoints[name], s.URL; got != want {
+			t.Errorf("Endpoints()[%s] = %q, want %q", name, got, want)
+		}
+	}
+}
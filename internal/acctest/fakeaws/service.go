@@ -0,0 +1,322 @@
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+type protocol int
+
+const (
+	protocolJSON protocol = iota
+	protocolQuery
+	protocolRESTJSON
+	protocolRESTXML
+)
+
+// handlerFunc handles a JSON, Query or REST-JSON protocol operation, returning the operation's output shape.
+type handlerFunc func(*request) (interface{}, error)
+
+// handle returns a handlerFunc for an operation whose input and output shapes are the AWS SDK for Go types I and O.
+func handle[I, O any](f func(*request, *I) (*O, error)) handlerFunc {
+	return func(r *request) (interface{}, error) {
+		input := new(I)
+
+		if err := r.decode(input); err != nil {
+			return nil, err
+		}
+
+		output, err := f(r, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output, nil
+	}
+}
+
+// restHandlerFunc handles a REST-XML protocol operation.
+type restHandlerFunc func(*request) (*restResponse, error)
+
+type restResponse struct {
+	body       []byte
+	header     http.Header
+	statusCode int
+}
+
+type service struct {
+	name        string // Service package name.
+	signingName string // Signing name, if different from the service package name.
+
+	protocol     protocol
+	jsonVersion  string // JSON protocol version, e.g. "1.1".
+	targetPrefix string // JSON protocol `X-Amz-Target` header prefix.
+	xmlns        string // Query protocol response namespace.
+
+	operations   map[string]handlerFunc
+	operationFor func(*request) string                    // REST-JSON protocol operation router.
+	route        func(*request) (string, restHandlerFunc) // REST-XML protocol request router.
+}
+
+type request struct {
+	*http.Request
+
+	accountID string
+	body      []byte
+	form      url.Values
+	operation string
+	region    string
+	server    *Server
+	service   *service
+}
+
+// arn returns an ARN for a resource in the request's partition, Region and account.
+func (r *request) arn(service, region, resource string) string {
+	return fmt.Sprintf("arn:aws:%s:%s:%s:%s", service, region, r.accountID, resource)
+}
+
+// decode decodes the request body into the specified input shape.
+func (r *request) decode(v interface{}) error {
+	switch r.service.protocol {
+	case protocolJSON, protocolRESTJSON:
+		if len(bytes.TrimSpace(r.body)) == 0 {
+			return nil
+		}
+
+		if err := jsonutil.UnmarshalJSON(v, bytes.NewReader(r.body)); err != nil {
+			return newAPIError(http.StatusBadRequest, "SerializationException", err.Error())
+		}
+
+	case protocolQuery:
+		if err := decodeQuery(r.form, v); err != nil {
+			return newAPIError(http.StatusBadRequest, "MalformedQueryString", err.Error())
+		}
+
+	case protocolRESTXML:
+		if len(bytes.TrimSpace(r.body)) == 0 {
+			return nil
+		}
+
+		if err := decodeXML(r.body, v); err != nil {
+			return newAPIError(http.StatusBadRequest, "MalformedXML", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (svc *service) serve(w http.ResponseWriter, r *request) {
+	var handler handlerFunc
+	var restHandler restHandlerFunc
+
+	switch svc.protocol {
+	case protocolJSON:
+		_, r.operation, _ = strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+		handler = svc.operations[r.operation]
+
+	case protocolQuery:
+		form, err := url.ParseQuery(string(r.body))
+
+		if err != nil {
+			svc.writeError(w, r, newAPIError(http.StatusBadRequest, "MalformedQueryString", err.Error()))
+			return
+		}
+
+		for k, v := range r.URL.Query() {
+			form[k] = append(form[k], v...)
+		}
+
+		r.form = form
+		r.operation = form.Get("Action")
+		handler = svc.operations[r.operation]
+
+	case protocolRESTJSON:
+		r.operation = svc.operationFor(r)
+		handler = svc.operations[r.operation]
+
+	case protocolRESTXML:
+		r.operation, restHandler = svc.route(r)
+	}
+
+	if handler == nil && restHandler == nil {
+		svc.writeError(w, r, svc.unknownOperationError(r))
+		return
+	}
+
+	if err := r.server.call(svc.name, r.operation); err != nil {
+		svc.writeError(w, r, err)
+		return
+	}
+
+	if restHandler != nil {
+		response, err := restHandler(r)
+
+		if err != nil {
+			svc.writeError(w, r, err)
+			return
+		}
+
+		svc.writeRESTResponse(w, response)
+		return
+	}
+
+	output, err := handler(r)
+
+	if err != nil {
+		svc.writeError(w, r, err)
+		return
+	}
+
+	svc.writeOutput(w, r, output)
+}
+
+func (svc *service) unknownOperationError(r *request) *apiError {
+	switch svc.protocol {
+	case protocolJSON:
+		return newAPIError(http.StatusBadRequest, "UnknownOperationException", fmt.Sprintf("operation %q is not supported by the fake %s service", r.operation, svc.name))
+	case protocolQuery:
+		return newAPIError(http.StatusBadRequest, "InvalidAction", fmt.Sprintf("operation %q is not supported by the fake %s service", r.operation, svc.name))
+	default:
+		return newAPIError(http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("%s %s is not supported by the fake %s service", r.Method, r.URL.RequestURI(), svc.name))
+	}
+}
+
+func (svc *service) writeOutput(w http.ResponseWriter, r *request, output interface{}) {
+	var body bytes.Buffer
+
+	switch svc.protocol {
+	case protocolJSON, protocolRESTJSON:
+		b, err := jsonutil.BuildJSON(output)
+
+		if err != nil {
+			svc.writeError(w, r, newAPIError(http.StatusInternalServerError, "InternalFailure", err.Error()))
+			return
+		}
+
+		if svc.protocol == protocolRESTJSON {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "application/x-amz-json-"+svc.jsonVersion)
+		}
+		body.Write(b)
+
+	case protocolQuery:
+		fmt.Fprintf(&body, `<%sResponse xmlns="%s">`, r.operation, svc.xmlns)
+
+		if err := encodeXML(&body, r.operation+"Result", output); err != nil {
+			svc.writeError(w, r, newAPIError(http.StatusInternalServerError, "InternalFailure", err.Error()))
+			return
+		}
+
+		fmt.Fprintf(&body, `<ResponseMetadata><RequestId>%s</RequestId></ResponseMetadata></%sResponse>`, requestID, r.operation)
+
+		w.Header().Set("Content-Type", "text/xml")
+	}
+
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body.Bytes()) //nolint:errcheck // Nothing useful can be done with the error.
+}
+
+func (svc *service) writeRESTResponse(w http.ResponseWriter, response *restResponse) {
+	for k, v := range response.header {
+		w.Header()[k] = v
+	}
+
+	if len(response.body) > 0 && w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/xml")
+	}
+
+	w.Header().Set("X-Amz-Request-Id", requestID)
+
+	statusCode := response.statusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.WriteHeader(statusCode)
+	w.Write(response.body) //nolint:errcheck // Nothing useful can be done with the error.
+}
+
+func (svc *service) writeError(w http.ResponseWriter, r *request, err error) {
+	apiErr, ok := err.(*apiError) //nolint:errorlint // Handlers return *apiError directly.
+	if !ok {
+		apiErr = newAPIError(http.StatusInternalServerError, "InternalFailure", err.Error())
+	}
+
+	var body []byte
+
+	switch svc.protocol {
+	case protocolJSON, protocolRESTJSON:
+		body, _ = json.Marshal(map[string]string{
+			"__type":  apiErr.code,
+			"message": apiErr.message,
+		})
+
+		if svc.protocol == protocolRESTJSON {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "application/x-amz-json-"+svc.jsonVersion)
+		}
+		w.Header().Set("X-Amzn-Errortype", apiErr.code)
+
+	case protocolQuery:
+		var buf bytes.Buffer
+
+		fmt.Fprint(&buf, `<ErrorResponse><Error><Type>Sender</Type><Code>`)
+		xml.EscapeText(&buf, []byte(apiErr.code)) //nolint:errcheck // Writes to a bytes.Buffer do not fail.
+		fmt.Fprint(&buf, `</Code><Message>`)
+		xml.EscapeText(&buf, []byte(apiErr.message)) //nolint:errcheck // Writes to a bytes.Buffer do not fail.
+		fmt.Fprintf(&buf, `</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, requestID)
+
+		body = buf.Bytes()
+		w.Header().Set("Content-Type", "text/xml")
+
+	case protocolRESTXML:
+		if r.Method != http.MethodHead {
+			var buf bytes.Buffer
+
+			fmt.Fprint(&buf, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>`)
+			xml.EscapeText(&buf, []byte(apiErr.code)) //nolint:errcheck // Writes to a bytes.Buffer do not fail.
+			fmt.Fprint(&buf, `</Code><Message>`)
+			xml.EscapeText(&buf, []byte(apiErr.message)) //nolint:errcheck // Writes to a bytes.Buffer do not fail.
+			fmt.Fprintf(&buf, `</Message><RequestId>%s</RequestId></Error>`, requestID)
+
+			body = buf.Bytes()
+			w.Header().Set("Content-Type", "application/xml")
+		}
+	}
+
+	w.WriteHeader(apiErr.statusCode)
+	w.Write(body) //nolint:errcheck // Nothing useful can be done with the error.
+}
+
+// requestID is the request ID returned in all responses.
+const requestID = "00000000-0000-0000-0000-000000000000"
+
+// apiError is an AWS API error returned by the fake server.
+type apiError struct {
+	code       string
+	message    string
+	statusCode int
+}
+
+func newAPIError(statusCode int, code, message string) *apiError {
+	return &apiError{
+		code:       code,
+		message:    message,
+		statusCode: statusCode,
+	}
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
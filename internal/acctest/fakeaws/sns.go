@@ -0,0 +1,346 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type snsService struct {
+	server        *Server
+	subscriptions *store[snsSubscription]
+	topics        *store[snsTopic]
+}
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+	tags       tags
+}
+
+type snsSubscription struct {
+	arn        string
+	attributes map[string]string
+	topicARN   string
+}
+
+func newSNSService(server *Server) *service {
+	s := &snsService{
+		server:        server,
+		subscriptions: newStore[snsSubscription](),
+		topics:        newStore[snsTopic](),
+	}
+
+	return &service{
+		name:     names.SNS,
+		protocol: protocolQuery,
+		xmlns:    "http://sns.amazonaws.com/doc/2010-03-31/",
+		operations: map[string]handlerFunc{
+			"CreateTopic":               handle(s.createTopic),
+			"DeleteTopic":               handle(s.deleteTopic),
+			"GetSubscriptionAttributes": handle(s.getSubscriptionAttributes),
+			"GetTopicAttributes":        handle(s.getTopicAttributes),
+			"ListSubscriptionsByTopic":  handle(s.listSubscriptionsByTopic),
+			"ListTagsForResource":       handle(s.listTagsForResource),
+			"ListTopics":                handle(s.listTopics),
+			"SetSubscriptionAttributes": handle(s.setSubscriptionAttributes),
+			"SetTopicAttributes":        handle(s.setTopicAttributes),
+			"Subscribe":                 handle(s.subscribe),
+			"TagResource":               handle(s.tagResource),
+			"Unsubscribe":               handle(s.unsubscribe),
+			"UntagResource":             handle(s.untagResource),
+		},
+	}
+}
+
+func (s *snsService) createTopic(r *request, input *sns.CreateTopicInput) (*sns.CreateTopicOutput, error) {
+	name := aws.StringValue(input.Name)
+	arn := r.arn("sns", r.region, name)
+	attributes := aws.StringValueMap(input.Attributes)
+
+	if fifo := strings.HasSuffix(name, ".fifo"); fifo != (attributes["FifoTopic"] == "true") {
+		return nil, newAPIError(http.StatusBadRequest, sns.ErrCodeInvalidParameterException, "Invalid parameter: Fifo Topic names must end with .fifo and must be made up of only uppercase and lowercase ASCII letters, numbers, underscores, and hyphens, and must be between 1 and 256 characters long.")
+	}
+
+	// CreateTopic is idempotent.
+	if s.topics.exists(arn) {
+		return &sns.CreateTopicOutput{
+			TopicArn: aws.String(arn),
+		}, nil
+	}
+
+	topic := &snsTopic{
+		arn: arn,
+		attributes: map[string]string{
+			"DisplayName":             "",
+			"EffectiveDeliveryPolicy": snsDefaultDeliveryPolicy,
+			"Owner":                   r.accountID,
+			"Policy":                  snsDefaultTopicPolicy(arn, r.accountID),
+			"SubscriptionsConfirmed":  "0",
+			"SubscriptionsDeleted":    "0",
+			"SubscriptionsPending":    "0",
+			"TopicArn":                arn,
+		},
+	}
+
+	for k, v := range attributes {
+		topic.attributes[k] = v
+	}
+
+	for _, tag := range input.Tags {
+		topic.tags = topic.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	s.topics.create(arn, topic, r.server.options.EventualConsistencyReads)
+
+	return &sns.CreateTopicOutput{
+		TopicArn: aws.String(arn),
+	}, nil
+}
+
+func (s *snsService) deleteTopic(r *request, input *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+	// DeleteTopic is idempotent.
+	s.topics.delete(aws.StringValue(input.TopicArn))
+
+	for _, subscription := range s.subscriptions.list() {
+		if subscription.topicARN == aws.StringValue(input.TopicArn) {
+			s.subscriptions.delete(subscription.arn)
+		}
+	}
+
+	return &sns.DeleteTopicOutput{}, nil
+}
+
+func (s *snsService) getTopicAttributes(r *request, input *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error) {
+	topic, err := s.findTopic(input.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed := 0
+	for _, subscription := range s.subscriptions.list() {
+		if subscription.topicARN == topic.arn {
+			confirmed++
+		}
+	}
+	topic.attributes["SubscriptionsConfirmed"] = strconv.Itoa(confirmed)
+
+	return &sns.GetTopicAttributesOutput{
+		Attributes: aws.StringMap(topic.attributes),
+	}, nil
+}
+
+func (s *snsService) listTopics(r *request, input *sns.ListTopicsInput) (*sns.ListTopicsOutput, error) {
+	output := &sns.ListTopicsOutput{}
+
+	for _, topic := range s.topics.list() {
+		output.Topics = append(output.Topics, &sns.Topic{
+			TopicArn: aws.String(topic.arn),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *snsService) setTopicAttributes(r *request, input *sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error) {
+	topic, err := s.findTopic(input.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := aws.StringValue(input.AttributeName)
+
+	switch name {
+	case "FifoTopic", "Owner", "TopicArn":
+		return nil, newAPIError(http.StatusBadRequest, sns.ErrCodeInvalidParameterException, fmt.Sprintf("Invalid parameter: AttributeName %s cannot be changed", name))
+	case "Policy":
+		if aws.StringValue(input.AttributeValue) == "" {
+			topic.attributes[name] = snsDefaultTopicPolicy(topic.arn, r.accountID)
+			return &sns.SetTopicAttributesOutput{}, nil
+		}
+	}
+
+	topic.attributes[name] = aws.StringValue(input.AttributeValue)
+
+	return &sns.SetTopicAttributesOutput{}, nil
+}
+
+func (s *snsService) subscribe(r *request, input *sns.SubscribeInput) (*sns.SubscribeOutput, error) {
+	topic, err := s.findTopic(input.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arn := fmt.Sprintf("%s:%08d-0000-0000-0000-000000000000", topic.arn, r.server.nextID())
+	subscription := &snsSubscription{
+		arn: arn,
+		attributes: map[string]string{
+			"ConfirmationWasAuthenticated": "true",
+			"Endpoint":                     aws.StringValue(input.Endpoint),
+			"Owner":                        r.accountID,
+			"PendingConfirmation":          "false",
+			"Protocol":                     aws.StringValue(input.Protocol),
+			"RawMessageDelivery":           "false",
+			"SubscriptionArn":              arn,
+			"TopicArn":                     topic.arn,
+		},
+		topicARN: topic.arn,
+	}
+
+	for k, v := range input.Attributes {
+		subscription.attributes[k] = aws.StringValue(v)
+	}
+
+	s.subscriptions.create(arn, subscription, r.server.options.EventualConsistencyReads)
+
+	return &sns.SubscribeOutput{
+		SubscriptionArn: aws.String(arn),
+	}, nil
+}
+
+func (s *snsService) getSubscriptionAttributes(r *request, input *sns.GetSubscriptionAttributesInput) (*sns.GetSubscriptionAttributesOutput, error) {
+	subscription, ok := s.subscriptions.get(aws.StringValue(input.SubscriptionArn))
+
+	if !ok {
+		return nil, snsNotFoundError("Subscription does not exist")
+	}
+
+	return &sns.GetSubscriptionAttributesOutput{
+		Attributes: aws.StringMap(subscription.attributes),
+	}, nil
+}
+
+func (s *snsService) setSubscriptionAttributes(r *request, input *sns.SetSubscriptionAttributesInput) (*sns.SetSubscriptionAttributesOutput, error) {
+	subscription, ok := s.subscriptions.get(aws.StringValue(input.SubscriptionArn))
+
+	if !ok {
+		return nil, snsNotFoundError("Subscription does not exist")
+	}
+
+	subscription.attributes[aws.StringValue(input.AttributeName)] = aws.StringValue(input.AttributeValue)
+
+	return &sns.SetSubscriptionAttributesOutput{}, nil
+}
+
+func (s *snsService) listSubscriptionsByTopic(r *request, input *sns.ListSubscriptionsByTopicInput) (*sns.ListSubscriptionsByTopicOutput, error) {
+	topic, err := s.findTopic(input.TopicArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &sns.ListSubscriptionsByTopicOutput{}
+
+	for _, subscription := range s.subscriptions.list() {
+		if subscription.topicARN != topic.arn {
+			continue
+		}
+
+		output.Subscriptions = append(output.Subscriptions, &sns.Subscription{
+			Endpoint:        aws.String(subscription.attributes["Endpoint"]),
+			Owner:           aws.String(subscription.attributes["Owner"]),
+			Protocol:        aws.String(subscription.attributes["Protocol"]),
+			SubscriptionArn: aws.String(subscription.arn),
+			TopicArn:        aws.String(topic.arn),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *snsService) unsubscribe(r *request, input *sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error) {
+	if !s.subscriptions.exists(aws.StringValue(input.SubscriptionArn)) {
+		return nil, snsNotFoundError("Subscription does not exist")
+	}
+
+	s.subscriptions.delete(aws.StringValue(input.SubscriptionArn))
+
+	return &sns.UnsubscribeOutput{}, nil
+}
+
+func (s *snsService) listTagsForResource(r *request, input *sns.ListTagsForResourceInput) (*sns.ListTagsForResourceOutput, error) {
+	topic, err := s.findTagResource(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &sns.ListTagsForResourceOutput{
+		Tags: []*sns.Tag{},
+	}
+
+	for _, k := range sortedKeys(topic.tags) {
+		output.Tags = append(output.Tags, &sns.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(topic.tags[k]),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *snsService) tagResource(r *request, input *sns.TagResourceInput) (*sns.TagResourceOutput, error) {
+	topic, err := s.findTagResource(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		topic.tags = topic.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	return &sns.TagResourceOutput{}, nil
+}
+
+func (s *snsService) untagResource(r *request, input *sns.UntagResourceInput) (*sns.UntagResourceOutput, error) {
+	topic, err := s.findTagResource(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	topic.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &sns.UntagResourceOutput{}, nil
+}
+
+func (s *snsService) findTopic(arn *string) (*snsTopic, error) {
+	topic, ok := s.topics.get(aws.StringValue(arn))
+
+	if !ok {
+		return nil, snsNotFoundError("Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+// findTagResource returns the topic with the specified ARN.
+// Unlike other operations, the tagging operations return ResourceNotFound.
+func (s *snsService) findTagResource(arn *string) (*snsTopic, error) {
+	topic, ok := s.topics.get(aws.StringValue(arn))
+
+	if !ok {
+		return nil, newAPIError(http.StatusNotFound, sns.ErrCodeResourceNotFoundException, "Resource does not exist")
+	}
+
+	return topic, nil
+}
+
+func snsNotFoundError(message string) *apiError {
+	return newAPIError(http.StatusNotFound, sns.ErrCodeNotFoundException, message)
+}
+
+const snsDefaultDeliveryPolicy = `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`
+
+func snsDefaultTopicPolicy(arn, accountID string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%[1]q,"Condition":{"StringEquals":{"AWS:SourceOwner":%[2]q}}}]}`, arn, accountID)
+}
@@ -0,0 +1,251 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type sqsService struct {
+	server *Server
+	queues *store[sqsQueue]
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	name       string
+	tags       tags
+}
+
+func newSQSService(server *Server) *service {
+	s := &sqsService{
+		server: server,
+		queues: newStore[sqsQueue](),
+	}
+
+	return &service{
+		name:     names.SQS,
+		protocol: protocolQuery,
+		xmlns:    "http://queue.amazonaws.com/doc/2012-11-05/",
+		operations: map[string]handlerFunc{
+			"CreateQueue":        handle(s.createQueue),
+			"DeleteQueue":        handle(s.deleteQueue),
+			"GetQueueAttributes": handle(s.getQueueAttributes),
+			"GetQueueUrl":        handle(s.getQueueURL),
+			"ListQueueTags":      handle(s.listQueueTags),
+			"ListQueues":         handle(s.listQueues),
+			"SetQueueAttributes": handle(s.setQueueAttributes),
+			"TagQueue":           handle(s.tagQueue),
+			"UntagQueue":         handle(s.untagQueue),
+		},
+	}
+}
+
+func (s *sqsService) createQueue(r *request, input *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+	name := aws.StringValue(input.QueueName)
+	attributes := aws.StringValueMap(input.Attributes)
+
+	if fifo := strings.HasSuffix(name, ".fifo"); fifo != (attributes[sqs.QueueAttributeNameFifoQueue] == "true") {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidParameterValue", "The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix.")
+	}
+
+	if queue, ok := s.queues.items[name]; ok {
+		for k, v := range attributes {
+			if queue.attributes[k] != v {
+				return nil, newAPIError(http.StatusBadRequest, sqs.ErrCodeQueueNameExists, fmt.Sprintf("A queue already exists with the same name and a different value for attribute %s", k))
+			}
+		}
+
+		return &sqs.CreateQueueOutput{
+			QueueUrl: aws.String(s.queueURL(name)),
+		}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	queue := &sqsQueue{
+		attributes: map[string]string{
+			sqs.QueueAttributeNameCreatedTimestamp:                      now,
+			sqs.QueueAttributeNameDelaySeconds:                          "0",
+			sqs.QueueAttributeNameLastModifiedTimestamp:                 now,
+			sqs.QueueAttributeNameMaximumMessageSize:                    "262144",
+			sqs.QueueAttributeNameMessageRetentionPeriod:                "345600",
+			sqs.QueueAttributeNameQueueArn:                              r.arn("sqs", r.region, name),
+			sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds:         "0",
+			sqs.QueueAttributeNameVisibilityTimeout:                     "30",
+			sqs.QueueAttributeNameApproximateNumberOfMessages:           "0",
+			sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:    "0",
+			sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible: "0",
+		},
+		name: name,
+	}
+
+	for k, v := range attributes {
+		queue.attributes[k] = v
+	}
+
+	if _, ok := queue.attributes[sqs.QueueAttributeNameKmsMasterKeyId]; !ok {
+		if _, ok := queue.attributes[sqs.QueueAttributeNameSqsManagedSseEnabled]; !ok {
+			queue.attributes[sqs.QueueAttributeNameSqsManagedSseEnabled] = "true"
+		}
+	}
+
+	for k, v := range input.Tags {
+		queue.tags = queue.tags.set(k, aws.StringValue(v))
+	}
+
+	s.queues.create(name, queue, r.server.options.EventualConsistencyReads)
+
+	return &sqs.CreateQueueOutput{
+		QueueUrl: aws.String(s.queueURL(name)),
+	}, nil
+}
+
+func (s *sqsService) deleteQueue(r *request, input *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+	queue, err := s.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.queues.delete(queue.name)
+
+	return &sqs.DeleteQueueOutput{}, nil
+}
+
+func (s *sqsService) getQueueAttributes(r *request, input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+	queue, err := s.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]*string)
+	for _, name := range aws.StringValueSlice(input.AttributeNames) {
+		if name == sqs.QueueAttributeNameAll {
+			for k, v := range queue.attributes {
+				attributes[k] = aws.String(v)
+			}
+
+			break
+		}
+
+		if v, ok := queue.attributes[name]; ok {
+			attributes[name] = aws.String(v)
+		}
+	}
+
+	return &sqs.GetQueueAttributesOutput{
+		Attributes: attributes,
+	}, nil
+}
+
+func (s *sqsService) getQueueURL(r *request, input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+	name := aws.StringValue(input.QueueName)
+
+	if _, ok := s.queues.get(name); !ok {
+		return nil, sqsQueueNotFoundError()
+	}
+
+	return &sqs.GetQueueUrlOutput{
+		QueueUrl: aws.String(s.queueURL(name)),
+	}, nil
+}
+
+func (s *sqsService) listQueueTags(r *request, input *sqs.ListQueueTagsInput) (*sqs.ListQueueTagsOutput, error) {
+	queue, err := s.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &sqs.ListQueueTagsOutput{}
+	if len(queue.tags) > 0 {
+		output.Tags = aws.StringMap(queue.tags)
+	}
+
+	return output, nil
+}
+
+func (s *sqsService) listQueues(r *request, input *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error) {
+	output := &sqs.ListQueuesOutput{}
+
+	for _, queue := range s.queues.list() {
+		if strings.HasPrefix(queue.name, aws.StringValue(input.QueueNamePrefix)) {
+			output.QueueUrls = append(output.QueueUrls, aws.String(s.queueURL(queue.name)))
+		}
+	}
+
+	return output, nil
+}
+
+func (s *sqsService) setQueueAttributes(r *request, input *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+	queue, err := s.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.Attributes {
+		if k == sqs.QueueAttributeNameFifoQueue && aws.StringValue(v) != queue.attributes[k] {
+			return nil, newAPIError(http.StatusBadRequest, "InvalidAttributeName", "FifoQueue cannot be changed")
+		}
+
+		queue.attributes[k] = aws.StringValue(v)
+	}
+
+	queue.attributes[sqs.QueueAttributeNameLastModifiedTimestamp] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return &sqs.SetQueueAttributesOutput{}, nil
+}
+
+func (s *sqsService) tagQueue(r *request, input *sqs.TagQueueInput) (*sqs.TagQueueOutput, error) {
+	queue, err := s.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.Tags {
+		queue.tags = queue.tags.set(k, aws.StringValue(v))
+	}
+
+	return &sqs.TagQueueOutput{}, nil
+}
+
+func (s *sqsService) untagQueue(r *request, input *sqs.UntagQueueInput) (*sqs.UntagQueueOutput, error) {
+	queue, err := s.findQueue(input.QueueUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	queue.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &sqs.UntagQueueOutput{}, nil
+}
+
+// findQueue returns the queue with the specified URL.
+func (s *sqsService) findQueue(url *string) (*sqsQueue, error) {
+	queue, ok := s.queues.get(path.Base(aws.StringValue(url)))
+
+	if !ok {
+		return nil, sqsQueueNotFoundError()
+	}
+
+	return queue, nil
+}
+
+func (s *sqsService) queueURL(name string) string {
+	return fmt.Sprintf("%s/%s/%s", s.server.URL, s.server.options.AccountID, name)
+}
+
+func sqsQueueNotFoundError() *apiError {
+	return newAPIError(http.StatusBadRequest, sqs.ErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+}
@@ -0,0 +1,378 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type ssmService struct {
+	parameters *store[ssmParameter]
+	server     *Server
+}
+
+type ssmParameter struct {
+	metadata *ssm.ParameterMetadata
+	tags     tags
+	value    string
+}
+
+func newSSMService(server *Server) *service {
+	s := &ssmService{
+		parameters: newStore[ssmParameter](),
+		server:     server,
+	}
+
+	return &service{
+		name:         names.SSM,
+		protocol:     protocolJSON,
+		jsonVersion:  "1.1",
+		targetPrefix: "AmazonSSM",
+		operations: map[string]handlerFunc{
+			"AddTagsToResource":      handle(s.addTagsToResource),
+			"DeleteParameter":        handle(s.deleteParameter),
+			"DeleteParameters":       handle(s.deleteParameters),
+			"DescribeParameters":     handle(s.describeParameters),
+			"GetParameter":           handle(s.getParameter),
+			"GetParameters":          handle(s.getParameters),
+			"GetParametersByPath":    handle(s.getParametersByPath),
+			"ListTagsForResource":    handle(s.listTagsForResource),
+			"PutParameter":           handle(s.putParameter),
+			"RemoveTagsFromResource": handle(s.removeTagsFromResource),
+		},
+	}
+}
+
+func (s *ssmService) putParameter(r *request, input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+	name := aws.StringValue(input.Name)
+	parameter, ok := s.parameters.items[name]
+
+	if ok {
+		if !aws.BoolValue(input.Overwrite) {
+			return nil, newAPIError(http.StatusBadRequest, ssm.ErrCodeParameterAlreadyExists, "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+
+		if len(input.Tags) > 0 {
+			return nil, newAPIError(http.StatusBadRequest, "ValidationException", "Invalid request: tags and overwrite can't be used together. To create a parameter with tags, please remove overwrite flag. To update tags for an existing parameter, please use AddTagsToResource or RemoveTagsFromResource.")
+		}
+	} else {
+		parameter = &ssmParameter{
+			metadata: &ssm.ParameterMetadata{
+				DataType: aws.String("text"),
+				Name:     aws.String(name),
+				Tier:     aws.String(ssm.ParameterTierStandard),
+				Version:  aws.Int64(0),
+			},
+		}
+
+		for _, tag := range input.Tags {
+			parameter.tags = parameter.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+		}
+
+		s.parameters.create(name, parameter, r.server.options.EventualConsistencyReads)
+	}
+
+	metadata := parameter.metadata
+	metadata.AllowedPattern = input.AllowedPattern
+	metadata.Description = input.Description
+	metadata.LastModifiedDate = aws.Time(time.Now())
+	metadata.LastModifiedUser = aws.String(r.arn("iam", "", "user/fakeaws"))
+	metadata.Version = aws.Int64(aws.Int64Value(metadata.Version) + 1)
+
+	if v := input.DataType; v != nil {
+		metadata.DataType = v
+	}
+
+	if v := input.Tier; v != nil && aws.StringValue(v) != ssm.ParameterTierIntelligentTiering {
+		metadata.Tier = v
+	}
+
+	if v := input.Type; v != nil {
+		metadata.Type = v
+	}
+
+	if aws.StringValue(metadata.Type) == ssm.ParameterTypeSecureString {
+		metadata.KeyId = input.KeyId
+		if metadata.KeyId == nil {
+			metadata.KeyId = aws.String("alias/aws/ssm")
+		}
+	} else {
+		metadata.KeyId = nil
+	}
+
+	parameter.value = aws.StringValue(input.Value)
+
+	return &ssm.PutParameterOutput{
+		Tier:    metadata.Tier,
+		Version: metadata.Version,
+	}, nil
+}
+
+func (s *ssmService) deleteParameter(r *request, input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+	name := aws.StringValue(input.Name)
+
+	if !s.parameters.exists(name) {
+		return nil, ssmParameterNotFoundError()
+	}
+
+	s.parameters.delete(name)
+
+	return &ssm.DeleteParameterOutput{}, nil
+}
+
+func (s *ssmService) deleteParameters(r *request, input *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error) {
+	output := &ssm.DeleteParametersOutput{}
+
+	for _, name := range aws.StringValueSlice(input.Names) {
+		if !s.parameters.exists(name) {
+			output.InvalidParameters = append(output.InvalidParameters, aws.String(name))
+			continue
+		}
+
+		s.parameters.delete(name)
+		output.DeletedParameters = append(output.DeletedParameters, aws.String(name))
+	}
+
+	return output, nil
+}
+
+func (s *ssmService) describeParameters(r *request, input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+	output := &ssm.DescribeParametersOutput{
+		Parameters: []*ssm.ParameterMetadata{},
+	}
+
+	for _, parameter := range s.parameters.list() {
+		name := aws.StringValue(parameter.metadata.Name)
+
+		if !ssmParameterMatches(name, input.Filters, input.ParameterFilters) {
+			continue
+		}
+
+		// Honor eventual consistency.
+		if _, ok := s.parameters.get(name); !ok {
+			continue
+		}
+
+		output.Parameters = append(output.Parameters, parameter.metadata)
+	}
+
+	return output, nil
+}
+
+func (s *ssmService) getParameter(r *request, input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	parameter, ok := s.parameters.get(ssmParameterName(aws.StringValue(input.Name)))
+
+	if !ok {
+		return nil, ssmParameterNotFoundError()
+	}
+
+	return &ssm.GetParameterOutput{
+		Parameter: parameter.output(r, aws.BoolValue(input.WithDecryption)),
+	}, nil
+}
+
+func (s *ssmService) getParameters(r *request, input *ssm.GetParametersInput) (*ssm.GetParametersOutput, error) {
+	output := &ssm.GetParametersOutput{
+		InvalidParameters: []*string{},
+		Parameters:        []*ssm.Parameter{},
+	}
+
+	for _, name := range aws.StringValueSlice(input.Names) {
+		parameter, ok := s.parameters.get(ssmParameterName(name))
+
+		if !ok {
+			output.InvalidParameters = append(output.InvalidParameters, aws.String(name))
+			continue
+		}
+
+		output.Parameters = append(output.Parameters, parameter.output(r, aws.BoolValue(input.WithDecryption)))
+	}
+
+	return output, nil
+}
+
+func (s *ssmService) getParametersByPath(r *request, input *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+	path := strings.TrimSuffix(aws.StringValue(input.Path), "/") + "/"
+
+	output := &ssm.GetParametersByPathOutput{
+		Parameters: []*ssm.Parameter{},
+	}
+
+	for _, parameter := range s.parameters.list() {
+		name := aws.StringValue(parameter.metadata.Name)
+
+		if !strings.HasPrefix(name, path) {
+			continue
+		}
+
+		if !aws.BoolValue(input.Recursive) && strings.Contains(strings.TrimPrefix(name, path), "/") {
+			continue
+		}
+
+		output.Parameters = append(output.Parameters, parameter.output(r, aws.BoolValue(input.WithDecryption)))
+	}
+
+	return output, nil
+}
+
+func (s *ssmService) addTagsToResource(r *request, input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+	parameter, err := s.findTagResource(input.ResourceType, input.ResourceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		parameter.tags = parameter.tags.set(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+	}
+
+	return &ssm.AddTagsToResourceOutput{}, nil
+}
+
+func (s *ssmService) listTagsForResource(r *request, input *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+	parameter, err := s.findTagResource(input.ResourceType, input.ResourceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ssm.ListTagsForResourceOutput{
+		TagList: []*ssm.Tag{},
+	}
+
+	for _, k := range sortedKeys(parameter.tags) {
+		output.TagList = append(output.TagList, &ssm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(parameter.tags[k]),
+		})
+	}
+
+	return output, nil
+}
+
+func (s *ssmService) removeTagsFromResource(r *request, input *ssm.RemoveTagsFromResourceInput) (*ssm.RemoveTagsFromResourceOutput, error) {
+	parameter, err := s.findTagResource(input.ResourceType, input.ResourceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parameter.tags.remove(aws.StringValueSlice(input.TagKeys)...)
+
+	return &ssm.RemoveTagsFromResourceOutput{}, nil
+}
+
+// findTagResource returns the parameter identified by a tagging operation.
+// Only parameters can be tagged.
+func (s *ssmService) findTagResource(resourceType, id *string) (*ssmParameter, error) {
+	if aws.StringValue(resourceType) != ssm.ResourceTypeForTaggingParameter {
+		return nil, newAPIError(http.StatusBadRequest, ssm.ErrCodeInvalidResourceType, fmt.Sprintf("resource type %s is not supported by the fake SSM service", aws.StringValue(resourceType)))
+	}
+
+	parameter, ok := s.parameters.get(ssmParameterName(aws.StringValue(id)))
+
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, ssm.ErrCodeInvalidResourceId, "")
+	}
+
+	return parameter, nil
+}
+
+func (p *ssmParameter) output(r *request, withDecryption bool) *ssm.Parameter {
+	name := aws.StringValue(p.metadata.Name)
+	resource := "parameter/" + name
+	if strings.HasPrefix(name, "/") {
+		resource = "parameter" + name
+	}
+
+	value := p.value
+	if aws.StringValue(p.metadata.Type) == ssm.ParameterTypeSecureString && !withDecryption {
+		// The real service returns the encrypted value.
+		value = "kms:" + aws.StringValue(p.metadata.KeyId)
+	}
+
+	return &ssm.Parameter{
+		ARN:              aws.String(r.arn("ssm", r.region, resource)),
+		DataType:         p.metadata.DataType,
+		LastModifiedDate: p.metadata.LastModifiedDate,
+		Name:             p.metadata.Name,
+		Type:             p.metadata.Type,
+		Value:            aws.String(value),
+		Version:          p.metadata.Version,
+	}
+}
+
+// ssmParameterName returns the name of a parameter from its name or ARN.
+func ssmParameterName(v string) string {
+	if !strings.HasPrefix(v, "arn:") {
+		return v
+	}
+
+	// Hierarchical names start with "/", which is dropped from the ARN.
+	_, resource, _ := strings.Cut(v, ":parameter/")
+
+	if strings.Contains(resource, "/") {
+		return "/" + resource
+	}
+
+	return resource
+}
+
+func ssmParameterMatches(name string, filters []*ssm.ParametersFilter, parameterFilters []*ssm.ParameterStringFilter) bool {
+	for _, filter := range filters {
+		if aws.StringValue(filter.Key) == ssm.ParametersFilterKeyName && !ssmStringMatches(name, "Equals", aws.StringValueSlice(filter.Values)) {
+			return false
+		}
+	}
+
+	for _, filter := range parameterFilters {
+		option := aws.StringValue(filter.Option)
+		if option == "" {
+			option = "Equals"
+		}
+
+		switch aws.StringValue(filter.Key) {
+		case "Name":
+			if !ssmStringMatches(name, option, aws.StringValueSlice(filter.Values)) {
+				return false
+			}
+		case "Path":
+			path := strings.TrimSuffix(aws.StringValue(filter.Values[0]), "/") + "/"
+			if !strings.HasPrefix(name, path) || (option != "Recursive" && strings.Contains(strings.TrimPrefix(name, path), "/")) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func ssmStringMatches(s, option string, values []string) bool {
+	for _, v := range values {
+		switch option {
+		case "BeginsWith":
+			if strings.HasPrefix(s, v) {
+				return true
+			}
+		case "Contains":
+			if strings.Contains(s, v) {
+				return true
+			}
+		default:
+			if s == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func ssmParameterNotFoundError() *apiError {
+	return newAPIError(http.StatusBadRequest, ssm.ErrCodeParameterNotFound, "")
+}
@@ -0,0 +1,75 @@
+package fakeaws
+
+import (
+	"sort"
+)
+
+// store holds the resources of one type, keyed by name or identifier.
+// Newly created resources can be made invisible to a number of reads to simulate eventual consistency.
+type store[T any] struct {
+	hiddenReads map[string]int
+	items       map[string]*T
+}
+
+func newStore[T any]() *store[T] {
+	return &store[T]{
+		hiddenReads: make(map[string]int),
+		items:       make(map[string]*T),
+	}
+}
+
+// create adds a new resource which is not returned by the next `hiddenReads` calls to get.
+func (s *store[T]) create(key string, v *T, hiddenReads int) {
+	s.items[key] = v
+
+	if hiddenReads > 0 {
+		s.hiddenReads[key] = hiddenReads
+	}
+}
+
+// get returns the resource with the specified key, if it exists and is visible to reads.
+func (s *store[T]) get(key string) (*T, bool) {
+	v, ok := s.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	if n := s.hiddenReads[key]; n > 0 {
+		s.hiddenReads[key] = n - 1
+		return nil, false
+	}
+
+	return v, true
+}
+
+// exists returns whether a resource with the specified key exists, regardless of its visibility to reads.
+func (s *store[T]) exists(key string) bool {
+	_, ok := s.items[key]
+
+	return ok
+}
+
+func (s *store[T]) delete(key string) {
+	delete(s.items, key)
+	delete(s.hiddenReads, key)
+}
+
+// list returns all resources, ordered by key.
+func (s *store[T]) list() []*T {
+	keys := make([]string, 0, len(s.items))
+
+	for k := range s.items {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	values := make([]*T, 0, len(keys))
+
+	for _, k := range keys {
+		values = append(values, s.items[k])
+	}
+
+	return values
+}
@@ -0,0 +1,26 @@
+package fakeaws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func newSTSService(*Server) *service {
+	return &service{
+		name:     names.STS,
+		protocol: protocolQuery,
+		xmlns:    "https://sts.amazonaws.com/doc/2011-06-15/",
+		operations: map[string]handlerFunc{
+			"GetCallerIdentity": handle(getCallerIdentity),
+		},
+	}
+}
+
+func getCallerIdentity(r *request, _ *sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{
+		Account: aws.String(r.accountID),
+		Arn:     aws.String(r.arn("iam", "", "user/fakeaws")),
+		UserId:  aws.String("AIDAFAKEAWS"),
+	}, nil
+}
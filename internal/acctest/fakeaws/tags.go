@@ -0,0 +1,37 @@
+package fakeaws
+
+import (
+	"sort"
+)
+
+// tags is the set of tags on a resource.
+type tags map[string]string
+
+func (t tags) set(key, value string) tags {
+	if t == nil {
+		t = make(tags)
+	}
+
+	t[key] = value
+
+	return t
+}
+
+func (t tags) remove(keys ...string) {
+	for _, key := range keys {
+		delete(t, key)
+	}
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -6,21 +6,29 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -71,9 +79,30 @@ func (m randomnessSourceMap) key() string {
 	return "vcr-randomness-sources"
 }
 
+// vcrRecording is a test's VCR recorder and the redactor applied to its cassette.
+type vcrRecording struct {
+	recorder *recorder.Recorder
+	redactor *vcrRedactor
+}
+
+type recorderMap map[string]*vcrRecording
+
+func (m recorderMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m recorderMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m recorderMap) key() string {
+	return "vcr-recorders"
+}
+
 var (
 	providerMetas     = metaMap(make(map[string]*conns.AWSClient, 0))
 	randomnessSources = randomnessSourceMap(make(map[string]*randomnessSource, 0))
+	recorders         = recorderMap(make(map[string]*vcrRecording, 0))
 )
 
 // ProviderMeta returns the current provider's state (AKA "meta" or "conns.AWSClient").
@@ -109,6 +138,8 @@ func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (t
 	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
 
 	for name := range input {
+		name := name
+
 		output[name] = func() (tfprotov5.ProviderServer, error) {
 			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
@@ -116,7 +147,7 @@ func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (t
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name(), name)
 
 			return providerServerFactory(), nil
 		}
@@ -125,162 +156,354 @@ func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (t
 	return output
 }
 
+// vcrEnabledProtoV6ProviderFactories returns ProtoV6ProviderFactories ready for use with VCR.
+func vcrEnabledProtoV6ProviderFactories(t *testing.T, input map[string]func() (tfprotov6.ProviderServer, error)) map[string]func() (tfprotov6.ProviderServer, error) {
+	output := make(map[string]func() (tfprotov6.ProviderServer, error), len(input))
+
+	for name := range input {
+		name := name
+
+		output[name] = func() (tfprotov6.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV6ProviderServerFactory(context.Background())
+
+			if err != nil {
+				return nil, err
+			}
+
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name(), name)
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
+
+// vcrProviderMetaKey returns the key of a provider's cached instance state.
+// The main provider's key is the test name so that ProviderMeta returns its state.
+func vcrProviderMetaKey(testName, providerName string) string {
+	if providerName == ProviderName {
+		return testName
+	}
+
+	return testName + "#" + providerName
+}
+
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
 // This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
-func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
+// The primary (Plugin SDK) provider's instance state is also used by the Terraform Plugin Framework provider it is muxed with,
+// so all resources and data sources, whichever SDK they are implemented in, share the test's recorder.
+func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName, providerName string) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		key := vcrProviderMetaKey(testName, providerName)
+
 		providerMetas.Lock()
-		meta, ok := providerMetas[testName]
+		meta, ok := providerMetas[key]
 		defer providerMetas.Unlock()
 
 		if ok {
 			return meta, nil
 		}
 
-		vcrMode, err := vcrMode()
+		recording, err := vcrRecorder(ctx, testName)
 
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		// Cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		tlsConfig := transport.TLSClientConfig
-		if tlsConfig == nil {
-			tlsConfig = &tls.Config{}
-			transport.TLSClientConfig = tlsConfig
+		// Use the wrapped HTTP Client for AWS APIs.
+		// As the HTTP client is used in the provider's ConfigureContextFunc
+		// we must do this setup before calling the ConfigureContextFunc.
+		httpClient := &http.Client{Transport: recording.recorder}
+		if v, ok := provider.Meta().(*conns.AWSClient); ok {
+			meta = v
+		} else {
+			meta = new(conns.AWSClient)
 		}
-		tlsConfig.MinVersion = tls.VersionTLS12
+		meta.SetHTTPClient(httpClient)
+		// Don't retry requests if a recorded interaction isn't found.
+		meta.AddSDKv1Hook(func(sess *session.Session) {
+			sess.Handlers.AfterRetry.PushFront(func(r *request.Request) {
+				// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
+				if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
+					r.Retryable = aws.Bool(false)
+				}
+			})
+		})
+		meta.AddSDKv2Hook(func(cfg *aws_sdkv2.Config) {
+			retryer := cfg.Retryer
+			if retryer == nil {
+				// The AWS SDK for Go v2 API clients' default.
+				retryer = func() aws_sdkv2.Retryer {
+					return retry.NewStandard()
+				}
+			}
+			cfg.Retryer = func() aws_sdkv2.Retryer {
+				return &vcrRetryer{Retryer: retryer()}
+			}
+		})
+		provider.SetMeta(meta)
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		if v, diags := configureContextFunc(ctx, d); diags.HasError() {
+			return nil, diags
+		} else {
+			meta = v.(*conns.AWSClient)
+		}
 
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		// The caller's account ID appears in many places without any surrounding context.
+		recording.redactor.addLiteral(vcrRedactionRuleAccountID, meta.AccountID)
+
+		providerMetas[key] = meta
+
+		return meta, nil
+	}
+}
+
+// vcrRecorder returns the VCR recording for the specified test, creating it if necessary.
+// All providers configured by a test share a single recorder and so a single cassette.
+func vcrRecorder(ctx context.Context, testName string) (*vcrRecording, error) {
+	recorders.Lock()
+	recording, ok := recorders[testName]
+	defer recorders.Unlock()
+
+	if ok {
+		return recording, nil
+	}
+
+	vcrMode, err := vcrMode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+
+	// Create a VCR recorder around a default HTTP client.
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  path,
+		Mode:          vcrMode,
+		RealTransport: httpClient.Transport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sensitive HTTP headers.
+	r.AddHook(func(i *cassette.Interaction) error {
+		delete(i.Request.Headers, "Authorization")
+		delete(i.Request.Headers, "X-Amz-Security-Token")
+
+		return nil
+	}, recorder.AfterCaptureHook)
+
+	// Redact sensitive and unstable values before the cassette is saved.
+	// Live interactions are unaffected so that resources can be created and read while recording.
+	redactor := newVCRRedactor()
+	r.AddHook(redactor.redactInteraction, recorder.BeforeSaveHook)
+
+	// Defines how VCR will match requests to responses.
+	// Recorded requests are redacted, so the same redactions are applied to the live request before comparison.
+	// Placeholders in the recorded request, which the live request may have copied from a replayed response, are left as-is.
+	r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
+		redactor.addPlaceholders(i.URL)
+		redactor.addPlaceholders(i.Body)
+
+		if r.Method != i.Method || redactor.redact(r.URL.String()) != i.URL {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := redactor.redact(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 
 		if err != nil {
-			return nil, diag.FromErr(err)
+			tflog.Debug(ctx, "Failed to parse request Content-Type", map[string]interface{}{
+				"error": err,
+			})
+			return false
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+		switch contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
 
-			return nil
-		}, recorder.AfterCaptureHook)
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
 				return false
 			}
 
-			if r.Body == nil {
-				return true
+			return reflect.DeepEqual(requestJson, cassetteJson)
+
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
+
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
 			}
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
 					"error": err,
 				})
 				return false
 			}
 
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+			return reflect.DeepEqual(requestXml, cassetteXml)
+
+		case "application/x-www-form-urlencoded":
+			// AWS Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+			requestForm, err := url.ParseQuery(body)
+
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse request form", map[string]interface{}{
+					"error": err,
+				})
+				return false
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			cassetteForm, err := url.ParseQuery(i.Body)
 
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			if err != nil {
+				tflog.Debug(ctx, "Failed to parse cassette form", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
 
-				return reflect.DeepEqual(requestJson, cassetteJson)
+			return vcrFormsEqual(requestForm, cassetteForm)
+		}
 
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
+		return false
+	})
 
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+	recording = &vcrRecording{
+		recorder: r,
+		redactor: redactor,
+	}
+	recorders[testName] = recording
 
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+	return recording, nil
+}
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+var (
+	// vcrIgnoredFormFields are the form fields whose values are ignored when matching requests.
+	// The values of idempotency tokens are generated randomly for each request.
+	vcrIgnoredFormFields = map[string]struct{}{
+		"ClientRequestToken": {},
+		"ClientToken":        {},
+		"IdempotencyToken":   {},
+	}
+	vcrIgnoredFormFieldsMu sync.RWMutex
+)
+
+// RegisterVCRIgnoredFormFields adds to the form fields whose values are ignored when matching requests, for example timestamps.
+// A field is ignored wherever it is nested, e.g. "ClientToken" matches "LaunchTemplateData.ClientToken".
+func RegisterVCRIgnoredFormFields(names ...string) {
+	vcrIgnoredFormFieldsMu.Lock()
+	defer vcrIgnoredFormFieldsMu.Unlock()
 
+	for _, name := range names {
+		vcrIgnoredFormFields[name] = struct{}{}
+	}
+}
+
+// vcrIsIgnoredFormField returns whether the value of the specified form field is ignored when matching requests.
+func vcrIsIgnoredFormField(key string) bool {
+	vcrIgnoredFormFieldsMu.RLock()
+	defer vcrIgnoredFormFieldsMu.RUnlock()
+
+	// Nested and list member fields are of the form "Parent.1.Child".
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		key = key[i+1:]
+	}
+
+	_, ok := vcrIgnoredFormFields[key]
+
+	return ok
+}
+
+// vcrFormsEqual returns whether two AWS Query protocol request bodies are equivalent.
+// Parameter order is not significant and the values of ignored fields aren't compared.
+func vcrFormsEqual(v1, v2 url.Values) bool {
+	if len(v1) != len(v2) {
+		return false
+	}
+
+	for key, values1 := range v1 {
+		values2, ok := v2[key]
+
+		if !ok {
 			return false
-		})
+		}
 
-		// Use the wrapped HTTP Client for AWS APIs.
-		// As the HTTP client is used in the provider's ConfigureContextFunc
-		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
-		if v, ok := provider.Meta().(*conns.AWSClient); ok {
-			meta = v
-		} else {
-			meta = new(conns.AWSClient)
+		if vcrIsIgnoredFormField(key) {
+			continue
 		}
-		meta.SetHTTPClient(httpClient)
-		provider.SetMeta(meta)
 
-		if v, diags := configureContextFunc(ctx, d); diags.HasError() {
-			return nil, diags
-		} else {
-			meta = v.(*conns.AWSClient)
+		if !reflect.DeepEqual(values1, values2) {
+			return false
 		}
+	}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		meta.LogsConn().Handlers.AfterRetry.PushFront(func(r *request.Request) {
-			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-				r.Retryable = aws.Bool(false)
-			}
-		})
+	return true
+}
 
-		providerMetas[testName] = meta
+// vcrRetryer is an AWS SDK for Go v2 retryer that doesn't retry requests if a recorded interaction isn't found.
+type vcrRetryer struct {
+	aws_sdkv2.Retryer
+}
 
-		return meta, nil
+func (r *vcrRetryer) IsErrorRetryable(err error) bool {
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return false
 	}
+
+	return r.Retryer.IsErrorRetryable(err)
 }
 
 // vcrRandomnessSource returns a rand.Source for VCR testing.
@@ -381,21 +604,31 @@ func closeVCRRecorder(t *testing.T) {
 	}
 
 	testName := t.Name()
+
+	// Forget the state of all the test's providers.
+	// The lock is acquired before that of the recorders, as in vcrProviderConfigureContextFunc.
 	providerMetas.Lock()
-	meta, ok := providerMetas[testName]
 	defer providerMetas.Unlock()
 
+	for key := range providerMetas {
+		if key == testName || strings.HasPrefix(key, testName+"#") {
+			delete(providerMetas, key)
+		}
+	}
+
+	recorders.Lock()
+	recording, ok := recorders[testName]
+	defer recorders.Unlock()
+
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient().Transport.(*recorder.Recorder); ok {
-				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
-					t.Error(err)
-				}
+			t.Log("stopping VCR recorder")
+			if err := recording.recorder.Stop(); err != nil {
+				t.Error(err)
 			}
 		}
 
-		delete(providerMetas, testName)
+		delete(recorders, testName)
 	}
 
 	// Save the randomness seed.
@@ -416,9 +649,17 @@ func closeVCRRecorder(t *testing.T) {
 }
 
 // ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
+// Unless disabled, import and empty plan verification steps are added after each configuration step.
 func ParallelTest(t *testing.T, c resource.TestCase) {
+	if isAutoVerifyEnabled(t) {
+		c.Steps = autoVerifySteps(c.Steps)
+	}
+
 	if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
+		if c.ProtoV6ProviderFactories != nil {
+			c.ProtoV6ProviderFactories = vcrEnabledProtoV6ProviderFactories(t, c.ProtoV6ProviderFactories)
+		}
 		defer closeVCRRecorder(t)
 	}
 
@@ -426,9 +667,17 @@ func ParallelTest(t *testing.T, c resource.TestCase) {
 }
 
 // Test wraps resource.Test, initializing VCR if enabled.
+// Unless disabled, import and empty plan verification steps are added after each configuration step.
 func Test(t *testing.T, c resource.TestCase) {
+	if isAutoVerifyEnabled(t) {
+		c.Steps = autoVerifySteps(c.Steps)
+	}
+
 	if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(t, c.ProtoV5ProviderFactories)
+		if c.ProtoV6ProviderFactories != nil {
+			c.ProtoV6ProviderFactories = vcrEnabledProtoV6ProviderFactories(t, c.ProtoV6ProviderFactories)
+		}
 		defer closeVCRRecorder(t)
 	}
 
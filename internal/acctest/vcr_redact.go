@@ -0,0 +1,408 @@
+package acctest
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// VCRRedactionRule rewrites sensitive or unstable values in VCR recordings.
+// Each distinct value matched by a rule is replaced by the same placeholder everywhere in a cassette,
+// in both requests and responses, so recorded interactions remain consistent with each other.
+// Placeholders depend only on the value, so live requests redacted during replay match those recorded.
+// Placeholders issued by a rule, or found in recorded requests during replay, are left as-is.
+type VCRRedactionRule struct {
+	// Name identifies the rule. Rules with the same name share placeholders.
+	Name string
+	// Pattern matches the values to be redacted.
+	// If Pattern has a subexpression named "value" only the subexpression is redacted, otherwise the whole match is.
+	Pattern *regexp.Regexp
+	// Placeholder returns the placeholder for a value redacted by the rule.
+	// It must always return the same placeholder for the same value.
+	Placeholder func(value string) string
+	// Skip, if set, returns whether a matched value is left as-is, for example because it is from a documentation range.
+	Skip func(value string) bool
+	// Literal, if set, redacts every later occurrence of a matched value, even without the context required by Pattern.
+	Literal bool
+}
+
+var (
+	vcrRedactionRules   []VCRRedactionRule
+	vcrRedactionRulesMu sync.Mutex
+)
+
+// RegisterVCRRedactionRule adds a rule to those applied to all VCR recordings.
+// Rules are applied in the order they are registered, after the built-in rules.
+func RegisterVCRRedactionRule(rule VCRRedactionRule) {
+	vcrRedactionRulesMu.Lock()
+	defer vcrRedactionRulesMu.Unlock()
+
+	vcrRedactionRules = append(vcrRedactionRules, rule)
+}
+
+const (
+	vcrRedactionRuleAccountID    = "account-id"
+	vcrRedactionRuleAccessKeyID  = "access-key-id"
+	vcrRedactionRuleIPAddress    = "ip-address"
+	vcrRedactionRulePresignedURL = "presigned-url"
+
+	vcrRedactedValue = "REDACTED"
+	vcrRedactedDate  = "20060102T150405Z"
+)
+
+// vcrHash returns a hash of the value, used to derive deterministic placeholders.
+func vcrHash(value string) uint64 {
+	h := sha256.Sum256([]byte(value))
+
+	return binary.BigEndian.Uint64(h[:8])
+}
+
+// vcrAccountIDPlaceholder returns a placeholder from the whole 12-digit account ID space, making collisions unlikely.
+func vcrAccountIDPlaceholder(value string) string {
+	return fmt.Sprintf("%012d", vcrHash(value)%1000000000000)
+}
+
+func vcrAccessKeyIDPlaceholder(value string) string {
+	return fmt.Sprintf("AKIAEXAMPLE%09d", vcrHash(value)%1000000000)
+}
+
+func vcrIsAccessKeyIDPlaceholder(value string) bool {
+	return strings.HasPrefix(value, "AKIAEXAMPLE")
+}
+
+// vcrIPAddressPlaceholder returns an address from the IPv4 documentation ranges (RFC 5737).
+func vcrIPAddressPlaceholder(value string) string {
+	n := vcrHash(value) % 508
+
+	if n < 254 {
+		return fmt.Sprintf("198.51.100.%d", n+1)
+	}
+
+	return fmt.Sprintf("203.0.113.%d", n-254+1)
+}
+
+// vcrIsNonPublicIPAddress returns whether the value isn't a public IPv4 address.
+// Private addresses are commonly part of test configurations and are left as-is.
+func vcrIsNonPublicIPAddress(value string) bool {
+	ip := net.ParseIP(value)
+
+	if ip == nil {
+		return true
+	}
+
+	if ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsMulticast() {
+		return true
+	}
+
+	for _, cidr := range []string{"192.0.2.0/24", "198.51.100.0/24", "203.0.113.0/24", "100.64.0.0/10", "255.255.255.255/32"} {
+		if _, ipNet, _ := net.ParseCIDR(cidr); ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func vcrConstantPlaceholder(placeholder string) func(string) string {
+	return func(string) string {
+		return placeholder
+	}
+}
+
+// vcrBuiltInRedactionRules returns the redaction rules applied to all VCR recordings.
+func vcrBuiltInRedactionRules() []VCRRedactionRule {
+	return []VCRRedactionRule{
+		{
+			Name:        vcrRedactionRuleAccountID,
+			Pattern:     regexp.MustCompile(`arn:aws[a-z-]*:[a-z0-9-]*:[a-z0-9-]*:(?P<value>\d{12}):`),
+			Placeholder: vcrAccountIDPlaceholder,
+			Literal:     true,
+		},
+		{
+			Name: vcrRedactionRuleAccountID,
+			// For example "Account": "123456789012", <OwnerId>123456789012</OwnerId> or AccountId=123456789012.
+			Pattern:     regexp.MustCompile(`(?i)(?:account|owner)[a-z]*"?\s*[:=>]\s*"?(?P<value>\d{12})\b`),
+			Placeholder: vcrAccountIDPlaceholder,
+			Literal:     true,
+		},
+		{
+			Name:        vcrRedactionRuleAccessKeyID,
+			Pattern:     regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`),
+			Placeholder: vcrAccessKeyIDPlaceholder,
+			Skip:        vcrIsAccessKeyIDPlaceholder,
+		},
+		{
+			Name: vcrRedactionRulePresignedURL,
+			// Presigned URL values change on every request so are all replaced by the same placeholder.
+			Pattern:     regexp.MustCompile(`(?i)X-Amz-(?:Credential|Security-Token|Signature)=(?P<value>[^&"'<\s]+)`),
+			Placeholder: vcrConstantPlaceholder(vcrRedactedValue),
+		},
+		{
+			Name:        vcrRedactionRulePresignedURL,
+			Pattern:     regexp.MustCompile(`(?i)X-Amz-Date=(?P<value>\d{8}T\d{6}Z)`),
+			Placeholder: vcrConstantPlaceholder(vcrRedactedDate),
+		},
+		{
+			Name: vcrRedactionRuleIPAddress,
+			// Only addresses assigned by AWS are redacted, for example "PublicIp": "54.1.2.3" or <publicIpAddress>54.1.2.3</publicIpAddress>.
+			// Other dotted quads, such as versions or addresses in test configurations, are left as-is.
+			Pattern:     regexp.MustCompile(`(?i)(?:public|carrier|customerowned)ip(?:v4)?(?:address)?"?\s*[:=>]\s*"?(?P<value>(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d))\b`),
+			Placeholder: vcrIPAddressPlaceholder,
+			Skip:        vcrIsNonPublicIPAddress,
+		},
+	}
+}
+
+// vcrNormalizedHeaders are HTTP headers whose values change on every request.
+var vcrNormalizedHeaders = map[string]string{
+	"Amz-Sdk-Invocation-Id": vcrRedactedValue,
+	"X-Amz-Date":            vcrRedactedDate,
+}
+
+// vcrRedactor applies redaction rules to VCR interactions.
+// A redactor is used for a single cassette.
+type vcrRedactor struct {
+	mu           sync.Mutex
+	issued       map[string]map[string]bool   // Rule name -> placeholder -> true.
+	literals     map[string]*regexp.Regexp    // Known sensitive value -> pattern matching the value.
+	placeholders map[string]map[string]string // Rule name -> value -> placeholder.
+	rules        []VCRRedactionRule
+}
+
+func newVCRRedactor() *vcrRedactor {
+	vcrRedactionRulesMu.Lock()
+	defer vcrRedactionRulesMu.Unlock()
+
+	return &vcrRedactor{
+		issued:       make(map[string]map[string]bool),
+		literals:     make(map[string]*regexp.Regexp),
+		placeholders: make(map[string]map[string]string),
+		rules:        append(vcrBuiltInRedactionRules(), vcrRedactionRules...),
+	}
+}
+
+// addLiteral redacts every occurrence of a known sensitive value using the placeholders of the named rule.
+// This catches values, such as the caller's account ID, that appear without any surrounding context.
+func (r *vcrRedactor) addLiteral(ruleName, value string) {
+	if value == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rule := range r.rules {
+		if rule.Name == ruleName {
+			if r.skip(rule, value) {
+				return
+			}
+
+			rule.Literal = true
+			r.placeholder(rule, value)
+
+			return
+		}
+	}
+}
+
+// addPlaceholders records the values matched by the rules in an already redacted string, such as a recorded request, as placeholders.
+// During replay, placeholders from recorded responses are sent back in live requests and must not be redacted again.
+func (r *vcrRedactor) addPlaceholders(s string) {
+	if s == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rule := range r.rules {
+		group := rule.Pattern.SubexpIndex("value")
+
+		for _, match := range rule.Pattern.FindAllStringSubmatch(s, -1) {
+			value := match[0]
+			if group > 0 {
+				value = match[group]
+			}
+
+			if value == "" || (rule.Skip != nil && rule.Skip(value)) {
+				continue
+			}
+
+			r.issue(rule, value)
+		}
+	}
+}
+
+// issue records a placeholder issued by the specified rule. The caller must hold the lock.
+func (r *vcrRedactor) issue(rule VCRRedactionRule, placeholder string) {
+	placeholders, ok := r.issued[rule.Name]
+
+	if !ok {
+		placeholders = make(map[string]bool)
+		r.issued[rule.Name] = placeholders
+	}
+
+	placeholders[placeholder] = true
+}
+
+// skip returns whether the specified value matched by a rule is left as-is. The caller must hold the lock.
+func (r *vcrRedactor) skip(rule VCRRedactionRule, value string) bool {
+	if r.issued[rule.Name][value] {
+		return true
+	}
+
+	return rule.Skip != nil && rule.Skip(value)
+}
+
+// placeholder returns the placeholder for the specified value. The caller must hold the lock.
+func (r *vcrRedactor) placeholder(rule VCRRedactionRule, value string) string {
+	if _, ok := r.literals[value]; !ok && rule.Literal {
+		r.literals[value] = regexp.MustCompile(`\b` + regexp.QuoteMeta(value) + `\b`)
+	}
+
+	values, ok := r.placeholders[rule.Name]
+
+	if !ok {
+		values = make(map[string]string)
+		r.placeholders[rule.Name] = values
+	}
+
+	placeholder, ok := values[value]
+
+	if !ok {
+		placeholder = rule.Placeholder(value)
+		values[value] = placeholder
+		r.issue(rule, placeholder)
+	}
+
+	return placeholder
+}
+
+// redact returns the specified string with all sensitive values replaced by their placeholders.
+func (r *vcrRedactor) redact(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rule := range r.rules {
+		s = r.redactRule(rule, s)
+	}
+
+	for value, pattern := range r.literals {
+		s = pattern.ReplaceAllLiteralString(s, r.literalPlaceholder(value))
+	}
+
+	return s
+}
+
+// literalPlaceholder returns the placeholder for the specified literal value. The caller must hold the lock.
+func (r *vcrRedactor) literalPlaceholder(value string) string {
+	for _, values := range r.placeholders {
+		if placeholder, ok := values[value]; ok {
+			return placeholder
+		}
+	}
+
+	return value
+}
+
+// redactRule applies a single rule. The caller must hold the lock.
+func (r *vcrRedactor) redactRule(rule VCRRedactionRule, s string) string {
+	group := rule.Pattern.SubexpIndex("value")
+	matches := rule.Pattern.FindAllStringSubmatchIndex(s, -1)
+
+	if len(matches) == 0 {
+		return s
+	}
+
+	var sb strings.Builder
+	last := 0
+
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if group > 0 {
+			start, end = match[2*group], match[2*group+1]
+		}
+
+		if start < 0 {
+			continue
+		}
+
+		value := s[start:end]
+
+		if r.skip(rule, value) {
+			continue
+		}
+
+		sb.WriteString(s[last:start])
+		sb.WriteString(r.placeholder(rule, value))
+		last = end
+	}
+
+	sb.WriteString(s[last:])
+
+	return sb.String()
+}
+
+func (r *vcrRedactor) redactHeaders(headers http.Header) {
+	for k, vs := range headers {
+		if v, ok := vcrNormalizedHeaders[http.CanonicalHeaderKey(k)]; ok {
+			for i := range vs {
+				vs[i] = v
+			}
+
+			continue
+		}
+
+		for i, v := range vs {
+			vs[i] = r.redact(v)
+		}
+	}
+}
+
+func (r *vcrRedactor) redactValues(values url.Values) {
+	for k, vs := range values {
+		for i, v := range vs {
+			vs[i] = r.redact(v)
+		}
+
+		values[k] = vs
+	}
+}
+
+// redactInteraction rewrites all sensitive values in the specified interaction.
+// Body lengths are updated as redaction may have changed them.
+func (r *vcrRedactor) redactInteraction(i *cassette.Interaction) error {
+	// Values are often first seen in context in the response, so discover those before redacting the request.
+	r.redact(i.Response.Body)
+
+	i.Request.Body = r.redact(i.Request.Body)
+	if i.Request.ContentLength > 0 {
+		i.Request.ContentLength = int64(len(i.Request.Body))
+	}
+	i.Request.Host = r.redact(i.Request.Host)
+	i.Request.RequestURI = r.redact(i.Request.RequestURI)
+	i.Request.URL = r.redact(i.Request.URL)
+	r.redactHeaders(i.Request.Headers)
+	r.redactValues(i.Request.Form)
+
+	i.Response.Body = r.redact(i.Response.Body)
+	r.redactHeaders(i.Response.Headers)
+
+	if i.Response.ContentLength > 0 {
+		i.Response.ContentLength = int64(len(i.Response.Body))
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// apiLatencyBucketBounds are the upper bounds, in milliseconds, of the API call latency histogram buckets.
+// The final bucket, for latencies greater than the last bound, is implicit.
+var apiLatencyBucketBounds = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000}
+
+// apiCall is a completed AWS API call, including all its attempts.
+type apiCall struct {
+	Err       error
+	End       time.Time
+	Operation string
+	Region    string
+	RequestID string
+	Retries   int
+	Service   string
+	Start     time.Time
+	Throttles int
+}
+
+type apiOperationKey struct {
+	Operation string
+	Region    string
+	Service   string
+}
+
+type apiOperationMetrics struct {
+	BucketCounts []int
+	Calls        int
+	Errors       int
+	MaxLatency   time.Duration
+	MinLatency   time.Duration
+	Retries      int
+	Throttles    int
+	TotalLatency time.Duration
+}
+
+// apiMetrics collects metrics, and optionally traces, of the AWS API calls made by all API clients.
+type apiMetrics struct {
+	file       string
+	mu         sync.Mutex
+	operations map[apiOperationKey]*apiOperationMetrics
+	start      time.Time
+	tracer     *apiTracer
+}
+
+var (
+	apiMetricsInstance *apiMetrics
+	apiMetricsOnce     sync.Once
+)
+
+// globalAPIMetrics returns the process-wide API call metrics collector, or nil if collection isn't enabled.
+// Collection is enabled by setting the TF_AWS_API_METRICS_FILE or OTEL_TRACES_EXPORTER environment variables.
+func globalAPIMetrics() *apiMetrics {
+	apiMetricsOnce.Do(func() {
+		file := os.Getenv(envvar.APIMetricsFile)
+		tracer, err := newAPITracerFromEnv()
+
+		if err != nil {
+			log.Printf("[WARN] AWS API call tracing disabled: %s", err)
+		}
+
+		if file == "" && tracer == nil {
+			return
+		}
+
+		apiMetricsInstance = newAPIMetrics(file, tracer)
+	})
+
+	return apiMetricsInstance
+}
+
+func newAPIMetrics(file string, tracer *apiTracer) *apiMetrics {
+	return &apiMetrics{
+		file:       file,
+		operations: make(map[apiOperationKey]*apiOperationMetrics),
+		start:      time.Now(),
+		tracer:     tracer,
+	}
+}
+
+// record adds a completed API call to the collected metrics.
+func (m *apiMetrics) record(call apiCall) {
+	latency := call.End.Sub(call.Start)
+	key := apiOperationKey{
+		Operation: call.Operation,
+		Region:    call.Region,
+		Service:   call.Service,
+	}
+
+	m.mu.Lock()
+	operation, ok := m.operations[key]
+	if !ok {
+		operation = &apiOperationMetrics{
+			BucketCounts: make([]int, len(apiLatencyBucketBounds)+1),
+			MinLatency:   latency,
+		}
+		m.operations[key] = operation
+	}
+
+	operation.Calls++
+	if call.Err != nil {
+		operation.Errors++
+	}
+	operation.Retries += call.Retries
+	operation.Throttles += call.Throttles
+	operation.TotalLatency += latency
+	if latency > operation.MaxLatency {
+		operation.MaxLatency = latency
+	}
+	if latency < operation.MinLatency {
+		operation.MinLatency = latency
+	}
+	operation.BucketCounts[sort.SearchFloat64s(apiLatencyBucketBounds, durationMilliseconds(latency))]++
+	m.mu.Unlock()
+
+	if m.tracer != nil {
+		m.tracer.addSpan(call)
+	}
+}
+
+type apiMetricsSummary struct {
+	EndTime    time.Time             `json:"end_time"`
+	Operations []apiOperationSummary `json:"operations"`
+	StartTime  time.Time             `json:"start_time"`
+}
+
+type apiOperationSummary struct {
+	Calls     int               `json:"calls"`
+	Errors    int               `json:"errors"`
+	Latency   apiLatencySummary `json:"latency"`
+	Operation string            `json:"operation"`
+	Region    string            `json:"region"`
+	Retries   int               `json:"retries"`
+	Service   string            `json:"service"`
+	Throttles int               `json:"throttles"`
+}
+
+type apiLatencySummary struct {
+	BucketBoundsMS []float64 `json:"bucket_bounds_ms"`
+	BucketCounts   []int     `json:"bucket_counts"`
+	MaxMS          float64   `json:"max_ms"`
+	MeanMS         float64   `json:"mean_ms"`
+	MinMS          float64   `json:"min_ms"`
+	TotalMS        float64   `json:"total_ms"`
+}
+
+// summary returns the collected metrics, ordered by service, operation and Region.
+func (m *apiMetrics) summary(end time.Time) apiMetricsSummary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	summary := apiMetricsSummary{
+		EndTime:    end,
+		Operations: make([]apiOperationSummary, 0, len(m.operations)),
+		StartTime:  m.start,
+	}
+
+	for key, operation := range m.operations {
+		summary.Operations = append(summary.Operations, apiOperationSummary{
+			Calls:  operation.Calls,
+			Errors: operation.Errors,
+			Latency: apiLatencySummary{
+				BucketBoundsMS: apiLatencyBucketBounds,
+				BucketCounts:   append([]int(nil), operation.BucketCounts...),
+				MaxMS:          durationMilliseconds(operation.MaxLatency),
+				MeanMS:         durationMilliseconds(operation.TotalLatency) / float64(operation.Calls),
+				MinMS:          durationMilliseconds(operation.MinLatency),
+				TotalMS:        durationMilliseconds(operation.TotalLatency),
+			},
+			Operation: key.Operation,
+			Region:    key.Region,
+			Retries:   operation.Retries,
+			Service:   key.Service,
+			Throttles: operation.Throttles,
+		})
+	}
+
+	sort.Slice(summary.Operations, func(i, j int) bool {
+		oi, oj := summary.Operations[i], summary.Operations[j]
+
+		if oi.Service != oj.Service {
+			return oi.Service < oj.Service
+		}
+		if oi.Operation != oj.Operation {
+			return oi.Operation < oj.Operation
+		}
+		return oi.Region < oj.Region
+	})
+
+	return summary
+}
+
+// writeSummary writes the JSON summary file, if configured.
+// Provider processes that made no API calls, for example those only serving schemas, don't write the file.
+func (m *apiMetrics) writeSummary() error {
+	if m.file == "" {
+		return nil
+	}
+
+	summary := m.summary(time.Now())
+
+	if len(summary.Operations) == 0 {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(summary, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("encoding AWS API metrics summary: %w", err)
+	}
+
+	file := strings.ReplaceAll(m.file, "{pid}", strconv.Itoa(os.Getpid()))
+
+	if err := os.WriteFile(file, b, 0644); err != nil {
+		return fmt.Errorf("writing AWS API metrics summary (%s): %w", file, err)
+	}
+
+	return nil
+}
+
+// FlushAPIMetrics writes the AWS API call metrics summary and exports any pending traces.
+// It should be called when the provider shuts down and is a no-op if API call metrics aren't enabled.
+func FlushAPIMetrics(ctx context.Context) error {
+	m := globalAPIMetrics()
+
+	if m == nil {
+		return nil
+	}
+
+	err := m.writeSummary()
+
+	if m.tracer != nil {
+		if v := m.tracer.shutdown(ctx); err == nil {
+			err = v
+		}
+	}
+
+	return err
+}
+
+// addSDKv1Handlers records the calls of all API clients created from the AWS SDK v1 Session.
+func (m *apiMetrics) addSDKv1Handlers(sess *session.Session) {
+	const name = "terraform-provider-aws.APIMetrics"
+
+	// Throttled attempts of in-flight requests.
+	var throttles sync.Map
+
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: name,
+		Fn: func(r *request.Request) {
+			if request.IsErrorThrottle(r.Error) {
+				v, _ := throttles.LoadOrStore(r, new(int))
+				*v.(*int)++
+			}
+		},
+	})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: name,
+		Fn: func(r *request.Request) {
+			call := apiCall{
+				Err:       r.Error,
+				End:       time.Now(),
+				Region:    aws.StringValue(r.Config.Region),
+				RequestID: r.RequestID,
+				Retries:   r.RetryCount,
+				Service:   r.ClientInfo.ServiceID,
+				Start:     r.Time,
+			}
+			if r.Operation != nil {
+				call.Operation = r.Operation.Name
+			}
+			if v, ok := throttles.LoadAndDelete(r); ok {
+				call.Throttles = *v.(*int)
+			}
+
+			m.record(call)
+		},
+	})
+}
+
+// sdkv2Middleware returns an AWS SDK v2 API option that records the calls of all API clients.
+// The middleware runs before the retry middleware so that all attempts are included.
+func (m *apiMetrics) sdkv2Middleware() func(*middleware.Stack) error {
+	throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("APIMetrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			call := apiCall{
+				Err:       err,
+				End:       time.Now(),
+				Operation: awsmiddleware.GetOperationName(ctx),
+				Region:    awsmiddleware.GetRegion(ctx),
+				Service:   awsmiddleware.GetServiceID(ctx),
+				Start:     start,
+			}
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				call.RequestID = v
+			}
+			if results, ok := retry.GetAttemptResults(metadata); ok {
+				if n := len(results.Results); n > 0 {
+					call.Retries = n - 1
+				}
+				for _, result := range results.Results {
+					if result.Err != nil && throttles.IsErrorThrottle(result.Err) == aws_sdkv2.TrueTernary {
+						call.Throttles++
+					}
+				}
+			}
+
+			m.record(call)
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+func durationMilliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,224 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAPIMetricsSummary(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	metrics := newAPIMetrics("", nil)
+
+	metrics.record(apiCall{
+		End:       start.Add(20 * time.Millisecond),
+		Operation: "DescribeVpcs",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		Service:   "EC2",
+		Start:     start,
+	})
+	metrics.record(apiCall{
+		End:       start.Add(2 * time.Second),
+		Err:       errors.New("RequestLimitExceeded"),
+		Operation: "DescribeVpcs",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		Retries:   3,
+		Service:   "EC2",
+		Start:     start,
+		Throttles: 2,
+	})
+	metrics.record(apiCall{
+		End:       start.Add(time.Millisecond),
+		Operation: "GetRole",
+		Region:    "us-east-1", //lintignore:AWSAT003
+		Service:   "IAM",
+		Start:     start,
+	})
+
+	summary := metrics.summary(start.Add(time.Minute))
+
+	if got, want := len(summary.Operations), 2; got != want {
+		t.Fatalf("got %d operations, want %d", got, want)
+	}
+
+	got := summary.Operations[0]
+
+	if got.Service != "EC2" || got.Operation != "DescribeVpcs" {
+		t.Fatalf("first operation is %s/%s, want EC2/DescribeVpcs", got.Service, got.Operation)
+	}
+
+	if got.Calls != 2 || got.Errors != 1 || got.Retries != 3 || got.Throttles != 2 {
+		t.Errorf("got calls %d, errors %d, retries %d, throttles %d, want 2, 1, 3, 2", got.Calls, got.Errors, got.Retries, got.Throttles)
+	}
+
+	if got.Latency.MinMS != 20 || got.Latency.MaxMS != 2000 || got.Latency.MeanMS != 1010 {
+		t.Errorf("got latency min %f, max %f, mean %f, want 20, 2000, 1010", got.Latency.MinMS, got.Latency.MaxMS, got.Latency.MeanMS)
+	}
+
+	wantBucketCounts := []int{0, 0, 1, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0}
+	if !reflect.DeepEqual(got.Latency.BucketCounts, wantBucketCounts) {
+		t.Errorf("got bucket counts %v, want %v", got.Latency.BucketCounts, wantBucketCounts)
+	}
+}
+
+func TestAPIMetricsWriteSummary(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "metrics.json")
+	metrics := newAPIMetrics(file, nil)
+
+	if err := metrics.writeSummary(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(file); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("summary without API calls written: %v", err)
+	}
+
+	now := time.Now()
+	metrics.record(apiCall{
+		End:       now,
+		Operation: "GetCallerIdentity",
+		Service:   "STS",
+		Start:     now,
+	})
+
+	if err := metrics.writeSummary(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(file)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var summary apiMetricsSummary
+
+	if err := json.Unmarshal(b, &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(summary.Operations), 1; got != want {
+		t.Errorf("got %d operations, want %d", got, want)
+	}
+}
+
+func TestAPITracerExport(t *testing.T) {
+	t.Parallel()
+
+	var got otlpTracesData
+	var gotHeader string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Api-Key")
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	tracer := newAPITracer(server.URL+"/v1/traces", map[string]string{"X-Api-Key": "secret"}, "test")
+	now := time.Now()
+
+	tracer.addSpan(apiCall{
+		End:       now,
+		Err:       errors.New("AccessDenied"),
+		Operation: "ListBuckets",
+		RequestID: "abc",
+		Service:   "S3",
+		Start:     now,
+	})
+
+	if err := tracer.shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotHeader != "secret" {
+		t.Errorf("got header %q, want %q", gotHeader, "secret")
+	}
+
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("unexpected export: %+v", got)
+	}
+
+	spans := got.ResourceSpans[0].ScopeSpans[0].Spans
+
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("got %d spans, want %d", got, want)
+	}
+
+	if spans[0].Name != "S3/ListBuckets" || spans[0].ParentSpanID != spans[1].SpanID || spans[0].Status == nil {
+		t.Errorf("unexpected API call span: %+v", spans[0])
+	}
+}
+
+func TestAPITracerShutdownContext(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	tracer := newAPITracer(server.URL+"/v1/traces", nil, "test")
+	now := time.Now()
+
+	// Fill a batch so that it is exported in the background, where the export blocks.
+	for i := 0; i < apiTracerBatchSize; i++ {
+		tracer.addSpan(apiCall{
+			End:       now,
+			Operation: "ListBuckets",
+			Service:   "S3",
+			Start:     now,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := tracer.shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %s", err, context.DeadlineExceeded)
+	}
+}
+
+func TestParseOTLPHeaders(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseOTLPHeaders("api-key=secret, x-tenant=a%20b,")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"api-key":  "secret",
+		"x-tenant": "a b",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := parseOTLPHeaders("invalid"); err == nil {
+		t.Error("expected error")
+	}
+}
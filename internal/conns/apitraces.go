@@ -0,0 +1,316 @@
+package conns
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	apiTracerBatchSize       = 512
+	apiTracerDefaultEndpoint = "http://localhost:4318"
+	apiTracerExportTimeout   = 10 * time.Second // The OpenTelemetry default export timeout.
+	apiTracerScopeName       = "github.com/hashicorp/terraform-provider-aws/internal/conns"
+	apiTracerServiceName     = "terraform-provider-aws"
+)
+
+// apiTracer exports a span for each AWS API call using the OpenTelemetry Protocol (OTLP) with JSON encoding over HTTP.
+// All of a provider process's API calls are children of a single root span, exported on shutdown.
+type apiTracer struct {
+	endpoint    string
+	headers     map[string]string
+	httpClient  *http.Client
+	mu          sync.Mutex
+	rootSpanID  string
+	serviceName string
+	spans       []otlpSpan
+	start       time.Time
+	traceID     string
+	wg          sync.WaitGroup
+}
+
+// newAPITracerFromEnv returns a tracer configured by the standard OpenTelemetry environment variables,
+// or nil if tracing isn't enabled.
+func newAPITracerFromEnv() (*apiTracer, error) {
+	switch v := os.Getenv(envvar.OTelTracesExporter); v {
+	case "", "none":
+		return nil, nil
+	case "otlp":
+	default:
+		return nil, fmt.Errorf("unsupported %s: %s", envvar.OTelTracesExporter, v)
+	}
+
+	if v := os.Getenv(envvar.OTelExporterOTLPProtocol); v != "" && v != "http/json" {
+		return nil, fmt.Errorf("unsupported %s: %s", envvar.OTelExporterOTLPProtocol, v)
+	}
+
+	endpoint := os.Getenv(envvar.OTelExporterOTLPTracesEndpoint)
+	if endpoint == "" {
+		endpoint = strings.TrimSuffix(envvar.GetWithDefault(envvar.OTelExporterOTLPEndpoint, apiTracerDefaultEndpoint), "/") + "/v1/traces"
+	}
+
+	headers, err := parseOTLPHeaders(os.Getenv(envvar.OTelExporterOTLPHeaders))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newAPITracer(endpoint, headers, envvar.GetWithDefault(envvar.OTelServiceName, apiTracerServiceName)), nil
+}
+
+func newAPITracer(endpoint string, headers map[string]string, serviceName string) *apiTracer {
+	httpClient := cleanhttp.DefaultClient()
+	httpClient.Timeout = apiTracerExportTimeout
+
+	return &apiTracer{
+		endpoint:    endpoint,
+		headers:     headers,
+		httpClient:  httpClient,
+		rootSpanID:  randomHex(8),
+		serviceName: serviceName,
+		start:       time.Now(),
+		traceID:     randomHex(16),
+	}
+}
+
+// parseOTLPHeaders parses a comma-separated list of "key=value" pairs with URL-encoded values.
+func parseOTLPHeaders(s string) (map[string]string, error) {
+	headers := make(map[string]string)
+
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		k, v, ok := strings.Cut(pair, "=")
+
+		if !ok {
+			return nil, fmt.Errorf("invalid %s: %q", envvar.OTelExporterOTLPHeaders, pair)
+		}
+
+		v, err := url.QueryUnescape(strings.TrimSpace(v))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %q: %w", envvar.OTelExporterOTLPHeaders, pair, err)
+		}
+
+		headers[strings.TrimSpace(k)] = v
+	}
+
+	return headers, nil
+}
+
+// addSpan adds a span for the specified API call, exporting a batch of spans in the background once enough are pending.
+func (t *apiTracer) addSpan(call apiCall) {
+	span := otlpSpan{
+		Attributes: []otlpKeyValue{
+			otlpStringAttribute("rpc.system", "aws-api"),
+			otlpStringAttribute("rpc.service", call.Service),
+			otlpStringAttribute("rpc.method", call.Operation),
+			otlpStringAttribute("cloud.region", call.Region),
+			otlpIntAttribute("aws.retries", call.Retries),
+			otlpIntAttribute("aws.throttles", call.Throttles),
+		},
+		EndTimeUnixNano:   strconv.FormatInt(call.End.UnixNano(), 10),
+		Kind:              otlpSpanKindClient,
+		Name:              call.Service + "/" + call.Operation,
+		ParentSpanID:      t.rootSpanID,
+		SpanID:            randomHex(8),
+		StartTimeUnixNano: strconv.FormatInt(call.Start.UnixNano(), 10),
+		TraceID:           t.traceID,
+	}
+	if call.RequestID != "" {
+		span.Attributes = append(span.Attributes, otlpStringAttribute("aws.request_id", call.RequestID))
+	}
+	if call.Err != nil {
+		span.Status = &otlpStatus{
+			Code:    otlpStatusCodeError,
+			Message: call.Err.Error(),
+		}
+	}
+
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	var batch []otlpSpan
+	if len(t.spans) >= apiTracerBatchSize {
+		batch, t.spans = t.spans, nil
+	}
+	t.mu.Unlock()
+
+	if batch != nil {
+		t.wg.Add(1)
+		go func() {
+			defer t.wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), apiTracerExportTimeout)
+			defer cancel()
+
+			if err := t.export(ctx, batch); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+		}()
+	}
+}
+
+// shutdown exports the root span and any pending spans.
+// It waits for exports in progress to complete, unless the context is done first.
+func (t *apiTracer) shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("exporting OTLP traces (%s): %w", t.endpoint, ctx.Err())
+	}
+
+	t.mu.Lock()
+	batch := append(t.spans, otlpSpan{
+		EndTimeUnixNano:   strconv.FormatInt(time.Now().UnixNano(), 10),
+		Kind:              otlpSpanKindInternal,
+		Name:              t.serviceName,
+		SpanID:            t.rootSpanID,
+		StartTimeUnixNano: strconv.FormatInt(t.start.UnixNano(), 10),
+		TraceID:           t.traceID,
+	})
+	t.spans = nil
+	t.mu.Unlock()
+
+	return t.export(ctx, batch)
+}
+
+func (t *apiTracer) export(ctx context.Context, spans []otlpSpan) error {
+	body, err := json.Marshal(otlpTracesData{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{otlpStringAttribute("service.name", t.serviceName)},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: apiTracerScopeName},
+				Spans: spans,
+			}},
+		}},
+	})
+
+	if err != nil {
+		return fmt.Errorf("encoding OTLP traces: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(body))
+
+	if err != nil {
+		return fmt.Errorf("exporting OTLP traces (%s): %w", t.endpoint, err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	for k, v := range t.headers {
+		request.Header.Set(k, v)
+	}
+
+	response, err := t.httpClient.Do(request)
+
+	if err != nil {
+		return fmt.Errorf("exporting OTLP traces (%s): %w", t.endpoint, err)
+	}
+
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("exporting OTLP traces (%s): %s", t.endpoint, response.Status)
+	}
+
+	return nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// OTLP JSON encoding.
+// See https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto.
+
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindClient   = 3
+
+	otlpStatusCodeError = 2
+)
+
+type otlpTracesData struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Kind              int            `json:"kind"`
+	Name              string         `json:"name"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	SpanID            string         `json:"spanId"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+	TraceID           string         `json:"traceId"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	IntValue    string `json:"intValue,omitempty"`
+	StringValue string `json:"stringValue,omitempty"`
+}
+
+func otlpStringAttribute(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
+
+func otlpIntAttribute(key string, value int) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: strconv.Itoa(value)}}
+}
@@ -0,0 +1,83 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// assumeRoleExpiryWindow is how long before they expire chained role credentials are refreshed.
+const assumeRoleExpiryWindow = 5 * time.Minute
+
+// assumeRoleChain returns a credentials provider that assumes each of the specified IAM Roles in sequence,
+// starting from the credentials in cfg.
+// Credentials for each hop are cached and refreshed before they expire.
+// The first role is hop 1, the role assumed when cfg was loaded being hop 0.
+func assumeRoleChain(ctx context.Context, cfg aws_sdkv2.Config, roles []*awsbase.AssumeRole, stsRegion, stsEndpoint string) (aws_sdkv2.CredentialsProvider, error) {
+	for i, ar := range roles {
+		if ar == nil || ar.RoleARN == "" {
+			return nil, fmt.Errorf("assuming IAM Role (hop %d): role ARN not set", i+1)
+		}
+
+		log.Printf("[INFO] Assuming chained IAM Role %q (SessionName: %q, ExternalId: %q, SourceIdentity: %q)", ar.RoleARN, ar.SessionName, ar.ExternalID, ar.SourceIdentity)
+
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+			if stsEndpoint != "" {
+				o.EndpointResolver = sts.EndpointResolverFromURL(stsEndpoint)
+			}
+		})
+
+		provider := aws_sdkv2.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, assumeRoleOptions(ar)), func(o *aws_sdkv2.CredentialsCacheOptions) {
+			o.ExpiryWindow = assumeRoleExpiryWindow
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+		}
+
+		cfg.Credentials = provider
+	}
+
+	return cfg.Credentials, nil
+}
+
+func assumeRoleOptions(ar *awsbase.AssumeRole) func(*stscreds.AssumeRoleOptions) {
+	return func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = ar.SessionName
+		o.Duration = ar.Duration
+
+		if ar.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			o.Policy = aws_sdkv2.String(ar.Policy)
+		}
+
+		for _, v := range ar.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{Arn: aws_sdkv2.String(v)})
+		}
+
+		for k, v := range ar.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{Key: aws_sdkv2.String(k), Value: aws_sdkv2.String(v)})
+		}
+
+		if len(ar.TransitiveTagKeys) > 0 {
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+		}
+
+		if ar.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+		}
+	}
+}
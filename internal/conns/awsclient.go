@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
@@ -13,6 +15,13 @@ func (client *AWSClient) InitContext(ctx context.Context) context.Context {
 	return ctx
 }
 
+// BatchingContext returns a Context in which concurrent requests for single items may be batched, see BatcherFor.
+// Each batched request waits for other requests to batch with, so it should only be used where concurrent callers exist,
+// e.g. when resources are read during refresh.
+func (client *AWSClient) BatchingContext(ctx context.Context) context.Context {
+	return newBatchersContext(ctx, &client.batchers)
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -43,3 +52,20 @@ func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
 func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
+
+// AddSDKv1Hook adds a function that customizes the AWS SDK v1 Session all API clients are created from.
+// To have effect it must be called before the AWS SDK v1 Session is created.
+func (client *AWSClient) AddSDKv1Hook(f func(*session.Session)) {
+	if client.Session == nil {
+		client.sdkv1Hooks = append(client.sdkv1Hooks, f)
+	}
+}
+
+// AddSDKv2Hook adds a function that customizes the AWS SDK v2 Config all API clients are created from.
+// Lazily created API clients are also customized.
+// To have effect it must be called before the provider is configured and the AWS SDK v2 Config is loaded.
+func (client *AWSClient) AddSDKv2Hook(f func(*aws_sdkv2.Config)) {
+	if client.Session == nil {
+		client.sdkv2Hooks = append(client.sdkv2Hooks, f)
+	}
+}
@@ -3,7 +3,9 @@ package conns
 
 import (
 	"net/http"
+	"sync"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
@@ -325,17 +327,24 @@ import (
 type AWSClient struct {
 	AccountID               string
 	DefaultTagsConfig       *tftags.DefaultConfig
+	DefaultTimeouts         map[string]ResourceTimeouts
 	DNSSuffix               string
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert.MediaConvert
 	Partition               string
+	PreventDestroyTags      tftags.KeyValueTags
 	Region                  string
+	RequiredTagsConfig      *tftags.RequiredConfig
 	ReverseDNSPrefix        string
 	ServicePackages         []intf.ServicePackage
 	Session                 *session.Session
 	TerraformVersion        string
 
-	httpClient *http.Client
+	batchers        sync.Map // Batchers, keyed by API client and operation.
+	httpClient      *http.Client
+	regionalClients *regionalClients
+	sdkv1Hooks      []func(*session.Session)
+	sdkv2Hooks      []func(*aws_sdkv2.Config)
 
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
 	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
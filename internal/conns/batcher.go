@@ -0,0 +1,163 @@
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// batchMaxDuration bounds how long a batched request runs when none of its callers has a deadline.
+const batchMaxDuration = 5 * time.Minute
+
+// Batcher coalesces concurrent requests for single items into batched API requests.
+// Requests received within a short window are sent together, up to the API's maximum number of items per request,
+// and the results are fanned back out to the callers.
+type Batcher[K comparable, V any] struct {
+	fetch    func(context.Context, []K) (map[K]V, error)
+	maxItems int
+	window   time.Duration
+
+	mu      sync.Mutex
+	pending *pendingBatch[K, V]
+}
+
+type pendingBatch[K comparable, V any] struct {
+	ctx      context.Context
+	deadline time.Time // Latest deadline of the batch's callers.
+	done     chan struct{}
+	err      error
+	keys     []K
+	once     sync.Once
+	results  map[K]V
+	seen     map[K]struct{}
+}
+
+// NewBatcher returns a new Batcher.
+// fetch is called with up to maxItems keys and returns the items found, by key.
+func NewBatcher[K comparable, V any](window time.Duration, maxItems int, fetch func(context.Context, []K) (map[K]V, error)) *Batcher[K, V] {
+	return &Batcher[K, V]{
+		fetch:    fetch,
+		maxItems: maxItems,
+		window:   window,
+	}
+}
+
+// Get returns the item with the specified key.
+// The returned bool is false if the batched request didn't return the item.
+func (b *Batcher[K, V]) Get(ctx context.Context, key K) (V, bool, error) {
+	var zero V
+
+	b.mu.Lock()
+	p := b.pending
+	if p == nil {
+		p = &pendingBatch[K, V]{
+			// The batched request is shared by all callers and must not be canceled by the first.
+			ctx:  withoutCancel{ctx},
+			done: make(chan struct{}),
+			seen: make(map[K]struct{}),
+		}
+		b.pending = p
+		time.AfterFunc(b.window, func() { b.send(p) })
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(batchMaxDuration)
+	}
+	if deadline.After(p.deadline) {
+		p.deadline = deadline
+	}
+	if _, ok := p.seen[key]; !ok {
+		p.seen[key] = struct{}{}
+		p.keys = append(p.keys, key)
+	}
+	if len(p.keys) >= b.maxItems {
+		b.pending = nil
+		go b.send(p)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		return zero, false, ctx.Err()
+	}
+
+	if p.err != nil {
+		return zero, false, p.err
+	}
+
+	v, ok := p.results[key]
+
+	return v, ok, nil
+}
+
+func (b *Batcher[K, V]) send(p *pendingBatch[K, V]) {
+	b.mu.Lock()
+	if b.pending == p {
+		b.pending = nil
+	}
+	b.mu.Unlock()
+
+	p.once.Do(func() {
+		b.mu.Lock()
+		deadline := p.deadline
+		b.mu.Unlock()
+
+		// The batched request outlives any single caller, but not the last of them.
+		ctx, cancel := context.WithDeadline(p.ctx, deadline)
+		defer cancel()
+
+		p.results, p.err = b.fetch(ctx, p.keys)
+		close(p.done)
+	})
+}
+
+type batchersKey struct{}
+
+type batcherKey struct {
+	apiClient any
+	operation string
+}
+
+// newBatchersContext returns a Context that carries the specified Batchers.
+func newBatchersContext(ctx context.Context, batchers *sync.Map) context.Context {
+	return context.WithValue(ctx, batchersKey{}, batchers)
+}
+
+// BatcherFor returns the Batcher for an API client's operation, creating it on first use.
+// Batchers belong to the AWSClient whose BatchingContext created the Context, so live no longer than it does.
+// The returned bool is false if the Context carries no AWSClient's Batchers, in which case requests should not be batched.
+func BatcherFor[K comparable, V any](ctx context.Context, apiClient any, operation string, create func() *Batcher[K, V]) (*Batcher[K, V], bool) {
+	batchers, ok := ctx.Value(batchersKey{}).(*sync.Map)
+
+	if !ok {
+		return nil, false
+	}
+
+	key := batcherKey{apiClient: apiClient, operation: operation}
+
+	if v, ok := batchers.Load(key); ok {
+		return v.(*Batcher[K, V]), true
+	}
+
+	v, _ := batchers.LoadOrStore(key, create())
+
+	return v.(*Batcher[K, V]), true
+}
+
+// withoutCancel is a Context that carries its parent's values but is never canceled.
+type withoutCancel struct {
+	context.Context
+}
+
+func (withoutCancel) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (withoutCancel) Done() <-chan struct{} {
+	return nil
+}
+
+func (withoutCancel) Err() error {
+	return nil
+}
@@ -0,0 +1,206 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var calls [][]string
+
+	b := NewBatcher(20*time.Millisecond, 3, func(ctx context.Context, keys []string) (map[string]string, error) {
+		mu.Lock()
+		calls = append(calls, keys)
+		mu.Unlock()
+
+		results := make(map[string]string)
+		for _, k := range keys {
+			if k != "missing" {
+				results[k] = "value-" + k
+			}
+		}
+
+		return results, nil
+	})
+
+	keys := []string{"a", "b", "a", "missing", "c"}
+	type result struct {
+		v   string
+		ok  bool
+		err error
+	}
+	results := make([]result, len(keys))
+
+	var wg sync.WaitGroup
+	for i, k := range keys {
+		wg.Add(1)
+		go func(i int, k string) {
+			defer wg.Done()
+			v, ok, err := b.Get(context.Background(), k)
+			results[i] = result{v, ok, err}
+		}(i, k)
+	}
+	wg.Wait()
+
+	for i, k := range keys {
+		got := results[i]
+
+		if got.err != nil {
+			t.Errorf("Get(%s): %s", k, got.err)
+			continue
+		}
+
+		if k == "missing" {
+			if got.ok {
+				t.Errorf("Get(%s): found, want not found", k)
+			}
+			continue
+		}
+
+		if want := "value-" + k; !got.ok || got.v != want {
+			t.Errorf("Get(%s) = %s, %t, want %s", k, got.v, got.ok, want)
+		}
+	}
+
+	var n int
+	for _, v := range calls {
+		if len(v) > 3 {
+			t.Errorf("batch of %d keys, want at most 3", len(v))
+		}
+		n += len(v)
+	}
+
+	// Duplicate keys within a batch are only requested once.
+	if len(calls) > 2 || n > 5 {
+		t.Errorf("calls = %v, want at most 2 batches", calls)
+	}
+}
+
+func TestBatcherError(t *testing.T) {
+	t.Parallel()
+
+	want := errors.New("throttled")
+	b := NewBatcher(time.Millisecond, 10, func(ctx context.Context, keys []string) (map[string]int, error) {
+		return nil, want
+	})
+
+	if _, _, err := b.Get(context.Background(), "a"); !errors.Is(err, want) {
+		t.Errorf("error = %v, want %v", err, want)
+	}
+}
+
+func TestBatcherFor(t *testing.T) {
+	t.Parallel()
+
+	var n int
+	create := func() *Batcher[string, int] {
+		n++
+		return NewBatcher(time.Millisecond, 10, func(ctx context.Context, keys []string) (map[string]int, error) {
+			return nil, nil
+		})
+	}
+
+	apiClient1, apiClient2 := new(int), new(int)
+	client1, client2 := new(AWSClient), new(AWSClient)
+	ctx1, ctx2 := client1.BatchingContext(context.Background()), client2.BatchingContext(context.Background())
+
+	batcherFor := func(ctx context.Context, apiClient any) *Batcher[string, int] {
+		t.Helper()
+
+		b, ok := BatcherFor(ctx, apiClient, "Describe", create)
+
+		if !ok {
+			t.Fatal("expected a Batcher")
+		}
+
+		return b
+	}
+
+	if batcherFor(ctx1, apiClient1) != batcherFor(ctx1, apiClient1) {
+		t.Error("expected the same Batcher")
+	}
+
+	if batcherFor(ctx1, apiClient1) == batcherFor(ctx1, apiClient2) {
+		t.Error("expected different Batchers for different API clients")
+	}
+
+	if batcherFor(ctx1, apiClient1) == batcherFor(ctx2, apiClient1) {
+		t.Error("expected different Batchers for different AWSClients")
+	}
+
+	if got, want := n, 3; got != want {
+		t.Errorf("created %d Batchers, want %d", got, want)
+	}
+
+	if _, ok := BatcherFor(context.Background(), apiClient1, "Describe", create); ok {
+		t.Error("expected no Batcher without an AWSClient's Context")
+	}
+}
+
+func TestBatcherDeadline(t *testing.T) {
+	t.Parallel()
+
+	deadlines := make(chan time.Time, 1)
+	// The batch is sent once both callers have joined it.
+	b := NewBatcher(time.Minute, 2, func(ctx context.Context, keys []string) (map[string]int, error) {
+		deadline, ok := ctx.Deadline()
+
+		if !ok {
+			t.Error("expected a deadline")
+		}
+
+		deadlines <- deadline
+
+		return nil, nil
+	})
+
+	ctx1, cancel1 := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel1()
+	ctx2, cancel2 := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel2()
+
+	var wg sync.WaitGroup
+	for key, ctx := range map[string]context.Context{"a": ctx1, "b": ctx2} {
+		wg.Add(1)
+		go func(ctx context.Context, key string) {
+			defer wg.Done()
+			b.Get(ctx, key) //nolint:errcheck // Only the batched request's deadline is checked.
+		}(ctx, key)
+	}
+	wg.Wait()
+
+	// The batched request runs until the latest of its callers' deadlines.
+	want, _ := ctx2.Deadline()
+
+	if got := <-deadlines; !got.Equal(want) {
+		t.Errorf("deadline = %s, want %s", got, want)
+	}
+}
+
+func TestBatcherDefaultDeadline(t *testing.T) {
+	t.Parallel()
+
+	var got time.Time
+	b := NewBatcher(time.Millisecond, 10, func(ctx context.Context, keys []string) (map[string]int, error) {
+		got, _ = ctx.Deadline()
+
+		return nil, nil
+	})
+
+	start := time.Now()
+
+	if _, _, err := b.Get(context.Background(), "a"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A batched request whose callers have no deadline is still bounded.
+	if got.IsZero() || got.Before(start.Add(batchMaxDuration)) || got.After(time.Now().Add(batchMaxDuration)) {
+		t.Errorf("deadline = %s, want %s after the request", got, batchMaxDuration)
+	}
+}
@@ -5,11 +5,13 @@ import (
 	"log"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
@@ -45,22 +47,28 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	APIRateLimits                  map[string]APIRateLimit
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeouts                map[string]ResourceTimeouts
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	Emulator                       bool
+	EndpointURL                    string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	PreventDestroyTags             tftags.KeyValueTags
 	Profile                        string
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -79,6 +87,12 @@ type Config struct {
 
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
+	if c.Emulator {
+		c.applyEmulatorProfile()
+	}
+
+	c.resolveEndpoints()
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		APNInfo:                       StdUserAgentProducts(c.TerraformVersion),
@@ -103,8 +117,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
+	} else if len(c.AssumeRole) > 1 {
+		return nil, diag.Errorf("configuring Terraform AWS Provider: assuming IAM Role (hop 0): role ARN not set")
 	}
 
 	if c.CustomCABundle != "" {
@@ -133,6 +149,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	// Any further roles are assumed in sequence using the credentials of the previous one.
+	if len(c.AssumeRole) > 1 {
+		cfg.Credentials, err = assumeRoleChain(ctx, cfg, c.AssumeRole[1:], c.STSRegion, c.Endpoints[names.STS])
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -140,11 +164,38 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	var limiters *apiLimiters
+	if len(c.APIRateLimits) > 0 {
+		limiters = newAPILimiters(c.APIRateLimits)
+		cfg.APIOptions = append(cfg.APIOptions, limiters.sdkv2Middleware())
+	}
+
+	metrics := globalAPIMetrics()
+	if metrics != nil {
+		cfg.APIOptions = append(cfg.APIOptions, metrics.sdkv2Middleware())
+	}
+
+	for _, f := range client.sdkv2Hooks {
+		f(&cfg)
+	}
+
 	sess, err := awsbasev1.GetSession(&cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	if limiters != nil {
+		limiters.addSDKv1Handlers(sess)
+	}
+
+	if metrics != nil {
+		metrics.addSDKv1Handlers(sess)
+	}
+
+	for _, f := range client.sdkv1Hooks {
+		f(sess)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("retrieving AWS account details: %s", err)
@@ -181,15 +232,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DefaultTimeouts = c.DefaultTimeouts
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
+	client.PreventDestroyTags = c.PreventDestroyTags
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
+	client.regionalClients = newRegionalClients(c, client, cfg, sess)
 
+	c.configureClients(client, cfg, sess, partition)
+
+	return client, nil
+}
+
+// configureClients creates the provided AWSClient's API clients from the AWS SDK v2 Config and AWS SDK v1 Session.
+func (c *Config) configureClients(client *AWSClient, cfg aws_sdkv2.Config, sess *session.Session, partition string) {
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
 	c.sdkv2Conns(client, cfg)
@@ -334,7 +396,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			}
 		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
 			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
+				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
 					r.Retryable = aws.Bool(true)
 				}
 				return
@@ -509,7 +571,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
 		}
@@ -525,6 +587,4 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			o.Region = endpoints.UsEast1RegionID
 		}
 	})
-
-	return client, nil
 }
@@ -0,0 +1,69 @@
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type resourceTypeNameKey struct{}
+
+// NewResourceContext returns a Context that carries the type name of the resource being operated on.
+func NewResourceContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, resourceTypeNameKey{}, typeName)
+}
+
+// ResourceTypeNameFromContext returns the type name of the resource being operated on, if any.
+func ResourceTypeNameFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(resourceTypeNameKey{}).(string)
+
+	return v, ok
+}
+
+type planWarningsKey struct{}
+
+type planWarnings struct {
+	mu          sync.Mutex
+	diagnostics diag.Diagnostics
+}
+
+// NewPlanWarningsContext returns a Context in which warnings about a planned resource change can be recorded
+// and a function that returns the warnings recorded so far.
+// Plugin SDK CustomizeDiff functions can only return errors, so warnings are passed back to the provider server this way.
+func NewPlanWarningsContext(ctx context.Context) (context.Context, func() diag.Diagnostics) {
+	v := &planWarnings{}
+
+	return context.WithValue(ctx, planWarningsKey{}, v), func() diag.Diagnostics {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+
+		return v.diagnostics
+	}
+}
+
+// AddPlanWarning records a warning about a planned resource change.
+// Identical warnings, e.g. from a CustomizeDiff function that is re-run, are recorded once.
+// Returns false if the Context can't record warnings.
+func AddPlanWarning(ctx context.Context, warning diag.Diagnostic) bool {
+	v, ok := ctx.Value(planWarningsKey{}).(*planWarnings)
+
+	if !ok {
+		return false
+	}
+
+	warning.Severity = diag.Warning
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, d := range v.diagnostics {
+		if d.Summary == warning.Summary && d.Detail == warning.Detail && d.AttributePath.Equals(warning.AttributePath) {
+			return true
+		}
+	}
+
+	v.diagnostics = append(v.diagnostics, warning)
+
+	return true
+}
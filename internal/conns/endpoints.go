@@ -0,0 +1,63 @@
+package conns
+
+import (
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// EndpointURLEnvVar is the environment variable overriding the endpoint for all AWS services.
+	EndpointURLEnvVar = "AWS_ENDPOINT_URL"
+)
+
+// ServiceEndpointURLEnvVar returns the environment variable overriding the endpoint for the specified service,
+// e.g. `AWS_ENDPOINT_URL_CLOUDWATCH_LOGS` for the `logs` service.
+// As with the AWS SDKs and CLI, the variable is named for the service's SDK service ID.
+func ServiceEndpointURLEnvVar(service string) string {
+	id := names.SDKID(service)
+	if id == "" {
+		id = service
+	}
+
+	return EndpointURLEnvVar + "_" + strings.ToUpper(strings.ReplaceAll(id, " ", "_"))
+}
+
+// resolveEndpoints fills in the endpoint of each service that has no explicit override.
+// In order of precedence, the endpoint is taken from the service's `AWS_ENDPOINT_URL_<SERVICE>` environment variable,
+// the provider-wide endpoint URL or the `AWS_ENDPOINT_URL` environment variable.
+func (c *Config) resolveEndpoints() {
+	endpointURL := c.EndpointURL
+	if endpointURL == "" {
+		endpointURL = os.Getenv(EndpointURLEnvVar)
+	}
+
+	for _, service := range names.ProviderPackages() {
+		if c.Endpoints[service] != "" {
+			continue
+		}
+
+		v := os.Getenv(ServiceEndpointURLEnvVar(service))
+		if v == "" {
+			v = endpointURL
+		}
+
+		if v == "" {
+			continue
+		}
+
+		if c.Endpoints == nil {
+			c.Endpoints = make(map[string]string)
+		}
+		c.Endpoints[service] = v
+	}
+}
+
+// applyEmulatorProfile configures the provider for a local emulator of the AWS APIs.
+func (c *Config) applyEmulatorProfile() {
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+}
@@ -0,0 +1,58 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestServiceEndpointURLEnvVar(t *testing.T) {
+	t.Parallel()
+
+	for service, want := range map[string]string{
+		names.DynamoDB: "AWS_ENDPOINT_URL_DYNAMODB",
+		names.ELBV2:    "AWS_ENDPOINT_URL_ELASTIC_LOAD_BALANCING_V2",
+		names.Logs:     "AWS_ENDPOINT_URL_CLOUDWATCH_LOGS",
+	} {
+		if got := ServiceEndpointURLEnvVar(service); got != want {
+			t.Errorf("ServiceEndpointURLEnvVar(%q) = %q, want %q", service, got, want)
+		}
+	}
+}
+
+func TestResolveEndpoints(t *testing.T) { //nolint:paralleltest
+	t.Setenv(EndpointURLEnvVar, "http://env.test")
+	t.Setenv("AWS_ENDPOINT_URL_DYNAMODB", "http://dynamodb.test")
+	t.Setenv("AWS_ENDPOINT_URL_CLOUDWATCH_LOGS", "http://logs.test")
+
+	c := &Config{
+		EndpointURL: "http://localhost:4566",
+		Endpoints: map[string]string{
+			names.S3: "http://s3.test",
+		},
+	}
+	c.resolveEndpoints()
+
+	for service, want := range map[string]string{
+		names.DynamoDB: "http://dynamodb.test",
+		names.EC2:      "http://localhost:4566",
+		names.Logs:     "http://logs.test",
+		names.S3:       "http://s3.test",
+		names.STS:      "http://localhost:4566",
+	} {
+		if got := c.Endpoints[service]; got != want {
+			t.Errorf("%s endpoint = %q, want %q", service, got, want)
+		}
+	}
+
+	c = &Config{}
+	c.resolveEndpoints()
+
+	if got, want := c.Endpoints[names.Logs], "http://logs.test"; got != want {
+		t.Errorf("Logs endpoint = %q, want %q", got, want)
+	}
+
+	if got, want := c.Endpoints[names.EC2], "http://env.test"; got != want {
+		t.Errorf("EC2 endpoint = %q, want %q", got, want)
+	}
+}
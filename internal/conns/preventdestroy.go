@@ -0,0 +1,30 @@
+package conns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// PreventDestroy returns an error if any of a resource's tags matches the provider's prevent_destroy_tags configuration.
+func (client *AWSClient) PreventDestroy(tags tftags.KeyValueTags) error {
+	if len(client.PreventDestroyTags) == 0 {
+		return nil
+	}
+
+	matches := tags.MatchPatterns(client.PreventDestroyTags).Map()
+
+	if len(matches) == 0 {
+		return nil
+	}
+
+	var pairs []string
+	for k, v := range matches {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+
+	return fmt.Errorf("destroy prevented by provider prevent_destroy_tags configuration: resource is tagged %s", strings.Join(pairs, ", "))
+}
@@ -0,0 +1,263 @@
+package conns
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// APIRateLimitDefaultService is the key of the API rate limit applied to services without their own.
+const APIRateLimitDefaultService = ""
+
+// APIRateLimit configures client-side shaping of a service's API traffic.
+// Zero values disable the corresponding limit.
+type APIRateLimit struct {
+	// Burst is the maximum number of requests sent at once after a period of inactivity.
+	Burst int
+	// MaxConcurrentRequests is the maximum number of requests in flight at any time.
+	MaxConcurrentRequests int
+	// RequestsPerSecond is the sustained rate at which requests are sent.
+	RequestsPerSecond float64
+}
+
+// apiLimiter shapes the API traffic to a single service.
+// Each request attempt, including retries, is subject to both limits.
+type apiLimiter struct {
+	bucket    *tokenBucket
+	semaphore chan struct{}
+}
+
+func newAPILimiter(limit APIRateLimit) *apiLimiter {
+	limiter := &apiLimiter{}
+
+	if limit.RequestsPerSecond > 0 {
+		limiter.bucket = newTokenBucket(limit.RequestsPerSecond, limit.Burst)
+	}
+
+	if limit.MaxConcurrentRequests > 0 {
+		limiter.semaphore = make(chan struct{}, limit.MaxConcurrentRequests)
+	}
+
+	return limiter
+}
+
+// acquire waits until a request can be sent.
+// If acquire returns no error, release must be called once the request has completed.
+func (l *apiLimiter) acquire(ctx context.Context) error {
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (l *apiLimiter) release() {
+	if l.semaphore != nil {
+		<-l.semaphore
+	}
+}
+
+// tokenBucket is a token bucket rate limiter.
+type tokenBucket struct {
+	mu     sync.Mutex
+	burst  float64
+	last   time.Time
+	rate   float64
+	tokens float64
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		burst:  float64(burst),
+		last:   time.Now(),
+		rate:   rate,
+		tokens: float64(burst),
+	}
+}
+
+// take removes a token from the bucket if one is available, otherwise returning how long until one will be.
+func (b *tokenBucket) take(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := b.take(time.Now())
+
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		}
+	}
+}
+
+// apiLimiters holds the limiters for all services, created as each service is first called.
+type apiLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*apiLimiter
+	limits   map[string]APIRateLimit
+}
+
+func newAPILimiters(limits map[string]APIRateLimit) *apiLimiters {
+	normalized := make(map[string]APIRateLimit, len(limits))
+
+	for k, v := range limits {
+		normalized[normalizeAPIRateLimitService(k)] = v
+	}
+
+	return &apiLimiters{
+		limiters: make(map[string]*apiLimiter),
+		limits:   normalized,
+	}
+}
+
+// limiter returns the limiter for the service with the specified identifiers, or nil if the service's traffic isn't limited.
+// A service is identified by its API service ID (e.g. "Route 53") or its signing name (e.g. "route53").
+func (l *apiLimiters) limiter(serviceID, signingName string) *apiLimiter {
+	serviceID, signingName = normalizeAPIRateLimitService(serviceID), normalizeAPIRateLimitService(signingName)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := serviceID
+	if key == "" {
+		key = signingName
+	}
+
+	if limiter, ok := l.limiters[key]; ok {
+		return limiter
+	}
+
+	var limiter *apiLimiter
+
+	if limit, ok := l.limits[serviceID]; ok && serviceID != "" {
+		limiter = newAPILimiter(limit)
+	} else if limit, ok := l.limits[signingName]; ok && signingName != "" {
+		limiter = newAPILimiter(limit)
+	} else if limit, ok := l.limits[APIRateLimitDefaultService]; ok {
+		limiter = newAPILimiter(limit)
+	}
+
+	l.limiters[key] = limiter
+
+	return limiter
+}
+
+// normalizeAPIRateLimitService returns the case-insensitive, space-insensitive form of a service identifier.
+func normalizeAPIRateLimitService(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, " ", ""))
+}
+
+// addSDKv1Handlers limits the traffic of all API clients created from the AWS SDK v1 Session.
+// Limits are applied as each attempt is sent so that retries are also limited.
+// Presigned requests are signed but never sent, so they aren't limited.
+func (l *apiLimiters) addSDKv1Handlers(sess *session.Session) {
+	const name = "terraform-provider-aws.APIRateLimit"
+
+	// Limiters acquired by in-flight request attempts.
+	// Every attempt that runs the Send handlers also runs the CompleteAttempt handlers, which release the limiter.
+	var acquired sync.Map
+
+	sess.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: name,
+		Fn: func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			signingName := r.ClientInfo.SigningName
+			if signingName == "" {
+				signingName = r.ClientInfo.ServiceName
+			}
+
+			limiter := l.limiter(r.ClientInfo.ServiceID, signingName)
+
+			if limiter == nil {
+				return
+			}
+
+			if err := limiter.acquire(r.Context()); err != nil {
+				r.Error = err
+				return
+			}
+
+			acquired.Store(r, limiter)
+		},
+	})
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: name,
+		Fn: func(r *request.Request) {
+			if v, ok := acquired.LoadAndDelete(r); ok {
+				v.(*apiLimiter).release()
+			}
+		},
+	})
+}
+
+// sdkv2Middleware returns an AWS SDK v2 API option that limits the traffic of all API clients.
+// The middleware runs after the retry middleware so that each attempt is limited.
+func (l *apiLimiters) sdkv2Middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("APIRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			limiter := l.limiter(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetSigningName(ctx))
+
+			if limiter == nil {
+				return next.HandleFinalize(ctx, in)
+			}
+
+			if err := limiter.acquire(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			defer limiter.release()
+
+			return next.HandleFinalize(ctx, in)
+		}), middleware.After)
+	}
+}
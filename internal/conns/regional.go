@@ -0,0 +1,131 @@
+package conns
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// RegionalImportIDSeparator separates a resource's import ID from the Region in which the resource is imported.
+const RegionalImportIDSeparator = "@"
+
+var importIDRegionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// SplitRegionalImportID splits an import ID of the form "<id>@<region>" into the resource's ID and Region.
+// The import ID is returned unchanged, with an empty Region, if it has no Region suffix.
+func SplitRegionalImportID(importID string) (string, string) {
+	i := strings.LastIndex(importID, RegionalImportIDSeparator)
+
+	if i < 1 {
+		return importID, ""
+	}
+
+	if region := importID[i+1:]; importIDRegionRegexp.MatchString(region) {
+		return importID[:i], region
+	}
+
+	return importID, ""
+}
+
+// regionalClients creates and caches AWSClients whose API clients make calls to Regions
+// other than the provider's configured Region.
+type regionalClients struct {
+	awsConfig aws_sdkv2.Config
+	clients   map[string]*AWSClient
+	config    Config
+	mu        sync.Mutex
+	primary   *AWSClient
+	session   *session.Session
+}
+
+func newRegionalClients(c *Config, primary *AWSClient, cfg aws_sdkv2.Config, sess *session.Session) *regionalClients {
+	return &regionalClients{
+		awsConfig: cfg,
+		clients:   make(map[string]*AWSClient),
+		config:    *c,
+		primary:   primary,
+		session:   sess,
+	}
+}
+
+// RegionalClient returns an AWSClient whose API clients make calls to the specified Region.
+// The client itself is returned if region is empty or is the client's Region.
+// Clients for other Regions share the provider's credentials, account and configuration
+// and are created on first use.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil {
+		return nil, fmt.Errorf("creating AWS client for Region (%s): provider not configured", region)
+	}
+
+	return client.regionalClients.get(region)
+}
+
+func (r *regionalClients) get(region string) (*AWSClient, error) {
+	if region == r.primary.Region {
+		return r.primary, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, ok := r.clients[region]; ok {
+		return client, nil
+	}
+
+	if !r.config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	// The provider's credentials and account are only valid in its own partition.
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() != r.primary.Partition {
+		return nil, fmt.Errorf("Region (%s) is in partition (%s), not in the provider's partition (%s)", region, p.ID(), r.primary.Partition)
+	}
+
+	c := r.config
+	c.Region = region
+
+	cfg := r.awsConfig.Copy()
+	cfg.Region = region
+
+	sess := r.session.Copy(&aws.Config{Region: aws.String(region)})
+
+	client := &AWSClient{
+		AccountID:          r.primary.AccountID,
+		DefaultTagsConfig:  r.primary.DefaultTagsConfig,
+		DefaultTimeouts:    r.primary.DefaultTimeouts,
+		DNSSuffix:          r.primary.DNSSuffix,
+		IgnoreTagsConfig:   r.primary.IgnoreTagsConfig,
+		Partition:          r.primary.Partition,
+		PreventDestroyTags: r.primary.PreventDestroyTags,
+		Region:             region,
+		RequiredTagsConfig: r.primary.RequiredTagsConfig,
+		ReverseDNSPrefix:   r.primary.ReverseDNSPrefix,
+		ServicePackages:    r.primary.ServicePackages,
+		Session:            sess,
+		TerraformVersion:   r.primary.TerraformVersion,
+
+		httpClient:      r.primary.httpClient,
+		regionalClients: r,
+		sdkv1Hooks:      r.primary.sdkv1Hooks,
+		sdkv2Hooks:      r.primary.sdkv2Hooks,
+	}
+
+	c.configureClients(client, cfg, sess, client.Partition)
+
+	r.clients[region] = client
+
+	return client, nil
+}
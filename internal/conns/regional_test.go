@@ -0,0 +1,115 @@
+package conns
+
+import (
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	if _, err := new(AWSClient).RegionalClient("us-west-2"); err == nil { //lintignore:AWSAT003
+		t.Error("unconfigured client: expected error, got none")
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	c := &Config{Region: "us-west-2"} //lintignore:AWSAT003
+	primary := &AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		Session:   sess,
+	}
+	primary.regionalClients = newRegionalClients(c, primary, aws_sdkv2.Config{Region: "us-west-2"}, sess) //lintignore:AWSAT003
+
+	for _, region := range []string{"", "us-west-2"} { //lintignore:AWSAT003
+		if got, err := primary.RegionalClient(region); err != nil {
+			t.Errorf("RegionalClient(%q): %s", region, err)
+		} else if got != primary {
+			t.Errorf("RegionalClient(%q): expected primary client", region)
+		}
+	}
+
+	if _, err := primary.RegionalClient("not-a-region"); err == nil {
+		t.Error("invalid Region: expected error, got none")
+	}
+
+	regional, err := primary.RegionalClient("eu-west-1") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("RegionalClient: %s", err)
+	}
+
+	if got, want := regional.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+
+	if got, want := regional.AccountID, primary.AccountID; got != want {
+		t.Errorf("AccountID = %s, want %s", got, want)
+	}
+
+	if got, want := aws.StringValue(regional.EC2Conn().Config.Region), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("EC2 API client Region = %s, want %s", got, want)
+	}
+
+	if got, want := regional.Partition, "aws"; got != want {
+		t.Errorf("Partition = %s, want %s", got, want)
+	}
+
+	if got, want := regional.DNSSuffix, "amazonaws.com"; got != want {
+		t.Errorf("DNSSuffix = %s, want %s", got, want)
+	}
+
+	// A Region in another partition is rejected.
+	for _, region := range []string{"cn-north-1", "us-gov-west-1"} { //lintignore:AWSAT003
+		if _, err := primary.RegionalClient(region); err == nil {
+			t.Errorf("RegionalClient(%q): expected error, got none", region)
+		}
+	}
+
+	if again, _ := primary.RegionalClient("eu-west-1"); again != regional { //lintignore:AWSAT003
+		t.Error("RegionalClient: expected cached client")
+	}
+
+	if back, _ := regional.RegionalClient("us-west-2"); back != primary { //lintignore:AWSAT003
+		t.Error("RegionalClient: expected primary client")
+	}
+}
+
+func TestSplitRegionalImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		importID   string
+		wantID     string
+		wantRegion string
+	}{
+		{importID: "i-1234567890", wantID: "i-1234567890"},
+		{importID: "i-1234567890@eu-west-1", wantID: "i-1234567890", wantRegion: "eu-west-1"},                                                                 //lintignore:AWSAT003
+		{importID: "arn:aws:sns:us-east-1:123456789012:topic@us-gov-west-1", wantID: "arn:aws:sns:us-east-1:123456789012:topic", wantRegion: "us-gov-west-1"}, //lintignore:AWSAT003,AWSAT005
+		{importID: "user@example.com", wantID: "user@example.com"},
+		{importID: "user@example.com@us-west-2", wantID: "user@example.com", wantRegion: "us-west-2"}, //lintignore:AWSAT003
+		{importID: "@us-west-2", wantID: "@us-west-2"},                                                //lintignore:AWSAT003
+	}
+
+	for _, testCase := range testCases {
+		gotID, gotRegion := SplitRegionalImportID(testCase.importID)
+
+		if gotID != testCase.wantID || gotRegion != testCase.wantRegion {
+			t.Errorf("SplitRegionalImportID(%q) = %q, %q, want %q, %q", testCase.importID, gotID, gotRegion, testCase.wantID, testCase.wantRegion)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package conns
+
+import (
+	"context"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// CheckRequiredTags returns the violations of the provider's required_tags configuration by a planned resource's tags.
+// The resource's type name is read from Context. Resources without a type name in Context aren't checked.
+func (client *AWSClient) CheckRequiredTags(ctx context.Context, tags tftags.KeyValueTags) (errs []error, warnings []error) {
+	if client.RequiredTagsConfig == nil {
+		return nil, nil
+	}
+
+	typeName, ok := ResourceTypeNameFromContext(ctx)
+
+	if !ok {
+		return nil, nil
+	}
+
+	return client.RequiredTagsConfig.Check(typeName, tags)
+}
@@ -0,0 +1,22 @@
+package conns
+
+import (
+	"time"
+)
+
+// ResourceTimeouts are provider-configured default operation timeouts for a resource type.
+// Zero values leave the resource's own default in place.
+// A timeout set in the resource's `timeouts` configuration block takes precedence.
+type ResourceTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// ResourceTimeouts returns the provider-configured default timeouts for the specified resource type.
+func (client *AWSClient) ResourceTimeouts(typeName string) (ResourceTimeouts, bool) {
+	v, ok := client.DefaultTimeouts[typeName]
+
+	return v, ok
+}
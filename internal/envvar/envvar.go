@@ -72,6 +72,62 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to tune resource sweepers
+const (
+	// The maximum number of resources deleted concurrently by a sweeper.
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// Set to a true value to list the resources sweepers would delete instead of deleting them.
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of "key" or "key=value" tags a resource must have to be swept.
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+
+	// Comma-separated list of "key" or "key=value" tags that prevent a resource from being swept.
+	SweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// The minimum age, as a Go duration, of a resource to be swept.
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Comma-separated list of name prefixes, one of which a resource's name must start with to be swept.
+	SweepNamePrefix = "TF_AWS_SWEEP_NAME_PREFIX"
+
+	// The file dry-run output is appended to. Defaults to standard output.
+	SweepOutputFile = "TF_AWS_SWEEP_OUTPUT_FILE"
+
+	// The format of dry-run output, either "json" (JSON Lines, the default) or "csv".
+	SweepOutputFormat = "TF_AWS_SWEEP_OUTPUT_FORMAT"
+)
+
+// Custom environment variables used to observe the provider's AWS API calls
+const (
+	// The file a JSON summary of AWS API calls is written to when the provider shuts down.
+	// Any "{pid}" in the path is replaced by the provider's process ID.
+	APIMetricsFile = "TF_AWS_API_METRICS_FILE"
+)
+
+// Standard OpenTelemetry environment variables used to export AWS API call traces.
+// Only the OTLP exporter using the "http/json" protocol is supported.
+const (
+	// Set to "otlp" to export a span for each AWS API call.
+	OTelTracesExporter = "OTEL_TRACES_EXPORTER"
+
+	// The base URL of the OTLP endpoint. Defaults to "http://localhost:4318".
+	OTelExporterOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+	// The full URL of the OTLP traces endpoint. Takes precedence over OTEL_EXPORTER_OTLP_ENDPOINT.
+	OTelExporterOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	// Comma-separated list of "key=value" headers sent with each export request.
+	OTelExporterOTLPHeaders = "OTEL_EXPORTER_OTLP_HEADERS"
+
+	// The OTLP protocol. Only "http/json" is supported.
+	OTelExporterOTLPProtocol = "OTEL_EXPORTER_OTLP_PROTOCOL"
+
+	// The service name of exported spans. Defaults to "terraform-provider-aws".
+	OTelServiceName = "OTEL_SERVICE_NAME"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -6,12 +6,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 type ServicePackage interface {
 	Configure(context.Context, any) error
 	FrameworkDataSources(context.Context) []func(context.Context) (datasource.DataSourceWithConfigure, error)
 	FrameworkResources(context.Context) []func(context.Context) (resource.ResourceWithConfigure, error)
+	ResourceImport(string) *ResourceImport
+	ResourceTags(string) *ResourceTags
 	SDKDataSources(context.Context) []struct {
 		TypeName string
 		Factory  func() *schema.Resource
@@ -22,3 +25,35 @@ type ServicePackage interface {
 	}
 	ServicePackageName() string
 }
+
+// ServicePackageWithTags is implemented by service packages that can list and update their resources' tags.
+// Such service packages are generated by internal/generate/tags/main.go with the -ServicePackageTags flag.
+type ServicePackageWithTags interface {
+	ServicePackage
+	ListTags(context.Context, any, string) (tftags.KeyValueTags, error)
+	UpdateTags(context.Context, any, string, any, any) error
+}
+
+// ResourceTags declares that the provider handles a resource's tags.
+// The provider computes "tags_all" from "tags" and the provider's default and ignored tags,
+// and lists and updates the resource's tags using the service package's ListTags and UpdateTags methods.
+type ResourceTags struct {
+	// IdentifierAttribute is the name of the resource attribute passed as the identifier to ListTags and UpdateTags,
+	// typically "arn" or "id".
+	IdentifierAttribute string
+}
+
+// ResourceImport declares how existing resources of a type are discovered for bulk import.
+type ResourceImport struct {
+	// ARNService is the service namespace in the resource's ARNs, e.g. "logs".
+	ARNService string
+	// ARNResourcePrefix is the prefix of the resource part of the resource's ARNs, e.g. "log-group:".
+	// An empty prefix matches ARNs whose resource part is a bare name, e.g. S3 bucket ARNs.
+	ARNResourcePrefix string
+	// CloudControlTypeName is the resource's Cloud Control API type name, e.g. "AWS::Logs::LogGroup".
+	// Cloud Control API resource identifiers are used as import IDs.
+	CloudControlTypeName string
+	// ImportIDIsARN is true if the resource is imported by ARN.
+	// Otherwise the import ID is the resource part of the ARN following ARNResourcePrefix.
+	ImportIDIsARN bool
+}
@@ -0,0 +1,808 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// AutoFlEx ("automatic flattener and expander") converts between Terraform Plugin Framework data models
+// and AWS SDK for Go v2 API structures using reflection.
+//
+// Fields are matched by name, ignoring case and underscores, so that a data model field `KMSKeyID`
+// or an object attribute `kms_key_id` matches an API structure field `KmsKeyId`.
+// Fields without a match are ignored.
+
+// Expand "expands" a Terraform Plugin Framework data model into an AWS SDK for Go v2 API structure.
+// tfObject is a struct (or pointer to struct) of attr.Value fields; apiObject must be a non-nil pointer to a struct.
+// Null and unknown values leave the corresponding API structure field unset.
+func Expand(ctx context.Context, tfObject, apiObject any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vFrom, vTo, d := autoFlexValues(tfObject, apiObject)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(expandStruct(ctx, structFields(vFrom), vTo, "")...)
+
+	return diags
+}
+
+// Flatten "flattens" an AWS SDK for Go v2 API structure into a Terraform Plugin Framework data model.
+// apiObject is a struct (or pointer to struct); tfObject must be a non-nil pointer to a struct of attr.Value fields.
+// Nil pointers, empty slices and empty maps are flattened to null values.
+func Flatten(ctx context.Context, apiObject, tfObject any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vFrom, vTo, d := autoFlexValues(apiObject, tfObject)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	fields := structFields(vFrom)
+	typ := vTo.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		target, ok := vTo.Field(i).Interface().(attr.Value)
+
+		if !ok {
+			continue
+		}
+
+		from, ok := fields[autoFlexName(field.Name)]
+
+		if !ok {
+			continue
+		}
+
+		t := resolveAttrType(ctx, target.Type(ctx), from.Type())
+		v, d := flattenValue(ctx, from, t, field.Name)
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+
+		diags.Append(setField(vTo.Field(i), v, field.Name)...)
+	}
+
+	return diags
+}
+
+// autoFlexValues returns the struct values to convert from and to.
+func autoFlexValues(from, to any) (reflect.Value, reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	vFrom := reflect.ValueOf(from)
+	if vFrom.Kind() == reflect.Pointer {
+		vFrom = vFrom.Elem()
+	}
+
+	if vFrom.Kind() != reflect.Struct {
+		diags.AddError("AutoFlEx", fmt.Sprintf("source (%T) must be a struct or pointer to struct", from))
+	}
+
+	vTo := reflect.ValueOf(to)
+	if vTo.Kind() != reflect.Pointer || vTo.IsNil() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("target (%T) must be a non-nil pointer to struct", to))
+		return vFrom, vTo, diags
+	}
+
+	vTo = vTo.Elem()
+	if vTo.Kind() != reflect.Struct {
+		diags.AddError("AutoFlEx", fmt.Sprintf("target (%T) must be a non-nil pointer to struct", to))
+	}
+
+	return vFrom, vTo, diags
+}
+
+// autoFlexName returns the normalized name used to match fields and attributes.
+func autoFlexName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// structFields returns a struct's exported fields keyed by normalized name.
+func structFields(v reflect.Value) map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Type().Field(i); field.IsExported() {
+			fields[autoFlexName(field.Name)] = v.Field(i)
+		}
+	}
+
+	return fields
+}
+
+// objectFields returns an Object's attributes keyed by normalized name.
+func objectFields(v basetypes.ObjectValue) map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+
+	for k, v := range v.Attributes() {
+		fields[autoFlexName(k)] = reflect.ValueOf(v)
+	}
+
+	return fields
+}
+
+func expandStruct(ctx context.Context, fields map[string]reflect.Value, to reflect.Value, path string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typ := to.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		from, ok := fields[autoFlexName(field.Name)]
+
+		if !ok {
+			continue
+		}
+
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+
+		v, ok := from.Interface().(attr.Value)
+
+		if !ok {
+			if from.Type().AssignableTo(field.Type) {
+				to.Field(i).Set(from)
+			}
+			continue
+		}
+
+		diags.Append(expandValue(ctx, v, to.Field(i), fieldPath)...)
+	}
+
+	return diags
+}
+
+// expandValue expands a Terraform Plugin Framework value into the specified API structure field.
+func expandValue(ctx context.Context, v attr.Value, to reflect.Value, path string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return diags
+	}
+
+	switch v := v.(type) {
+	case fwtypes.Duration:
+		if isDuration(to.Type()) {
+			return expandScalar(v.ValueDuration(), to, path)
+		}
+
+		return expandScalar(v.ValueDuration().String(), to, path)
+
+	case basetypes.StringValuable:
+		s, d := v.ToStringValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return expandScalar(s.ValueString(), to, path)
+
+	case basetypes.BoolValuable:
+		b, d := v.ToBoolValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return expandScalar(b.ValueBool(), to, path)
+
+	case basetypes.Int64Valuable:
+		i, d := v.ToInt64Value(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return expandScalar(i.ValueInt64(), to, path)
+
+	case basetypes.Float64Valuable:
+		f, d := v.ToFloat64Value(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return expandScalar(f.ValueFloat64(), to, path)
+
+	case basetypes.ListValuable:
+		l, d := v.ToListValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return expandElements(ctx, l.Elements(), to, path)
+
+	case basetypes.SetValuable:
+		s, d := v.ToSetValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return expandElements(ctx, s.Elements(), to, path)
+
+	case basetypes.MapValuable:
+		m, d := v.ToMapValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.Kind() != reflect.Map || to.Type().Key().Kind() != reflect.String {
+			return incompatibleTypes(v, to.Type(), path)
+		}
+
+		result := reflect.MakeMapWithSize(to.Type(), len(m.Elements()))
+		for k, e := range m.Elements() {
+			item := reflect.New(to.Type().Elem()).Elem()
+			diags.Append(expandValue(ctx, e, item, path+"."+k)...)
+			result.SetMapIndex(reflect.ValueOf(k).Convert(to.Type().Key()), item)
+		}
+		to.Set(result)
+
+		return diags
+
+	case basetypes.ObjectValuable:
+		o, d := v.ToObjectValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return expandObject(ctx, o, to, path)
+	}
+
+	return incompatibleTypes(v, to.Type(), path)
+}
+
+// expandElements expands List or Set elements into a slice or, for a single nested block, a struct.
+func expandElements(ctx context.Context, elems []attr.Value, to reflect.Value, path string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case to.Kind() == reflect.Slice:
+		result := reflect.MakeSlice(to.Type(), 0, len(elems))
+		for i, e := range elems {
+			item := reflect.New(to.Type().Elem()).Elem()
+			diags.Append(expandValue(ctx, e, item, fmt.Sprintf("%s[%d]", path, i))...)
+			result = reflect.Append(result, item)
+		}
+		to.Set(result)
+
+	case isStruct(to.Type()):
+		if len(elems) > 1 {
+			diags.AddError("AutoFlEx", fmt.Sprintf("expanding %s: %d elements cannot be expanded into %s", path, len(elems), to.Type()))
+			return diags
+		}
+
+		if len(elems) == 1 {
+			diags.Append(expandValue(ctx, elems[0], to, path)...)
+		}
+
+	default:
+		diags.AddError("AutoFlEx", fmt.Sprintf("expanding %s: collection cannot be expanded into %s", path, to.Type()))
+	}
+
+	return diags
+}
+
+// expandObject expands an Object into a struct or pointer to struct.
+func expandObject(ctx context.Context, v basetypes.ObjectValue, to reflect.Value, path string) diag.Diagnostics {
+	if to.Kind() == reflect.Pointer {
+		if !isStruct(to.Type()) {
+			return incompatibleTypes(v, to.Type(), path)
+		}
+
+		ptr := reflect.New(to.Type().Elem())
+		diags := expandStruct(ctx, objectFields(v), ptr.Elem(), path)
+		to.Set(ptr)
+
+		return diags
+	}
+
+	if to.Kind() != reflect.Struct {
+		return incompatibleTypes(v, to.Type(), path)
+	}
+
+	return expandStruct(ctx, objectFields(v), to, path)
+}
+
+// expandScalar sets a string, bool, int64, float64 or time.Duration value into the specified field.
+// Pointer fields are allocated; enum (named string) and narrower numeric types are converted.
+func expandScalar(v any, to reflect.Value, path string) diag.Diagnostics {
+	target := to
+	if to.Kind() == reflect.Pointer {
+		target = reflect.New(to.Type().Elem()).Elem()
+	}
+
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case string:
+		if target.Kind() != reflect.String {
+			return incompatibleTypes(v, to.Type(), path)
+		}
+		target.SetString(v)
+
+	case bool:
+		if target.Kind() != reflect.Bool {
+			return incompatibleTypes(v, to.Type(), path)
+		}
+		target.SetBool(v)
+
+	case time.Duration:
+		if !isDuration(target.Type()) {
+			return incompatibleTypes(v, to.Type(), path)
+		}
+		target.SetInt(int64(v))
+
+	case int64:
+		switch target.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if target.OverflowInt(v) {
+				diags.AddError("AutoFlEx", fmt.Sprintf("expanding %s: %d overflows %s", path, v, target.Type()))
+				return diags
+			}
+			target.SetInt(v)
+		default:
+			return incompatibleTypes(v, to.Type(), path)
+		}
+
+	case float64:
+		switch target.Kind() {
+		case reflect.Float32, reflect.Float64:
+			target.SetFloat(v)
+		default:
+			return incompatibleTypes(v, to.Type(), path)
+		}
+
+	default:
+		return incompatibleTypes(v, to.Type(), path)
+	}
+
+	if to.Kind() == reflect.Pointer {
+		to.Set(target.Addr())
+	}
+
+	return diags
+}
+
+// resolveAttrType fills in the element or attribute types of an empty collection or object type
+// from the API structure field's Go type.
+func resolveAttrType(ctx context.Context, t attr.Type, from reflect.Type) attr.Type {
+	switch t := t.(type) {
+	case basetypes.ListType:
+		if t.ElemType == nil {
+			if v, ok := elemAttrType(from); ok {
+				return types.ListType{ElemType: v}
+			}
+		}
+	case basetypes.SetType:
+		if t.ElemType == nil {
+			if v, ok := elemAttrType(from); ok {
+				return types.SetType{ElemType: v}
+			}
+		}
+	case basetypes.MapType:
+		if t.ElemType == nil {
+			if v, ok := elemAttrType(from); ok {
+				return types.MapType{ElemType: v}
+			}
+		}
+	case basetypes.ObjectType:
+		if len(t.AttrTypes) == 0 {
+			if v, ok := attrType(from); ok {
+				return v
+			}
+		}
+	}
+
+	return t
+}
+
+// elemAttrType returns the Terraform Plugin Framework type of the elements of a slice or map,
+// or of a struct flattened to a single-element list.
+func elemAttrType(t reflect.Type) (attr.Type, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map:
+		return attrType(t.Elem())
+	case reflect.Struct:
+		return attrType(t)
+	}
+
+	return nil, false
+}
+
+// attrType returns the Terraform Plugin Framework type corresponding to a Go type.
+// Struct field names are converted to snake case attribute names.
+func attrType(t reflect.Type) (attr.Type, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if isDuration(t) {
+		return fwtypes.DurationType, true
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return types.StringType, true
+	case reflect.Bool:
+		return types.BoolType, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return types.Int64Type, true
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type, true
+	case reflect.Slice:
+		if v, ok := attrType(t.Elem()); ok {
+			return types.ListType{ElemType: v}, true
+		}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			break
+		}
+		if v, ok := attrType(t.Elem()); ok {
+			return types.MapType{ElemType: v}, true
+		}
+	case reflect.Struct:
+		attrTypes := make(map[string]attr.Type)
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			if v, ok := attrType(field.Type); ok {
+				attrTypes[snakeCase(field.Name)] = v
+			}
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, true
+	}
+
+	return nil, false
+}
+
+// flattenValue flattens an API structure field into a Terraform Plugin Framework value of the specified type.
+func flattenValue(ctx context.Context, from reflect.Value, t attr.Type, path string) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for from.Kind() == reflect.Pointer || from.Kind() == reflect.Interface {
+		if from.IsNil() {
+			return nullValue(t), diags
+		}
+		from = from.Elem()
+	}
+
+	switch {
+	case t.Equal(fwtypes.ARNType):
+		if from.Kind() != reflect.String {
+			break
+		}
+
+		if from.String() == "" {
+			return fwtypes.ARNNull(), diags
+		}
+
+		v, err := arn.Parse(from.String())
+
+		if err != nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("flattening %s: %s", path, err))
+			return nil, diags
+		}
+
+		return fwtypes.ARNValue(v), diags
+
+	case t.Equal(fwtypes.DurationType):
+		if isDuration(from.Type()) {
+			return fwtypes.DurationValue(time.Duration(from.Int())), diags
+		}
+
+		if from.Kind() != reflect.String {
+			break
+		}
+
+		if from.String() == "" {
+			return fwtypes.DurationNull(), diags
+		}
+
+		v, err := time.ParseDuration(from.String())
+
+		if err != nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("flattening %s: %s", path, err))
+			return nil, diags
+		}
+
+		return fwtypes.DurationValue(v), diags
+
+	case t.Equal(fwtypes.PolicyDocumentType):
+		if from.Kind() != reflect.String {
+			break
+		}
+
+		return fwtypes.PolicyDocumentValue(from.String()), diags
+	}
+
+	switch t := t.(type) {
+	case basetypes.StringType:
+		if from.Kind() != reflect.String {
+			break
+		}
+
+		// An unset enum is flattened to null.
+		if from.String() == "" && from.Type() != reflect.TypeOf("") {
+			return types.StringNull(), diags
+		}
+
+		return types.StringValue(from.String()), diags
+
+	case basetypes.BoolType:
+		if from.Kind() == reflect.Bool {
+			return types.BoolValue(from.Bool()), diags
+		}
+
+	case basetypes.Int64Type:
+		switch from.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return types.Int64Value(from.Int()), diags
+		}
+
+	case basetypes.Float64Type:
+		switch from.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return types.Float64Value(from.Float()), diags
+		}
+
+	case basetypes.ListType:
+		elems, d := flattenElements(ctx, from, t.ElemType, path)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if elems == nil {
+			return types.ListNull(t.ElemType), diags
+		}
+
+		v, d := types.ListValue(t.ElemType, elems)
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.SetType:
+		elems, d := flattenElements(ctx, from, t.ElemType, path)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if elems == nil {
+			return types.SetNull(t.ElemType), diags
+		}
+
+		v, d := types.SetValue(t.ElemType, elems)
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.MapType:
+		if from.Kind() != reflect.Map || from.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		if from.Len() == 0 {
+			return types.MapNull(t.ElemType), diags
+		}
+
+		elems := make(map[string]attr.Value, from.Len())
+		iter := from.MapRange()
+		for iter.Next() {
+			k := iter.Key().String()
+			v, d := flattenValue(ctx, iter.Value(), t.ElemType, path+"."+k)
+			diags.Append(d...)
+			elems[k] = v
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		v, d := types.MapValue(t.ElemType, elems)
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.ObjectType:
+		if from.Kind() != reflect.Struct {
+			break
+		}
+
+		fields := structFields(from)
+		attrs := make(map[string]attr.Value, len(t.AttrTypes))
+		for k, attrType := range t.AttrTypes {
+			field, ok := fields[autoFlexName(k)]
+
+			if !ok {
+				attrs[k] = nullValue(attrType)
+				continue
+			}
+
+			v, d := flattenValue(ctx, field, attrType, path+"."+k)
+			diags.Append(d...)
+			attrs[k] = v
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		v, d := types.ObjectValue(t.AttrTypes, attrs)
+		diags.Append(d...)
+
+		return v, diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("flattening %s: %s cannot be flattened to %s", path, from.Type(), t))
+
+	return nil, diags
+}
+
+// flattenElements flattens a slice, or a struct as a single nested block, to List or Set elements.
+// An empty slice is flattened to nil.
+func flattenElements(ctx context.Context, from reflect.Value, elemType attr.Type, path string) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch from.Kind() {
+	case reflect.Slice:
+		if from.Len() == 0 {
+			return nil, diags
+		}
+
+		elems := make([]attr.Value, 0, from.Len())
+		for i := 0; i < from.Len(); i++ {
+			v, d := flattenValue(ctx, from.Index(i), elemType, fmt.Sprintf("%s[%d]", path, i))
+			diags.Append(d...)
+			elems = append(elems, v)
+		}
+
+		return elems, diags
+
+	case reflect.Struct:
+		v, d := flattenValue(ctx, from, elemType, path)
+		diags.Append(d...)
+
+		return []attr.Value{v}, diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("flattening %s: %s cannot be flattened to a collection", path, from.Type()))
+
+	return nil, diags
+}
+
+// nullValue returns the null value of the specified type.
+func nullValue(t attr.Type) attr.Value {
+	switch {
+	case t.Equal(fwtypes.ARNType):
+		return fwtypes.ARNNull()
+	case t.Equal(fwtypes.DurationType):
+		return fwtypes.DurationNull()
+	case t.Equal(fwtypes.PolicyDocumentType):
+		return fwtypes.PolicyDocumentNull()
+	}
+
+	switch t := t.(type) {
+	case basetypes.StringType:
+		return types.StringNull()
+	case basetypes.BoolType:
+		return types.BoolNull()
+	case basetypes.Int64Type:
+		return types.Int64Null()
+	case basetypes.Float64Type:
+		return types.Float64Null()
+	case basetypes.ListType:
+		return types.ListNull(t.ElemType)
+	case basetypes.SetType:
+		return types.SetNull(t.ElemType)
+	case basetypes.MapType:
+		return types.MapNull(t.ElemType)
+	case basetypes.ObjectType:
+		return types.ObjectNull(t.AttrTypes)
+	}
+
+	return nil
+}
+
+func setField(to reflect.Value, v attr.Value, path string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v == nil {
+		return diags
+	}
+
+	from := reflect.ValueOf(v)
+
+	if !from.Type().AssignableTo(to.Type()) {
+		diags.AddError("AutoFlEx", fmt.Sprintf("flattening %s: %s cannot be assigned to %s", path, from.Type(), to.Type()))
+		return diags
+	}
+
+	to.Set(from)
+
+	return diags
+}
+
+func incompatibleTypes(from any, to reflect.Type, path string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("expanding %s: %T cannot be expanded into %s", path, from, to))
+
+	return diags
+}
+
+func isDuration(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t == reflect.TypeOf(time.Duration(0))
+}
+
+func isStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct
+}
+
+// snakeCase converts a Go field name to a Terraform attribute name, e.g. "KMSKeyId" to "kms_key_id".
+func snakeCase(s string) string {
+	runes := []rune(s)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(unicode.ToLower(r))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,296 @@
+package flex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type testEnum string
+
+const (
+	testEnumFoo testEnum = "FOO"
+)
+
+type testAPINested struct {
+	Enabled *bool
+	Name    *string
+}
+
+type testAPIObject struct {
+	Arn        *string
+	Count      int32
+	Enabled    bool
+	Mode       testEnum
+	Name       *string
+	Nested     *testAPINested
+	NestedList []testAPINested
+	Ratio      *float64
+	Tags       map[string]string
+	Timeout    *time.Duration
+	Values     []string
+
+	noSmithyDocumentSerde struct{} //nolint:unused // Mimics AWS SDK for Go v2 API structures.
+}
+
+type testTFObject struct {
+	ARN        fwtypes.ARN      `tfsdk:"arn"`
+	Count      types.Int64      `tfsdk:"count"`
+	Enabled    types.Bool       `tfsdk:"enabled"`
+	Mode       types.String     `tfsdk:"mode"`
+	Name       types.String     `tfsdk:"name"`
+	Nested     types.Object     `tfsdk:"nested"`
+	NestedList types.List       `tfsdk:"nested_list"`
+	Ratio      types.Float64    `tfsdk:"ratio"`
+	Tags       types.Map        `tfsdk:"tags"`
+	Timeout    fwtypes.Duration `tfsdk:"timeout"`
+	Values     types.Set        `tfsdk:"values"`
+}
+
+var testNestedAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+	"name":    types.StringType,
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testARN := "arn:aws:iam::123456789012:role/example" //lintignore:AWSAT005
+
+	testCases := map[string]struct {
+		source     testTFObject
+		expected   testAPIObject
+		expectErrs bool
+	}{
+		"null values": {
+			source: testTFObject{
+				ARN:        fwtypes.ARNNull(),
+				Count:      types.Int64Null(),
+				Enabled:    types.BoolNull(),
+				Mode:       types.StringNull(),
+				Name:       types.StringNull(),
+				Nested:     types.ObjectNull(testNestedAttrTypes),
+				NestedList: types.ListNull(types.ObjectType{AttrTypes: testNestedAttrTypes}),
+				Ratio:      types.Float64Unknown(),
+				Tags:       types.MapNull(types.StringType),
+				Timeout:    fwtypes.DurationNull(),
+				Values:     types.SetUnknown(types.StringType),
+			},
+		},
+		"values": {
+			source: testTFObject{
+				ARN:     fwtypes.ARNValue(arn.ARN{Partition: "aws", Service: "iam", AccountID: "123456789012", Resource: "role/example"}),
+				Count:   types.Int64Value(3),
+				Enabled: types.BoolValue(true),
+				Mode:    types.StringValue("FOO"),
+				Name:    types.StringValue("example"),
+				Nested: types.ObjectValueMust(testNestedAttrTypes, map[string]attr.Value{
+					"enabled": types.BoolValue(true),
+					"name":    types.StringNull(),
+				}),
+				NestedList: types.ListValueMust(types.ObjectType{AttrTypes: testNestedAttrTypes}, []attr.Value{
+					types.ObjectValueMust(testNestedAttrTypes, map[string]attr.Value{
+						"enabled": types.BoolValue(false),
+						"name":    types.StringValue("first"),
+					}),
+				}),
+				Ratio:   types.Float64Value(0.5),
+				Tags:    types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+				Timeout: fwtypes.DurationValue(5 * time.Minute),
+				Values:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			},
+			expected: testAPIObject{
+				Arn:     aws.String(testARN),
+				Count:   3,
+				Enabled: true,
+				Mode:    testEnumFoo,
+				Name:    aws.String("example"),
+				Nested: &testAPINested{
+					Enabled: aws.Bool(true),
+				},
+				NestedList: []testAPINested{
+					{Enabled: aws.Bool(false), Name: aws.String("first")},
+				},
+				Ratio:   aws.Float64(0.5),
+				Tags:    map[string]string{"key": "value"},
+				Timeout: aws.Duration(5 * time.Minute),
+				Values:  []string{"a"},
+			},
+		},
+		"overflow": {
+			source: testTFObject{
+				Count: types.Int64Value(1 << 40),
+			},
+			expectErrs: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testAPIObject
+			diags := Expand(ctx, testCase.source, &got)
+
+			if got, want := diags.HasError(), testCase.expectErrs; got != want {
+				t.Fatalf("got errors %t, want %t: %v", got, want, diags)
+			}
+
+			if testCase.expectErrs {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(testAPIObject{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandIncompatibleTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var got struct {
+		Name []bool
+	}
+	diags := Expand(ctx, struct{ Name types.String }{Name: types.StringValue("example")}, &got)
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	diags = Expand(ctx, struct{}{}, got)
+
+	if !diags.HasError() {
+		t.Fatal("expected error for non-pointer target, got none")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testARN := "arn:aws:iam::123456789012:role/example" //lintignore:AWSAT005
+
+	testCases := map[string]struct {
+		source     testAPIObject
+		expected   testTFObject
+		expectErrs bool
+	}{
+		"zero values": {
+			source: testAPIObject{},
+			expected: testTFObject{
+				ARN:        fwtypes.ARNNull(),
+				Count:      types.Int64Value(0),
+				Enabled:    types.BoolValue(false),
+				Mode:       types.StringNull(),
+				Name:       types.StringNull(),
+				Nested:     types.ObjectNull(testNestedAttrTypes),
+				NestedList: types.ListNull(types.ObjectType{AttrTypes: testNestedAttrTypes}),
+				Ratio:      types.Float64Null(),
+				Tags:       types.MapNull(types.StringType),
+				Timeout:    fwtypes.DurationNull(),
+				Values:     types.SetNull(types.StringType),
+			},
+		},
+		"values": {
+			source: testAPIObject{
+				Arn:     aws.String(testARN),
+				Count:   3,
+				Enabled: true,
+				Mode:    testEnumFoo,
+				Name:    aws.String("example"),
+				Nested: &testAPINested{
+					Enabled: aws.Bool(true),
+				},
+				NestedList: []testAPINested{
+					{Enabled: aws.Bool(false), Name: aws.String("first")},
+				},
+				Ratio:   aws.Float64(0.5),
+				Tags:    map[string]string{"key": "value"},
+				Timeout: aws.Duration(5 * time.Minute),
+				Values:  []string{"a"},
+			},
+			expected: testTFObject{
+				ARN:     fwtypes.ARNValue(arn.ARN{Partition: "aws", Service: "iam", AccountID: "123456789012", Resource: "role/example"}),
+				Count:   types.Int64Value(3),
+				Enabled: types.BoolValue(true),
+				Mode:    types.StringValue("FOO"),
+				Name:    types.StringValue("example"),
+				Nested: types.ObjectValueMust(testNestedAttrTypes, map[string]attr.Value{
+					"enabled": types.BoolValue(true),
+					"name":    types.StringNull(),
+				}),
+				NestedList: types.ListValueMust(types.ObjectType{AttrTypes: testNestedAttrTypes}, []attr.Value{
+					types.ObjectValueMust(testNestedAttrTypes, map[string]attr.Value{
+						"enabled": types.BoolValue(false),
+						"name":    types.StringValue("first"),
+					}),
+				}),
+				Ratio:   types.Float64Value(0.5),
+				Tags:    types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")}),
+				Timeout: fwtypes.DurationValue(5 * time.Minute),
+				Values:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			},
+		},
+		"invalid ARN": {
+			source: testAPIObject{
+				Arn: aws.String("invalid"),
+			},
+			expectErrs: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testTFObject
+			diags := Flatten(ctx, testCase.source, &got)
+
+			if got, want := diags.HasError(), testCase.expectErrs; got != want {
+				t.Fatalf("got errors %t, want %t: %v", got, want, diags)
+			}
+
+			if testCase.expectErrs {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":              "name",
+		"KMSKeyId":          "kms_key_id",
+		"RetentionInDays":   "retention_in_days",
+		"Ipv6CidrBlock":     "ipv6_cidr_block",
+		"EnableDNSHostname": "enable_dns_hostname",
+	}
+
+	for input, want := range testCases {
+		if got := snakeCase(input); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
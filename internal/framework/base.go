@@ -93,6 +93,16 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 				"please de-duplicate and try again")
 		}
 
+		errs, warnings := r.Meta().CheckRequiredTags(ctx, defaultTagsConfig.MergeTags(resourceTags))
+
+		for _, v := range errs {
+			response.Diagnostics.AddAttributeError(path.Root("tags"), "Required tags", v.Error())
+		}
+
+		for _, v := range warnings {
+			response.Diagnostics.AddAttributeWarning(path.Root("tags"), "Required tags", v.Error())
+		}
+
 		allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
@@ -0,0 +1,36 @@
+package stringplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type policyDocumentSemanticEquality struct{}
+
+// PolicyDocumentSemanticEquality return a string plan modifier that keeps the prior state value
+// if the planned IAM policy document is semantically equal to it.
+// The attribute must be Computed so that the planned value may differ from the configured value.
+func PolicyDocumentSemanticEquality() planmodifier.String {
+	return policyDocumentSemanticEquality{}
+}
+
+func (m policyDocumentSemanticEquality) Description(context.Context) string {
+	return "If the planned policy document is equivalent to the prior state value, the prior state value is kept"
+}
+
+func (m policyDocumentSemanticEquality) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m policyDocumentSemanticEquality) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compare on create or if either value is not known.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if fwtypes.PolicyDocumentValue(req.StateValue.ValueString()).SemanticallyEqual(fwtypes.PolicyDocumentValue(req.PlanValue.ValueString())) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,78 @@
+package stringplanmodifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPolicyDocumentSemanticEquality(t *testing.T) {
+	t.Parallel()
+
+	const (
+		policy           = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`
+		policyEquivalent = `{
+  "Version": "2012-10-17",
+  "Statement": {"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["*"]}
+}`
+		policyDifferent = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`
+	)
+
+	type testCase struct {
+		plannedValue  types.String
+		currentValue  types.String
+		expectedValue types.String
+	}
+	tests := map[string]testCase{
+		"equivalent": {
+			plannedValue:  types.StringValue(policyEquivalent),
+			currentValue:  types.StringValue(policy),
+			expectedValue: types.StringValue(policy),
+		},
+		"different": {
+			plannedValue:  types.StringValue(policyDifferent),
+			currentValue:  types.StringValue(policy),
+			expectedValue: types.StringValue(policyDifferent),
+		},
+		"create": {
+			plannedValue:  types.StringValue(policy),
+			currentValue:  types.StringNull(),
+			expectedValue: types.StringValue(policy),
+		},
+		"planned unknown": {
+			plannedValue:  types.StringUnknown(),
+			currentValue:  types.StringValue(policy),
+			expectedValue: types.StringUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := planmodifier.StringRequest{
+				Path:       path.Root("test"),
+				PlanValue:  test.plannedValue,
+				StateValue: test.currentValue,
+			}
+			response := planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+			PolicyDocumentSemanticEquality().PlanModifyString(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if diff := cmp.Diff(response.PlanValue, test.expectedValue); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestPolicyDocumentTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.PolicyDocumentNull(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.PolicyDocumentUnknown(),
+		},
+		"policy": {
+			val:      tftypes.NewValue(tftypes.String, `{"Version":"2012-10-17"}`),
+			expected: fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17"}`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.PolicyDocumentType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyDocumentTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"empty string": {
+			val: tftypes.NewValue(tftypes.String, ""),
+		},
+		"valid policy": {
+			val: tftypes.NewValue(tftypes.String, `{"Version":"2012-10-17","Statement":[]}`),
+		},
+		"invalid JSON": {
+			val:         tftypes.NewValue(tftypes.String, `{"Version":`),
+			expectError: true,
+		},
+		"not an object": {
+			val:         tftypes.NewValue(tftypes.String, `["Version"]`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := fwtypes.PolicyDocumentType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestPolicyDocumentSemanticallyEqual(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		p1, p2   fwtypes.PolicyDocument
+		expected bool
+	}{
+		"whitespace": {
+			p1:       fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			p2:       fwtypes.PolicyDocumentValue("{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": [{\"Effect\": \"Allow\", \"Action\": \"s3:GetObject\", \"Resource\": \"*\"}]\n}"),
+			expected: true,
+		},
+		"single string and one-element array": {
+			p1:       fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			p2:       fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}}`),
+			expected: true,
+		},
+		"statement order": {
+			p1:       fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`),
+			p2:       fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			expected: true,
+		},
+		"principal order": {
+			p1:       fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111111111111:root","arn:aws:iam::222222222222:root"]},"Action":"sts:AssumeRole"}]}`), //lintignore:AWSAT005
+			p2:       fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::222222222222:root","arn:aws:iam::111111111111:root"]},"Action":"sts:AssumeRole"}]}`), //lintignore:AWSAT005
+			expected: true,
+		},
+		"empty": {
+			p1:       fwtypes.PolicyDocumentValue(""),
+			p2:       fwtypes.PolicyDocumentValue("{}"),
+			expected: true,
+		},
+		"different": {
+			p1: fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			p2: fwtypes.PolicyDocumentValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`),
+		},
+		"null": {
+			p1: fwtypes.PolicyDocumentNull(),
+			p2: fwtypes.PolicyDocumentNull(),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.p1.SemanticallyEqual(test.p2); got != test.expected {
+				t.Errorf("got %t, want %t", got, test.expected)
+			}
+		})
+	}
+}
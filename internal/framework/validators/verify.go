@@ -0,0 +1,347 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// StringOrList is a validator that can be used with a string attribute,
+// or with a list attribute to validate each of its string elements.
+type StringOrList interface {
+	validator.String
+	validator.List
+}
+
+// schemaValidateFuncValidator validates a string value using a Plugin SDK SchemaValidateFunc.
+// Running the same function that validates the attribute in Plugin SDK resources ensures that migrated resources
+// accept and reject exactly the same values, with the same error messages.
+type schemaValidateFuncValidator struct {
+	description string
+	f           schema.SchemaValidateFunc
+}
+
+// Description describes the validation in plain text formatting.
+func (validator schemaValidateFuncValidator) Description(_ context.Context) string {
+	return validator.description
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator schemaValidateFuncValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator schemaValidateFuncValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(validator.validate(ctx, request.Path, request.ConfigValue.ValueString())...)
+}
+
+// ValidateList performs the validation on each known string element.
+func (validator schemaValidateFuncValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, elem := range request.ConfigValue.Elements() {
+		elemPath := request.Path.AtListIndex(i)
+		v, ok := elem.(basetypes.StringValuable)
+
+		if !ok {
+			response.Diagnostics.AddAttributeError(
+				elemPath,
+				"Invalid Validator for Element Type",
+				fmt.Sprintf("%s: expected a string element, got %T", validator.Description(ctx), elem),
+			)
+			continue
+		}
+
+		s, diags := v.ToStringValue(ctx)
+		response.Diagnostics.Append(diags...)
+
+		if diags.HasError() || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+
+		response.Diagnostics.Append(validator.validate(ctx, elemPath, s.ValueString())...)
+	}
+}
+
+func (validator schemaValidateFuncValidator) validate(ctx context.Context, path path.Path, value string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ws, es := validator.f(value, path.String())
+
+	for _, w := range ws {
+		diags.Append(diag.NewAttributeWarningDiagnostic(path, validator.Description(ctx), w))
+	}
+
+	for _, err := range es {
+		diags.Append(diag.NewAttributeErrorDiagnostic(path, validator.Description(ctx), err.Error()))
+	}
+
+	return diags
+}
+
+// ARN returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid ARN (or is empty).
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidARN.
+func ARN() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid ARN",
+		f:           verify.ValidARN,
+	}
+}
+
+// AccountID returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid AWS account ID (exactly 12 digits).
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidAccountID.
+func AccountID() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid AWS account ID",
+		f:           verify.ValidAccountID,
+	}
+}
+
+// ASN4Byte returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a 4-byte Autonomous System Number (0 to 4294967295).
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.Valid4ByteASN.
+func ASN4Byte() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid 4-byte ASN",
+		f:           verify.Valid4ByteASN,
+	}
+}
+
+// Base64 returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which is non-empty and base64 encoded.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to validation.StringIsBase64.
+func Base64() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be base64 encoded",
+		f:           validation.StringIsBase64,
+	}
+}
+
+// CIDRNetworkAddress returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid IPv4 or IPv6 CIDR network address.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidCIDRNetworkAddress.
+func CIDRNetworkAddress() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid CIDR that represents a network address",
+		f:           verify.ValidCIDRNetworkAddress,
+	}
+}
+
+// DateOrPositiveInt returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents an RFC3339 timestamp or a positive integer.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidStringDateOrPositiveInt.
+func DateOrPositiveInt() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be an RFC3339 timestamp or a positive integer",
+		f:           verify.ValidStringDateOrPositiveInt,
+	}
+}
+
+// Duration returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a non-negative duration, e.g. "1h30m".
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidDuration.
+func Duration() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid duration",
+		f:           verify.ValidDuration,
+	}
+}
+
+// IAMPolicyJSON returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a JSON IAM policy document.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidIAMPolicyJSON.
+func IAMPolicyJSON() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid JSON IAM policy document",
+		f:           verify.ValidIAMPolicyJSON,
+	}
+}
+
+// JSONOrYAML returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid JSON or YAML document.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidStringIsJSONOrYAML.
+func JSONOrYAML() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid JSON or YAML document",
+		f:           verify.ValidStringIsJSONOrYAML,
+	}
+}
+
+// LaunchTemplateID returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid EC2 launch template ID.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidLaunchTemplateID.
+func LaunchTemplateID() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid launch template ID",
+		f:           verify.ValidLaunchTemplateID,
+	}
+}
+
+// LaunchTemplateName returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid EC2 launch template name.
+//
+// Attributes whose name ends in "prefix" are limited to 99 characters.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidLaunchTemplateName.
+func LaunchTemplateName() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid launch template name",
+		f:           verify.ValidLaunchTemplateName,
+	}
+}
+
+// MulticastIPAddress returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a multicast IP address.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidMulticastIPAddress.
+func MulticastIPAddress() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid multicast IP address",
+		f:           verify.ValidMulticastIPAddress,
+	}
+}
+
+// NullableBoolean returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which is empty or represents a boolean ("0", "1", "false" or "true").
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidTypeStringNullableBoolean.
+func NullableBoolean() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be empty or a boolean",
+		f:           verify.ValidTypeStringNullableBoolean,
+	}
+}
+
+// NullableFloat returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which is empty or represents a floating point number.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidTypeStringNullableFloat.
+func NullableFloat() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be empty or a floating point number",
+		f:           verify.ValidTypeStringNullableFloat,
+	}
+}
+
+// OnceADayWindowFormat returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which is empty or in the format "hh24:mi-hh24:mi".
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidOnceADayWindowFormat.
+func OnceADayWindowFormat() StringOrList {
+	return schemaValidateFuncValidator{
+		description: `value must satisfy the format of "hh24:mi-hh24:mi"`,
+		f:           verify.ValidOnceADayWindowFormat,
+	}
+}
+
+// OnceAWeekWindowFormat returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which is empty or in the format "ddd:hh24:mi-ddd:hh24:mi".
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidOnceAWeekWindowFormat.
+func OnceAWeekWindowFormat() StringOrList {
+	return schemaValidateFuncValidator{
+		description: `value must satisfy the format of "ddd:hh24:mi-ddd:hh24:mi"`,
+		f:           verify.ValidOnceAWeekWindowFormat,
+	}
+}
+
+// RegionName returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a well-formed AWS Region name (or is empty).
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidRegionName.
+func RegionName() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid AWS Region name",
+		f:           verify.ValidRegionName,
+	}
+}
+
+// UTCTimestamp returns a validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a timestamp in RFC3339 format.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Equivalent to verify.ValidUTCTimestamp.
+func UTCTimestamp() StringOrList {
+	return schemaValidateFuncValidator{
+		description: "value must be a valid RFC3339 timestamp",
+		f:           verify.ValidUTCTimestamp,
+	}
+}
@@ -0,0 +1,258 @@
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// TestVerifyParity checks that each validator accepts and rejects the same values,
+// with the same error messages, as its Plugin SDK equivalent.
+func TestVerifyParity(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator fwvalidators.StringOrList
+		f         schema.SchemaValidateFunc
+		values    []string
+	}
+	tests := map[string]testCase{
+		"ARN": {
+			validator: fwvalidators.ARN(),
+			f:         verify.ValidARN,
+			values:    []string{"", "arn:aws:iam::123456789012:role/example", "arn:aws:s3:::bucket", "arn:aws", "arn:AWS:iam::123456789012:role/example", "arn:aws:iam::12345:role/example"}, //lintignore:AWSAT005
+		},
+		"AccountID": {
+			validator: fwvalidators.AccountID(),
+			f:         verify.ValidAccountID,
+			values:    []string{"123456789012", "12345678901", "aws", ""},
+		},
+		"ASN4Byte": {
+			validator: fwvalidators.ASN4Byte(),
+			f:         verify.Valid4ByteASN,
+			values:    []string{"65000", "4294967295", "4294967296", "-1", "asn"},
+		},
+		"Base64": {
+			validator: fwvalidators.Base64(),
+			f:         validation.StringIsBase64,
+			values:    []string{"aGVsbG8=", "hello!", ""},
+		},
+		"CIDRNetworkAddress": {
+			validator: fwvalidators.CIDRNetworkAddress(),
+			f:         verify.ValidCIDRNetworkAddress,
+			values:    []string{"10.0.0.0/16", "10.0.0.1/16", "2001:db8::/32", "invalid"},
+		},
+		"DateOrPositiveInt": {
+			validator: fwvalidators.DateOrPositiveInt(),
+			f:         verify.ValidStringDateOrPositiveInt,
+			values:    []string{"2023-01-02T15:04:05Z", "30", "-1", "tomorrow"},
+		},
+		"Duration": {
+			validator: fwvalidators.Duration(),
+			f:         verify.ValidDuration,
+			values:    []string{"1h30m", "-5m", "forever"},
+		},
+		"IAMPolicyJSON": {
+			validator: fwvalidators.IAMPolicyJSON(),
+			f:         verify.ValidIAMPolicyJSON,
+			values:    []string{`{"Version":"2012-10-17","Statement":[]}`, `{"Version":`, `[]`, ""},
+		},
+		"JSONOrYAML": {
+			validator: fwvalidators.JSONOrYAML(),
+			f:         verify.ValidStringIsJSONOrYAML,
+			values:    []string{`{"key":"value"}`, "key: value", `{"key":`, "key: [value"},
+		},
+		"LaunchTemplateID": {
+			validator: fwvalidators.LaunchTemplateID(),
+			f:         verify.ValidLaunchTemplateID,
+			values:    []string{"lt-0123456789abcdef0", "template", ""},
+		},
+		"LaunchTemplateName": {
+			validator: fwvalidators.LaunchTemplateName(),
+			f:         verify.ValidLaunchTemplateName,
+			values:    []string{"example", "ex", "ex@mple"},
+		},
+		"MulticastIPAddress": {
+			validator: fwvalidators.MulticastIPAddress(),
+			f:         verify.ValidMulticastIPAddress,
+			values:    []string{"224.0.0.1", "10.0.0.1", "invalid"},
+		},
+		"NullableBoolean": {
+			validator: fwvalidators.NullableBoolean(),
+			f:         verify.ValidTypeStringNullableBoolean,
+			values:    []string{"", "true", "0", "yes"},
+		},
+		"NullableFloat": {
+			validator: fwvalidators.NullableFloat(),
+			f:         verify.ValidTypeStringNullableFloat,
+			values:    []string{"", "1.5", "one"},
+		},
+		"OnceADayWindowFormat": {
+			validator: fwvalidators.OnceADayWindowFormat(),
+			f:         verify.ValidOnceADayWindowFormat,
+			values:    []string{"", "04:00-05:00", "24:00-25:00"},
+		},
+		"OnceAWeekWindowFormat": {
+			validator: fwvalidators.OnceAWeekWindowFormat(),
+			f:         verify.ValidOnceAWeekWindowFormat,
+			values:    []string{"", "Sun:04:00-Sun:05:00", "sun:04:00", "funday:04:00-sun:05:00"},
+		},
+		"RegionName": {
+			validator: fwvalidators.RegionName(),
+			f:         verify.ValidRegionName,
+			values:    []string{"", "us-west-2", "us-gov-west-1", "US-WEST-2"},
+		},
+		"UTCTimestamp": {
+			validator: fwvalidators.UTCTimestamp(),
+			f:         verify.ValidUTCTimestamp,
+			values:    []string{"2023-01-02T15:04:05Z", "2023-01-02"},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			for _, value := range test.values {
+				_, es := test.f(value, "test")
+
+				request := validator.StringRequest{
+					Path:           path.Root("test"),
+					PathExpression: path.MatchRoot("test"),
+					ConfigValue:    types.StringValue(value),
+				}
+				response := validator.StringResponse{}
+				test.validator.ValidateString(ctx, request, &response)
+
+				errs := response.Diagnostics.Errors()
+
+				if got, want := len(errs), len(es); got != want {
+					t.Fatalf("%q: got %d errors, want %d: %v", value, got, want, response.Diagnostics)
+				}
+
+				for i, err := range es {
+					if got, want := errs[i].Detail(), err.Error(); got != want {
+						t.Errorf("%q: got error %q, want %q", value, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestARNValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"invalid String": {
+			val:         types.StringValue("test-value"),
+			expectError: true,
+		},
+		"valid ARN": {
+			val: types.StringValue("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.ARN().ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestARNListValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.List
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown List": {
+			val: types.ListUnknown(types.StringType),
+		},
+		"null List": {
+			val: types.ListNull(types.StringType),
+		},
+		"valid elements": {
+			val: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+				types.StringUnknown(),
+			}),
+		},
+		"invalid element": {
+			val: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+				types.StringValue("test-value"),
+			}),
+			expectError: true,
+		},
+		"non-string element": {
+			val: types.ListValueMust(types.BoolType, []attr.Value{
+				types.BoolValue(true),
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.ListResponse{}
+			fwvalidators.ARN().ValidateList(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ var (
 	getTag             = flag.Bool("GetTag", false, "whether to generate GetTag")
 	listTags           = flag.Bool("ListTags", false, "whether to generate ListTags")
 	serviceTagsMap     = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
+	servicePackageTags = flag.Bool("ServicePackageTags", false, "whether to generate service package ListTags and UpdateTags methods")
 	serviceTagsSlice   = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
 	untagInNeedTagType = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
 	updateTags         = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
@@ -73,12 +74,13 @@ func usage() {
 }
 
 type TemplateBody struct {
-	getTag           string
-	header           string
-	listTags         string
-	serviceTagsMap   string
-	serviceTagsSlice string
-	updateTags       string
+	getTag             string
+	header             string
+	listTags           string
+	serviceTagsMap     string
+	serviceTagsSlice   string
+	servicePackageTags string
+	updateTags         string
 }
 
 func newTemplateBody(version int, kvtValues bool) *TemplateBody {
@@ -90,6 +92,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 			"\n" + v1.ListTagsBody,
 			"\n" + v1.ServiceTagsMapBody,
 			"\n" + v1.ServiceTagsSliceBody,
+			"\n" + v1.ServicePackageTagsBody,
 			"\n" + v1.UpdateTagsBody,
 		}
 	case sdkV2:
@@ -100,6 +103,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 				"\n" + v2.ListTagsBody,
 				"\n" + v2.ServiceTagsValueMapBody,
 				"\n" + v2.ServiceTagsSliceBody,
+				"\n" + v2.ServicePackageTagsBody,
 				"\n" + v2.UpdateTagsBody,
 			}
 		}
@@ -109,6 +113,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 			"\n" + v2.ListTagsBody,
 			"\n" + v2.ServiceTagsMapBody,
 			"\n" + v2.ServiceTagsSliceBody,
+			"\n" + v2.ServicePackageTagsBody,
 			"\n" + v2.UpdateTagsBody,
 		}
 	default:
@@ -119,6 +124,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 type TemplateData struct {
 	AWSService             string
 	AWSServiceIfacePackage string
+	ClientMethod           string
 	ClientType             string
 	ServicePackage         string
 
@@ -158,6 +164,7 @@ type TemplateData struct {
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
+	ConnsPkg        bool
 	ContextPkg      bool
 	FmtPkg          bool
 	HelperSchemaPkg bool
@@ -206,6 +213,29 @@ func main() {
 		clientType = fmt.Sprintf("*%s.%s", awsPkg, clientTypeName)
 	}
 
+	var clientMethod string
+	if *servicePackageTags {
+		if !*listTags || !*updateTags {
+			g.Fatalf("ServicePackageTags requires ListTags and UpdateTags")
+		}
+
+		if *tagResTypeElem != "" || *tagTypeAddBoolElem != "" {
+			g.Fatalf("ServicePackageTags is not supported with TagResTypeElem or TagTypeAddBoolElem")
+		}
+
+		providerNameUpper, err := names.ProviderNameUpper(servicePackage)
+
+		if err != nil {
+			g.Fatalf("encountered: %s", err)
+		}
+
+		if *sdkVersion == sdkV1 {
+			clientMethod = providerNameUpper + "Conn"
+		} else {
+			clientMethod = providerNameUpper + "Client"
+		}
+	}
+
 	tagPackage := awsPkg
 
 	if tagPackage == "wafregional" {
@@ -218,9 +248,11 @@ func main() {
 	templateData := TemplateData{
 		AWSService:             awsPkg,
 		AWSServiceIfacePackage: awsIntfPkg,
+		ClientMethod:           clientMethod,
 		ClientType:             clientType,
 		ServicePackage:         servicePackage,
 
+		ConnsPkg:        *servicePackageTags,
 		ContextPkg:      *sdkVersion == sdkV2 || (*getTag || *listTags || *updateTags),
 		FmtPkg:          *updateTags,
 		HelperSchemaPkg: awsPkg == "autoscaling",
@@ -302,6 +334,12 @@ func main() {
 		}
 	}
 
+	if *servicePackageTags {
+		if err := d.WriteTemplate("servicepackagetags", templateBody.servicePackageTags, templateData); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
 	if *updateTags {
 		if err := d.WriteTemplate("updatetags", templateBody.updateTags, templateData); err != nil {
 			g.Fatalf("generating file (%s): %s", filename, err)
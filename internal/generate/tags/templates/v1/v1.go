@@ -19,5 +19,8 @@ var ServiceTagsMapBody string
 //go:embed service_tags_slice_body.tmpl
 var ServiceTagsSliceBody string
 
+//go:embed service_package_tags_body.tmpl
+var ServicePackageTagsBody string
+
 //go:embed update_tags_body.tmpl
 var UpdateTagsBody string
@@ -25,5 +25,8 @@ var ServiceTagsValueMapBody string
 //go:embed service_tags_slice_body.tmpl
 var ServiceTagsSliceBody string
 
+//go:embed service_package_tags_body.tmpl
+var ServicePackageTagsBody string
+
 //go:embed update_tags_body.tmpl
 var UpdateTagsBody string
@@ -5,7 +5,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 )
@@ -21,7 +23,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return planWarningsProviderServer{defaultTimeoutsProviderServer{primary.GRPCProvider(), primary}}
+		},
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -33,3 +37,24 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 
 	return muxServer.ProviderServer, primary, nil
 }
+
+// ProtoV6ProviderServerFactory returns a muxed terraform-plugin-go protocol v6 provider factory function.
+// The protocol v5 muxed provider server is upgraded to protocol v6.
+// The primary (Plugin SDK) provider server is also returned (useful for testing).
+func ProtoV6ProviderServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, *schema.Provider, error) {
+	providerServerFactory, primary, err := ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upgradedServer, err := tf5to6server.UpgradeServer(ctx, providerServerFactory)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return func() tfprotov6.ProviderServer {
+		return upgradedServer
+	}, primary, nil
+}
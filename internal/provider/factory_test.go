@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -19,3 +20,33 @@ func BenchmarkProtoV5ProviderServerFactory(b *testing.B) {
 		b.Logf("%d resources, %d data sources", len(p.ResourcesMap), len(p.DataSourcesMap))
 	}
 }
+
+func TestProtoV6ProviderServerFactory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	providerServerFactory, _, err := provider.ProtoV6ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := providerServerFactory().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, diagnostic := range response.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("unexpected error: %s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+
+	// Resources implemented using both the Plugin SDK and the Plugin Framework are served.
+	for _, typeName := range []string{"aws_vpc", "aws_auditmanager_account_registration"} {
+		if _, ok := response.ResourceSchemas[typeName]; !ok {
+			t.Errorf("resource %s not found", typeName)
+		}
+	}
+}
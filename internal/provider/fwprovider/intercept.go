@@ -0,0 +1,277 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// when represents the point in the request lifecycle that an interceptor is run.
+type when uint16
+
+const (
+	Before when = 1 << iota // Interceptor is run before the call to the resource's method.
+	After                   // Interceptor is run after a successful call to the resource's method.
+)
+
+// resourceInterceptor is run around a resource's CRUD and plan modification methods.
+// An interceptor can enhance the Context passed to the method and add diagnostics to the response.
+// An error diagnostic added before the method is called prevents the call.
+type resourceInterceptor interface {
+	create(context.Context, resource.CreateRequest, *resource.CreateResponse, *conns.AWSClient, when) context.Context
+	read(context.Context, resource.ReadRequest, *resource.ReadResponse, *conns.AWSClient, when) context.Context
+	update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, *conns.AWSClient, when) context.Context
+	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when) context.Context
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, when) context.Context
+}
+
+type resourceInterceptors []resourceInterceptor
+
+// tagsInterceptor implements transparent tagging for resources that declare their tags.
+type tagsInterceptor struct {
+	servicePackage intf.ServicePackageWithTags
+	tags           *intf.ResourceTags
+	typeName       string
+}
+
+func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when) context.Context {
+	if meta == nil {
+		return ctx
+	}
+
+	switch when {
+	case Before:
+		var planTags types.Map
+
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+
+		if response.Diagnostics.HasError() {
+			return ctx
+		}
+
+		ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+		inContext, _ := tftags.FromContext(ctx)
+		inContext.TagsIn = meta.DefaultTagsConfig.MergeTags(tftags.New(planTags))
+	case After:
+		inContext, ok := tftags.FromContext(ctx)
+
+		if !ok {
+			return ctx
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags_all"), flex.FlattenFrameworkStringValueMapLegacy(ctx, inContext.TagsIn.IgnoreConfig(inContext.IgnoreConfig).Map()))...)
+	}
+
+	return ctx
+}
+
+func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when) context.Context {
+	if meta == nil {
+		return ctx
+	}
+
+	switch when {
+	case Before:
+		ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+	case After:
+		// The resource has been deleted.
+		if response.State.Raw.IsNull() {
+			return ctx
+		}
+
+		inContext, ok := tftags.FromContext(ctx)
+
+		if !ok {
+			return ctx
+		}
+
+		tags, ok := inContext.GetTagsOut()
+
+		if !ok {
+			var identifier types.String
+
+			response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(r.tags.IdentifierAttribute), &identifier)...)
+
+			if response.Diagnostics.HasError() {
+				return ctx
+			}
+
+			var err error
+
+			tags, err = r.servicePackage.ListTags(ctx, meta, identifier.ValueString())
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("listing tags for %s (%s)", r.typeName, identifier.ValueString()), err.Error())
+
+				return ctx
+			}
+		}
+
+		tags = tags.IgnoreAWS().IgnoreConfig(inContext.IgnoreConfig)
+
+		// AWS APIs often return empty lists of tags when none have been configured.
+		stateTags := tftags.Null
+		if v := tags.RemoveDefaultConfig(inContext.DefaultConfig).Map(); len(v) > 0 {
+			stateTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, v)
+		}
+
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags"), stateTags)...)
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tags_all"), flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.Map()))...)
+	}
+
+	return ctx
+}
+
+func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when) context.Context {
+	if meta == nil {
+		return ctx
+	}
+
+	switch when {
+	case Before:
+		var planTags, planTagsAll, stateTagsAll types.Map
+
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tags_all"), &planTagsAll)...)
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+
+		if response.Diagnostics.HasError() {
+			return ctx
+		}
+
+		ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+		inContext, _ := tftags.FromContext(ctx)
+		inContext.TagsIn = meta.DefaultTagsConfig.MergeTags(tftags.New(planTags))
+
+		if !planTagsAll.Equal(stateTagsAll) {
+			var identifier types.String
+
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(r.tags.IdentifierAttribute), &identifier)...)
+
+			if response.Diagnostics.HasError() {
+				return ctx
+			}
+
+			if err := r.servicePackage.UpdateTags(ctx, meta, identifier.ValueString(), stateTagsAll, planTagsAll); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating tags for %s (%s)", r.typeName, identifier.ValueString()), err.Error())
+
+				return ctx
+			}
+		}
+	}
+
+	return ctx
+}
+
+func (r tagsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when) context.Context {
+	return ctx
+}
+
+// modifyPlan calculates the new value for the `tags_all` attribute.
+func (r tagsInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when) context.Context {
+	if meta == nil {
+		return ctx
+	}
+
+	// The resource is being destroyed.
+	if request.Plan.Raw.IsNull() {
+		return ctx
+	}
+
+	if when != After {
+		return ctx
+	}
+
+	var planTags types.Map
+
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if planTags.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), tftags.Unknown)...)
+
+		return ctx
+	}
+
+	resourceTags := tftags.New(planTags)
+
+	if meta.DefaultTagsConfig.TagsEqual(resourceTags) {
+		response.Diagnostics.AddError(
+			`"tags" are identical to those in the "default_tags" configuration block of the provider`,
+			"please de-duplicate and try again")
+	}
+
+	errs, warnings := meta.CheckRequiredTags(ctx, meta.DefaultTagsConfig.MergeTags(resourceTags))
+
+	for _, v := range errs {
+		response.Diagnostics.AddAttributeError(path.Root("tags"), "Required tags", v.Error())
+	}
+
+	for _, v := range warnings {
+		response.Diagnostics.AddAttributeWarning(path.Root("tags"), "Required tags", v.Error())
+	}
+
+	allTags := meta.DefaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(meta.IgnoreTagsConfig)
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+	return ctx
+}
+
+// preventDestroyInterceptor prevents the deletion of resources whose tags match the provider's prevent_destroy_tags configuration.
+type preventDestroyInterceptor struct {
+	typeName string
+}
+
+func (r preventDestroyInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when) context.Context {
+	return ctx
+}
+
+func (r preventDestroyInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when) context.Context {
+	return ctx
+}
+
+func (r preventDestroyInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when) context.Context {
+	return ctx
+}
+
+func (r preventDestroyInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when) context.Context {
+	if meta == nil || when != Before {
+		return ctx
+	}
+
+	// The resource's current tags are read from state.
+	var stateTagsAll types.Map
+
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+
+	if response.Diagnostics.HasError() {
+		return ctx
+	}
+
+	if err := meta.PreventDestroy(tftags.New(stateTagsAll)); err != nil {
+		var id types.String
+
+		// Not all resources have an "id" attribute.
+		request.State.GetAttribute(ctx, path.Root("id"), &id)
+
+		response.Diagnostics.AddError(fmt.Sprintf("deleting %s (%s)", r.typeName, id.ValueString()), err.Error())
+	}
+
+	return ctx
+}
+
+func (r preventDestroyInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when) context.Context {
+	return ctx
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -15,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -60,6 +63,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"emulator": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Configure the provider for a local emulator of the AWS APIs. Enables `s3_use_path_style`, `skip_credentials_validation`, `skip_region_validation` and `skip_requesting_account_id`.",
+			},
+			"endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Endpoint to use for all AWS services that don't have an `endpoints` override. Can also be configured using the `AWS_ENDPOINT_URL` environment variable.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -76,6 +87,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"prevent_destroy_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Resource tags that prevent the deletion of resources tagged with them. A `*` in a value matches any sequence of characters.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -151,10 +167,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
+			"api_rate_limit": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate of AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests sent at once after a period of inactivity.",
+						},
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent requests.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The sustained rate of requests.",
+						},
+						"service": schema.StringAttribute{
+							Optional:    true,
+							Description: "The service whose requests are limited, e.g. `ec2` or `route53`. If not set, the limits apply to each service without its own.",
+						},
+					},
 				},
+			},
+			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -257,6 +293,45 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with default operation timeouts for a resource type. A timeout set in a resource's `timeouts` configuration block takes precedence.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout for create operations, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout for delete operations, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout for read operations, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The resource type name, e.g. `aws_db_instance`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout for update operations, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -278,6 +353,35 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"required_tags": schema.ListNestedBlock{
+				Description: "Configuration blocks with tags that resources are required to have.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether a resource without the tag causes a plan `error` (the default) or `warn`ing.",
+						},
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that don't require the tag. A `*` matches any sequence of characters.",
+						},
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "The required tag key.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that require the tag. A `*` matches any sequence of characters. If not set, all resource types that support tags require the tag.",
+						},
+						"value_regex": schema.StringAttribute{
+							Optional:    true,
+							Description: "A regular expression that the tag's value must match.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -301,8 +405,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	var dataSources []func() datasource.DataSource
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
-		for _, v := range sp.FrameworkDataSources(ctx) {
-			v, err := v(ctx)
+		for _, factory := range sp.FrameworkDataSources(ctx) {
+			factory := factory
+			v, err := factory(ctx)
 
 			if err != nil {
 				tflog.Warn(ctx, "creating data source", map[string]interface{}{
@@ -313,8 +418,13 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				continue
 			}
 
+			schemaResponse := datasource.SchemaResponse{}
+			v.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+			_, ok := schemaResponse.Schema.Attributes[regionAttributeName]
+			regional := !ok && !names.IsGlobalService(sp.ServicePackageName())
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(v)
+				return newWrappedDataSource(newInstance(ctx, factory, v), regional)
 			})
 		}
 	}
@@ -331,8 +441,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	var resources []func() resource.Resource
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
-		for _, v := range sp.FrameworkResources(ctx) {
-			v, err := v(ctx)
+		for _, factory := range sp.FrameworkResources(ctx) {
+			factory := factory
+			v, err := factory(ctx)
 
 			if err != nil {
 				tflog.Warn(ctx, "creating resource", map[string]interface{}{
@@ -343,8 +454,43 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				continue
 			}
 
+			var interceptors resourceInterceptors
+
+			metadataResponse := resource.MetadataResponse{}
+			v.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
+			if v := sp.ResourceTags(typeName); v != nil {
+				spWithTags, ok := sp.(intf.ServicePackageWithTags)
+
+				if !ok {
+					tflog.Warn(ctx, "creating resource", map[string]interface{}{
+						"service_package_name": sp.ServicePackageName(),
+						"resource_type_name":   typeName,
+						"error":                "resource declares tags but service package doesn't implement ListTags and UpdateTags",
+					})
+
+					continue
+				}
+
+				interceptors = append(interceptors, tagsInterceptor{
+					servicePackage: spWithTags,
+					tags:           v,
+					typeName:       typeName,
+				})
+			}
+
+			schemaResponse := resource.SchemaResponse{}
+			v.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+			_, ok := schemaResponse.Schema.Attributes[regionAttributeName]
+			regional := !ok && !names.IsGlobalService(sp.ServicePackageName())
+
+			if _, ok := schemaResponse.Schema.Attributes["tags_all"]; ok {
+				interceptors = append(resourceInterceptors{preventDestroyInterceptor{typeName: typeName}}, interceptors...)
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(v)
+				return newWrappedResource(newInstance(ctx, factory, v), interceptors, regional)
 			})
 		}
 	}
@@ -352,6 +498,16 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// newInstance returns a new resource or data source instance so that each instance can be configured for its own Region.
+// The provided instance is returned if a new instance can't be created.
+func newInstance[T any](ctx context.Context, factory func(context.Context) (T, error), v T) T {
+	if v, err := factory(ctx); err == nil {
+		return v
+	}
+
+	return v
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -372,11 +528,13 @@ func endpointsBlock() schema.SetNestedBlock {
 // wrappedDataSource wraps a data source, adding common functionality.
 type wrappedDataSource struct {
 	inner    datasource.DataSourceWithConfigure
+	meta     *conns.AWSClient
+	regional bool
 	typeName string
 }
 
-func newWrappedDataSource(inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
-	return &wrappedDataSource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+func newWrappedDataSource(inner datasource.DataSourceWithConfigure, regional bool) datasource.DataSourceWithConfigure {
+	return &wrappedDataSource{inner: inner, regional: regional, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -385,29 +543,39 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional {
+		response.Schema.Attributes[regionAttributeName] = regionDataSourceAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
 
-	w.inner.Read(ctx, request, response)
+	w.readInner(ctx, request, response)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read exit", w.typeName))
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
 	w.inner.Configure(ctx, request, response)
 }
 
 // wrappedResource wraps a resource, adding common functionality.
 type wrappedResource struct {
-	inner    resource.ResourceWithConfigure
-	meta     *conns.AWSClient
-	typeName string
+	inner        resource.ResourceWithConfigure
+	interceptors resourceInterceptors
+	meta         *conns.AWSClient
+	regional     bool
+	typeName     string
 }
 
-func newWrappedResource(inner resource.ResourceWithConfigure) resource.ResourceWithConfigure {
-	return &wrappedResource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+func newWrappedResource(inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regional bool) resource.ResourceWithConfigure {
+	return &wrappedResource{inner: inner, interceptors: interceptors, regional: regional, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -416,52 +584,145 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional {
+		response.Schema.Attributes[regionAttributeName] = regionResourceAttribute()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
-	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+	meta := w.configure(ctx, request.Plan.GetAttribute, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if meta != nil {
+		ctx = meta.InitContext(ctx)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Create enter", w.typeName))
 
-	w.inner.Create(ctx, request, response)
+	for _, v := range w.interceptors {
+		ctx = v.create(ctx, request, response, meta, Before)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	w.createInner(ctx, request, response, meta)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i := len(w.interceptors) - 1; i >= 0; i-- {
+		ctx = w.interceptors[i].create(ctx, request, response, meta, After)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Create exit", w.typeName))
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
-	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+	meta := w.configure(ctx, request.State.GetAttribute, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if meta != nil {
+		// Resources are read concurrently during refresh.
+		ctx = meta.BatchingContext(meta.InitContext(ctx))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
 
-	w.inner.Read(ctx, request, response)
+	for _, v := range w.interceptors {
+		ctx = v.read(ctx, request, response, meta, Before)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	w.readInner(ctx, request, response, meta)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i := len(w.interceptors) - 1; i >= 0; i-- {
+		ctx = w.interceptors[i].read(ctx, request, response, meta, After)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read exit", w.typeName))
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
-	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+	meta := w.configure(ctx, request.Plan.GetAttribute, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if meta != nil {
+		ctx = meta.InitContext(ctx)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Update enter", w.typeName))
 
-	w.inner.Update(ctx, request, response)
+	for _, v := range w.interceptors {
+		ctx = v.update(ctx, request, response, meta, Before)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	w.updateInner(ctx, request, response, meta)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i := len(w.interceptors) - 1; i >= 0; i-- {
+		ctx = w.interceptors[i].update(ctx, request, response, meta, After)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Update exit", w.typeName))
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
-	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+	meta := w.configure(ctx, request.State.GetAttribute, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if meta != nil {
+		ctx = meta.InitContext(ctx)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Delete enter", w.typeName))
 
-	w.inner.Delete(ctx, request, response)
+	for _, v := range w.interceptors {
+		ctx = v.delete(ctx, request, response, meta, Before)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	w.deleteInner(ctx, request, response, meta)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i := len(w.interceptors) - 1; i >= 0; i-- {
+		ctx = w.interceptors[i].delete(ctx, request, response, meta, After)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Delete exit", w.typeName))
 }
@@ -472,6 +733,8 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	}
 
 	w.inner.Configure(ctx, request, response)
+
+	w.setDefaultTimeouts(ctx)
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -480,7 +743,7 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 			ctx = w.meta.InitContext(ctx)
 		}
 
-		v.ImportState(ctx, request, response)
+		w.importStateInner(ctx, v, request, response)
 
 		return
 	}
@@ -492,15 +755,48 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		if w.meta != nil {
-			ctx = w.meta.InitContext(ctx)
-		}
+	w.modifyPlanRegion(ctx, request, response)
 
-		v.ModifyPlan(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var meta *conns.AWSClient
 
+	if request.Plan.Raw.IsNull() {
+		meta = w.configure(ctx, request.State.GetAttribute, &response.Diagnostics)
+	} else {
+		meta = w.configure(ctx, response.Plan.GetAttribute, &response.Diagnostics)
+	}
+
+	if response.Diagnostics.HasError() {
 		return
 	}
+
+	if meta != nil {
+		ctx = meta.InitContext(ctx)
+	}
+	ctx = conns.NewResourceContext(ctx, w.resourceTypeName(ctx))
+
+	for _, v := range w.interceptors {
+		ctx = v.modifyPlan(ctx, request, response, meta, Before)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+		w.modifyPlanInner(ctx, v, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for i := len(w.interceptors) - 1; i >= 0; i-- {
+		w.interceptors[i].modifyPlan(ctx, request, response, meta, After)
+	}
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -517,6 +813,57 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 			ctx = w.meta.InitContext(ctx)
 		}
 
-		v.ValidateConfig(ctx, request, response)
+		w.validateConfigInner(ctx, v, request, response)
+	}
+}
+
+// withDefaultTimeouts is implemented by resources that embed framework.WithTimeouts.
+type withDefaultTimeouts interface {
+	SetDefaultCreateTimeout(time.Duration)
+	SetDefaultReadTimeout(time.Duration)
+	SetDefaultUpdateTimeout(time.Duration)
+	SetDefaultDeleteTimeout(time.Duration)
+}
+
+// setDefaultTimeouts overrides the resource's default timeouts with the provider-configured ones.
+// Any timeout set in the resource's `timeouts` configuration block takes precedence over the default.
+func (w *wrappedResource) setDefaultTimeouts(ctx context.Context) {
+	if w.meta == nil {
+		return
+	}
+
+	v, ok := w.inner.(withDefaultTimeouts)
+
+	if !ok {
+		return
+	}
+
+	timeouts, ok := w.meta.ResourceTimeouts(w.resourceTypeName(ctx))
+
+	if !ok {
+		return
 	}
+
+	for _, t := range []struct {
+		timeout time.Duration
+		set     func(time.Duration)
+	}{
+		{timeouts.Create, v.SetDefaultCreateTimeout},
+		{timeouts.Read, v.SetDefaultReadTimeout},
+		{timeouts.Update, v.SetDefaultUpdateTimeout},
+		{timeouts.Delete, v.SetDefaultDeleteTimeout},
+	} {
+		if t.timeout > 0 {
+			t.set(t.timeout)
+		}
+	}
+}
+
+// resourceTypeName returns the resource's Terraform type name.
+func (w *wrappedResource) resourceTypeName(ctx context.Context) string {
+	var response resource.MetadataResponse
+
+	w.inner.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+	return response.TypeName
 }
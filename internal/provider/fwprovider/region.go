@@ -0,0 +1,451 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	regionAttributeName        = "region"
+	regionAttributeDescription = "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration."
+)
+
+// attributeGetter reads an attribute's value from a configuration, plan or state.
+type attributeGetter func(context.Context, path.Path, any) diag.Diagnostics
+
+// regionalClient returns the AWSClient for the Region read using the specified function.
+// The provider's AWSClient is returned if no Region is set.
+func regionalClient(ctx context.Context, meta *conns.AWSClient, getAttribute attributeGetter, diags *diag.Diagnostics) *conns.AWSClient {
+	var region types.String
+
+	diags.Append(getAttribute(ctx, path.Root(regionAttributeName), &region)...)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	client, err := meta.RegionalClient(region.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("creating AWS client for Region (%s)", region.ValueString()), err.Error())
+
+		return nil
+	}
+
+	return client
+}
+
+// regionValues converts configuration, plan and state values between a wrapped schema, which has the `region` attribute,
+// and the inner resource's or data source's schema, which doesn't.
+// Errors are added to the diagnostics.
+type regionValues struct {
+	diags     *diag.Diagnostics
+	innerType tftypes.Type
+	outerType tftypes.Type
+}
+
+func newRegionValues(ctx context.Context, innerType, outerType attr.Type, diags *diag.Diagnostics) regionValues {
+	return regionValues{
+		diags:     diags,
+		innerType: innerType.TerraformType(ctx),
+		outerType: outerType.TerraformType(ctx),
+	}
+}
+
+// inner returns the value without the `region` attribute.
+func (r regionValues) inner(v tftypes.Value) tftypes.Value {
+	return r.convert(v, r.innerType, func(attributes map[string]tftypes.Value) {
+		delete(attributes, regionAttributeName)
+	})
+}
+
+// outer returns the value with the specified `region` attribute value.
+func (r regionValues) outer(v tftypes.Value, region tftypes.Value) tftypes.Value {
+	return r.convert(v, r.outerType, func(attributes map[string]tftypes.Value) {
+		attributes[regionAttributeName] = region
+	})
+}
+
+func (r regionValues) convert(v tftypes.Value, typ tftypes.Type, f func(map[string]tftypes.Value)) tftypes.Value {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := v.As(&attributes); err != nil {
+		r.diags.AddError("converting region attribute", err.Error())
+
+		return tftypes.NewValue(typ, nil)
+	}
+
+	f(attributes)
+
+	if err := tftypes.ValidateValue(typ, attributes); err != nil {
+		r.diags.AddError("converting region attribute", err.Error())
+
+		return tftypes.NewValue(typ, nil)
+	}
+
+	return tftypes.NewValue(typ, attributes)
+}
+
+func regionValue(meta *conns.AWSClient) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, meta.Region)
+}
+
+// innerSchema returns the inner resource's schema and a converter between the wrapped and inner resources' values.
+func (w *wrappedResource) innerSchema(ctx context.Context, diags *diag.Diagnostics) (resourceschema.Schema, regionValues) {
+	var response resource.SchemaResponse
+
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+	diags.Append(response.Diagnostics...)
+
+	outer := response.Schema
+	outer.Attributes = make(map[string]resourceschema.Attribute, len(response.Schema.Attributes)+1)
+	for k, v := range response.Schema.Attributes {
+		outer.Attributes[k] = v
+	}
+	outer.Attributes[regionAttributeName] = regionResourceAttribute()
+
+	return response.Schema, newRegionValues(ctx, response.Schema.Type(), outer.Type(), diags)
+}
+
+func regionResourceAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+func regionDataSourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+// configure returns the AWSClient for the resource's Region, read using the specified function,
+// and configures the inner resource with it.
+func (w *wrappedResource) configure(ctx context.Context, getAttribute attributeGetter, diags *diag.Diagnostics) *conns.AWSClient {
+	if !w.regional || w.meta == nil {
+		return w.meta
+	}
+
+	meta := regionalClient(ctx, w.meta, getAttribute, diags)
+
+	if diags.HasError() {
+		return nil
+	}
+
+	w.configureInner(ctx, meta, diags)
+
+	return meta
+}
+
+func (w *wrappedResource) configureInner(ctx context.Context, meta *conns.AWSClient, diags *diag.Diagnostics) {
+	var response resource.ConfigureResponse
+
+	w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &response)
+	diags.Append(response.Diagnostics...)
+}
+
+func (w *wrappedResource) createInner(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient) {
+	if !w.regional || meta == nil {
+		w.inner.Create(ctx, request, response)
+
+		return
+	}
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config = tfsdk.Config{Schema: innerSchema, Raw: values.inner(request.Config.Raw)}
+	innerRequest.Plan = tfsdk.Plan{Schema: innerSchema, Raw: values.inner(request.Plan.Raw)}
+	innerResponse.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(response.State.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Private = innerResponse.Private
+	response.State.Raw = values.outer(innerResponse.State.Raw, regionValue(meta))
+}
+
+func (w *wrappedResource) readInner(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient) {
+	if !w.regional || meta == nil {
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest, innerResponse := request, *response
+	innerRequest.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(request.State.Raw)}
+	innerResponse.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(response.State.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Private = innerResponse.Private
+	response.State.Raw = values.outer(innerResponse.State.Raw, regionValue(meta))
+}
+
+func (w *wrappedResource) updateInner(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient) {
+	if !w.regional || meta == nil {
+		w.inner.Update(ctx, request, response)
+
+		return
+	}
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config = tfsdk.Config{Schema: innerSchema, Raw: values.inner(request.Config.Raw)}
+	innerRequest.Plan = tfsdk.Plan{Schema: innerSchema, Raw: values.inner(request.Plan.Raw)}
+	innerRequest.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(request.State.Raw)}
+	innerResponse.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(response.State.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Private = innerResponse.Private
+	response.State.Raw = values.outer(innerResponse.State.Raw, regionValue(meta))
+}
+
+func (w *wrappedResource) deleteInner(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient) {
+	if !w.regional || meta == nil {
+		w.inner.Delete(ctx, request, response)
+
+		return
+	}
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest, innerResponse := request, *response
+	innerRequest.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(request.State.Raw)}
+	innerResponse.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(response.State.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State.Raw = values.outer(innerResponse.State.Raw, regionValue(meta))
+}
+
+// importStateInner calls the inner resource's ImportState method.
+// The import ID of a regional resource can have a Region suffix.
+func (w *wrappedResource) importStateInner(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if !w.regional || w.meta == nil {
+		inner.ImportState(ctx, request, response)
+
+		return
+	}
+
+	id, region := conns.SplitRegionalImportID(request.ID)
+	meta, err := w.meta.RegionalClient(region)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating AWS client for Region (%s)", region), err.Error())
+
+		return
+	}
+
+	w.configureInner(ctx, meta, &response.Diagnostics)
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest, innerResponse := request, *response
+	innerRequest.ID = id
+	innerResponse.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(response.State.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ImportState(meta.InitContext(ctx), innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Private = innerResponse.Private
+	response.State.Raw = values.outer(innerResponse.State.Raw, regionValue(meta))
+}
+
+// modifyPlanRegion plans the resource's Region, which is the configured Region or, if none, the provider's Region.
+// A change of Region replaces the resource.
+func (w *wrappedResource) modifyPlanRegion(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !w.regional || w.meta == nil {
+		return
+	}
+
+	// The resource is being destroyed.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion, planRegion, stateRegion types.String
+
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(regionAttributeName), &configRegion)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if configRegion.IsNull() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(regionAttributeName), w.meta.Region)...)
+	}
+
+	// The resource is being created.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(regionAttributeName), &planRegion)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(regionAttributeName), &stateRegion)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Don't replace existing resources whose state predates the `region` argument.
+	if stateRegion.ValueString() == "" {
+		return
+	}
+
+	if !planRegion.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(regionAttributeName))
+	}
+}
+
+func (w *wrappedResource) modifyPlanInner(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !w.regional || w.meta == nil {
+		inner.ModifyPlan(ctx, request, response)
+
+		return
+	}
+
+	var planRegion types.String
+
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(regionAttributeName), &planRegion)...)
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config = tfsdk.Config{Schema: innerSchema, Raw: values.inner(request.Config.Raw)}
+	innerRequest.Plan = tfsdk.Plan{Schema: innerSchema, Raw: values.inner(request.Plan.Raw)}
+	innerRequest.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(request.State.Raw)}
+	innerResponse.Plan = tfsdk.Plan{Schema: innerSchema, Raw: values.inner(response.Plan.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	region, err := planRegion.ToTerraformValue(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError("converting region attribute", err.Error())
+
+		return
+	}
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Private = innerResponse.Private
+	response.RequiresReplace = innerResponse.RequiresReplace
+	response.Plan.Raw = values.outer(innerResponse.Plan.Raw, region)
+}
+
+func (w *wrappedResource) validateConfigInner(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	if !w.regional {
+		inner.ValidateConfig(ctx, request, response)
+
+		return
+	}
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: innerSchema, Raw: values.inner(request.Config.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ValidateConfig(ctx, innerRequest, response)
+}
+
+// innerSchema returns the inner data source's schema and a converter between the wrapped and inner data sources' values.
+func (w *wrappedDataSource) innerSchema(ctx context.Context, diags *diag.Diagnostics) (datasourceschema.Schema, regionValues) {
+	var response datasource.SchemaResponse
+
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+	diags.Append(response.Diagnostics...)
+
+	outer := response.Schema
+	outer.Attributes = make(map[string]datasourceschema.Attribute, len(response.Schema.Attributes)+1)
+	for k, v := range response.Schema.Attributes {
+		outer.Attributes[k] = v
+	}
+	outer.Attributes[regionAttributeName] = regionDataSourceAttribute()
+
+	return response.Schema, newRegionValues(ctx, response.Schema.Type(), outer.Type(), diags)
+}
+
+func (w *wrappedDataSource) readInner(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	if !w.regional || w.meta == nil {
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	meta := regionalClient(ctx, w.meta, request.Config.GetAttribute, &response.Diagnostics)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var configureResponse datasource.ConfigureResponse
+
+	w.inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &configureResponse)
+	response.Diagnostics.Append(configureResponse.Diagnostics...)
+
+	innerSchema, values := w.innerSchema(ctx, &response.Diagnostics)
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config = tfsdk.Config{Schema: innerSchema, Raw: values.inner(request.Config.Raw)}
+	innerResponse.State = tfsdk.State{Schema: innerSchema, Raw: values.inner(response.State.Raw)}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Read(meta.InitContext(ctx), innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State.Raw = values.outer(innerResponse.State.Raw, regionValue(meta))
+}
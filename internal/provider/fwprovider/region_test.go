@@ -0,0 +1,545 @@
+package fwprovider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	testRegion      = "us-west-2" //lintignore:AWSAT003
+	testOtherRegion = "eu-west-1" //lintignore:AWSAT003
+)
+
+// testRegionResource is a minimal resource, without a `region` attribute, wrapped in tests.
+type testRegionResource struct {
+	configuredRegion string
+	importID         string
+}
+
+func (r *testRegionResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testRegionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *testRegionResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.configuredRegion = v.Region
+	}
+}
+
+func (r *testRegionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var name types.String
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), "test-id")...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (r *testRegionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r *testRegionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.State.Raw = request.Plan.Raw
+}
+
+func (r *testRegionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	response.State.RemoveResource(ctx)
+}
+
+func (r *testRegionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	r.importID = request.ID
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *testRegionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+}
+
+func newTestRegionResource(t *testing.T) (*wrappedResource, *testRegionResource, schema.Schema) {
+	t.Helper()
+
+	ctx := context.Background()
+	server := fakeaws.NewTestServer(t)
+	config := &conns.Config{
+		AccessKey:   fakeaws.AccessKey,
+		Emulator:    true,
+		EndpointURL: server.URL,
+		Region:      testRegion,
+		SecretKey:   fakeaws.SecretKey,
+	}
+	meta, diags := config.ConfigureProvider(ctx, &conns.AWSClient{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	inner := &testRegionResource{}
+	w := newWrappedResource(inner, nil, true).(*wrappedResource)
+
+	var configureResponse resource.ConfigureResponse
+	w.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &configureResponse)
+
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", diagsError(configureResponse.Diagnostics))
+	}
+
+	var schemaResponse resource.SchemaResponse
+	w.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	return w, inner, schemaResponse.Schema
+}
+
+var (
+	testInnerType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	}}
+	testOuterType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":     tftypes.String,
+		"name":   tftypes.String,
+		"region": tftypes.String,
+	}}
+)
+
+// testValue returns a test resource value. A nil attribute value is null.
+func testValue(typ tftypes.Object, attributes map[string]any) tftypes.Value {
+	vals := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for k, t := range typ.AttributeTypes {
+		vals[k] = tftypes.NewValue(t, attributes[k])
+	}
+
+	return tftypes.NewValue(typ, vals)
+}
+
+func testAttribute(t *testing.T, v tftypes.Value, name string) any {
+	t.Helper()
+
+	var vals map[string]tftypes.Value
+
+	if err := v.As(&vals); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !vals[name].IsKnown() {
+		return tftypes.UnknownValue
+	}
+
+	if vals[name].IsNull() {
+		return nil
+	}
+
+	var s string
+
+	if err := vals[name].As(&s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return s
+}
+
+func diagsError(diags diag.Diagnostics) string {
+	var errs []string
+
+	for _, d := range diags.Errors() {
+		errs = append(errs, d.Summary()+": "+d.Detail())
+	}
+
+	return strings.Join(errs, "; ")
+}
+
+func TestRegionValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		value     tftypes.Value
+		wantInner tftypes.Value
+		wantOuter tftypes.Value
+	}{
+		"null": {
+			value:     tftypes.NewValue(testOuterType, nil),
+			wantInner: tftypes.NewValue(testInnerType, nil),
+			wantOuter: tftypes.NewValue(testOuterType, nil),
+		},
+		"unknown": {
+			value:     tftypes.NewValue(testOuterType, tftypes.UnknownValue),
+			wantInner: tftypes.NewValue(testInnerType, tftypes.UnknownValue),
+			wantOuter: tftypes.NewValue(testOuterType, tftypes.UnknownValue),
+		},
+		"known": {
+			value:     testValue(testOuterType, map[string]any{"id": "test-id", "region": testOtherRegion}),
+			wantInner: testValue(testInnerType, map[string]any{"id": "test-id"}),
+			wantOuter: testValue(testOuterType, map[string]any{"id": "test-id", "region": testRegion}),
+		},
+		"unknown attribute": {
+			value:     testValue(testOuterType, map[string]any{"id": tftypes.UnknownValue, "name": "test"}),
+			wantInner: testValue(testInnerType, map[string]any{"id": tftypes.UnknownValue, "name": "test"}),
+			wantOuter: testValue(testOuterType, map[string]any{"id": tftypes.UnknownValue, "name": "test", "region": testRegion}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			values := regionValues{diags: &diags, innerType: testInnerType, outerType: testOuterType}
+
+			inner := values.inner(testCase.value)
+
+			if !inner.Equal(testCase.wantInner) {
+				t.Errorf("inner = %s, want %s", inner, testCase.wantInner)
+			}
+
+			outer := values.outer(inner, tftypes.NewValue(tftypes.String, testRegion))
+
+			if !outer.Equal(testCase.wantOuter) {
+				t.Errorf("outer = %s, want %s", outer, testCase.wantOuter)
+			}
+
+			if diags.HasError() {
+				t.Errorf("unexpected error: %s", diagsError(diags))
+			}
+		})
+	}
+
+	// The converter's types are those of the wrapped and inner schemas.
+	w, _, _ := newTestRegionResource(t)
+	var diags diag.Diagnostics
+	_, values := w.innerSchema(ctx, &diags)
+
+	if !values.innerType.Equal(testInnerType) {
+		t.Errorf("inner type = %s, want %s", values.innerType, testInnerType)
+	}
+	if !values.outerType.Equal(testOuterType) {
+		t.Errorf("outer type = %s, want %s", values.outerType, testOuterType)
+	}
+}
+
+func TestRegionValues_invalid(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	values := regionValues{diags: &diags, innerType: testInnerType, outerType: testOuterType}
+
+	// The region attribute must be a string.
+	got := values.outer(testValue(testInnerType, map[string]any{"id": "test-id"}), tftypes.NewValue(tftypes.Bool, true))
+
+	if !got.IsNull() {
+		t.Errorf("outer = %s, want null", got)
+	}
+
+	if !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestWrappedResourceRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configRegion any
+		wantRegion   string
+	}{
+		"no region": {
+			wantRegion: testRegion,
+		},
+		"provider region": {
+			configRegion: testRegion,
+			wantRegion:   testRegion,
+		},
+		"other region": {
+			configRegion: testOtherRegion,
+			wantRegion:   testOtherRegion,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			w, inner, s := newTestRegionResource(t)
+
+			// Plan the resource's creation. The region is computed, so is unknown if not configured.
+			config := testValue(testOuterType, map[string]any{"name": "test", "region": testCase.configRegion})
+			proposedRegion := testCase.configRegion
+			if proposedRegion == nil {
+				proposedRegion = tftypes.UnknownValue
+			}
+			proposed := testValue(testOuterType, map[string]any{"id": tftypes.UnknownValue, "name": "test", "region": proposedRegion})
+
+			planResponse := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: s, Raw: proposed}}
+			w.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: proposed},
+				State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(testOuterType, nil)},
+			}, &planResponse)
+
+			if planResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", diagsError(planResponse.Diagnostics))
+			}
+
+			plan := planResponse.Plan.Raw
+
+			createResponse := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(testOuterType, nil)}}
+			w.Create(ctx, resource.CreateRequest{
+				Config: tfsdk.Config{Schema: s, Raw: config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+			}, &createResponse)
+
+			if createResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", diagsError(createResponse.Diagnostics))
+			}
+
+			want := testValue(testOuterType, map[string]any{"id": "test-id", "name": "test", "region": testCase.wantRegion})
+			state := createResponse.State.Raw
+
+			if !state.Equal(want) {
+				t.Errorf("Create state = %s, want %s", state, want)
+			}
+
+			if got, want := inner.configuredRegion, testCase.wantRegion; got != want {
+				t.Errorf("configured Region = %q, want %q", got, want)
+			}
+
+			readResponse := resource.ReadResponse{State: tfsdk.State{Schema: s, Raw: state}}
+			w.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: state}}, &readResponse)
+
+			if readResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", diagsError(readResponse.Diagnostics))
+			}
+
+			if got := readResponse.State.Raw; !got.Equal(want) {
+				t.Errorf("Read state = %s, want %s", got, want)
+			}
+
+			config = testValue(testOuterType, map[string]any{"name": "updated", "region": testCase.configRegion})
+			plan = testValue(testOuterType, map[string]any{"id": "test-id", "name": "updated", "region": testCase.wantRegion})
+
+			updateResponse := resource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: plan}}
+			w.Update(ctx, resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: s, Raw: config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+				State:  tfsdk.State{Schema: s, Raw: state},
+			}, &updateResponse)
+
+			if updateResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", diagsError(updateResponse.Diagnostics))
+			}
+
+			if got := updateResponse.State.Raw; !got.Equal(plan) {
+				t.Errorf("Update state = %s, want %s", got, plan)
+			}
+
+			deleteResponse := resource.DeleteResponse{State: tfsdk.State{Schema: s, Raw: plan}}
+			w.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: s, Raw: plan}}, &deleteResponse)
+
+			if deleteResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", diagsError(deleteResponse.Diagnostics))
+			}
+
+			if got := deleteResponse.State.Raw; !got.IsNull() {
+				t.Errorf("Delete state = %s, want null", got)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceModifyPlanRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configRegion    any
+		plannedRegion   any // Region in the proposed new state.
+		stateRegion     any
+		destroy         bool
+		noState         bool
+		wantRegion      any
+		wantReplacement bool
+	}{
+		"create no region": {
+			plannedRegion: tftypes.UnknownValue,
+			noState:       true,
+			wantRegion:    testRegion,
+		},
+		"create with region": {
+			configRegion:  testOtherRegion,
+			plannedRegion: testOtherRegion,
+			noState:       true,
+			wantRegion:    testOtherRegion,
+		},
+		"update no region": {
+			plannedRegion: testRegion,
+			stateRegion:   testRegion,
+			wantRegion:    testRegion,
+		},
+		"update region changed": {
+			configRegion:    testOtherRegion,
+			plannedRegion:   testOtherRegion,
+			stateRegion:     testRegion,
+			wantRegion:      testOtherRegion,
+			wantReplacement: true,
+		},
+		"update provider region changed": {
+			plannedRegion:   testOtherRegion,
+			stateRegion:     testOtherRegion,
+			wantRegion:      testRegion,
+			wantReplacement: true,
+		},
+		"update state without region": {
+			// Existing resources whose state predates the `region` argument aren't replaced.
+			plannedRegion: tftypes.UnknownValue,
+			stateRegion:   nil,
+			wantRegion:    testRegion,
+		},
+		"destroy": {
+			stateRegion: testRegion,
+			destroy:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			w, _, s := newTestRegionResource(t)
+
+			config := testValue(testOuterType, map[string]any{"name": "test", "region": testCase.configRegion})
+			proposed := testValue(testOuterType, map[string]any{"id": "test-id", "name": "test", "region": testCase.plannedRegion})
+			state := testValue(testOuterType, map[string]any{"id": "test-id", "name": "test", "region": testCase.stateRegion})
+
+			if testCase.noState {
+				state = tftypes.NewValue(testOuterType, nil)
+			}
+			if testCase.destroy {
+				config = tftypes.NewValue(testOuterType, nil)
+				proposed = tftypes.NewValue(testOuterType, nil)
+			}
+
+			response := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: s, Raw: proposed}}
+			w.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: proposed},
+				State:  tfsdk.State{Schema: s, Raw: state},
+			}, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", diagsError(response.Diagnostics))
+			}
+
+			if testCase.destroy {
+				if !response.Plan.Raw.IsNull() {
+					t.Errorf("plan = %s, want null", response.Plan.Raw)
+				}
+
+				return
+			}
+
+			if diff := cmp.Diff(testAttribute(t, response.Plan.Raw, "region"), testCase.wantRegion); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			var wantRequiresReplace []path.Path
+			if testCase.wantReplacement {
+				wantRequiresReplace = []path.Path{path.Root(regionAttributeName)}
+			}
+
+			if diff := cmp.Diff(response.RequiresReplace, path.Paths(wantRequiresReplace)); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceImportStateRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID   string
+		wantID     string
+		wantRegion string
+	}{
+		"no region": {
+			importID:   "test-id",
+			wantID:     "test-id",
+			wantRegion: testRegion,
+		},
+		"provider region suffix": {
+			importID:   "test-id@" + testRegion,
+			wantID:     "test-id",
+			wantRegion: testRegion,
+		},
+		"not a region suffix": {
+			importID:   "user@example.com",
+			wantID:     "user@example.com",
+			wantRegion: testRegion,
+		},
+		"other region suffix": {
+			importID:   "test-id@" + testOtherRegion,
+			wantID:     "test-id",
+			wantRegion: testOtherRegion,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			w, inner, s := newTestRegionResource(t)
+
+			response := resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(testOuterType, nil)}}
+			w.ImportState(ctx, resource.ImportStateRequest{ID: testCase.importID}, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", diagsError(response.Diagnostics))
+			}
+
+			if got, want := inner.importID, testCase.wantID; got != want {
+				t.Errorf("inner import ID = %q, want %q", got, want)
+			}
+
+			want := testValue(testOuterType, map[string]any{"id": testCase.wantID, "region": testCase.wantRegion})
+
+			if got := response.State.Raw; !got.Equal(want) {
+				t.Errorf("state = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// when represents the point in the CRUD request lifecycle that an interceptor is run.
+type when uint16
+
+const (
+	Before when = 1 << iota // Interceptor is run before the call to the resource's handler.
+	After                   // Interceptor is run after a successful call to the resource's handler.
+)
+
+// why represents the CRUD operation(s) that an interceptor is run for.
+type why uint16
+
+const (
+	Create why = 1 << iota
+	Read
+	Update
+	Delete
+)
+
+// crudInterceptor is run around a resource's CRUD handlers.
+// An interceptor can enhance the Context passed to the handler and return diagnostics.
+// An error diagnostic returned before the handler is called prevents the call.
+type crudInterceptor interface {
+	run(context.Context, *schema.ResourceData, any, when, why, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
+type crudInterceptors []crudInterceptor
+
+// interceptedHandler returns a CRUD handler that runs the specified interceptors around the handler.
+func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f F, interceptors crudInterceptors, why why) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+
+		// Resources are read concurrently during refresh.
+		if why == Read {
+			ctx = meta.(*conns.AWSClient).BatchingContext(ctx)
+		}
+
+		for _, v := range interceptors {
+			ctx, diags = v.run(ctx, d, meta, Before, why, diags)
+
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		diags = append(diags, f(ctx, d, meta)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		// After interceptors are run in reverse order.
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			ctx, diags = interceptors[i].run(ctx, d, meta, After, why, diags)
+		}
+
+		return diags
+	}
+}
+
+// tagsInterceptor implements transparent tagging for resources that declare their tags.
+type tagsInterceptor struct {
+	servicePackage intf.ServicePackageWithTags
+	tags           *intf.ResourceTags
+	typeName       string
+}
+
+func (r tagsInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if why == Delete {
+		return ctx, diags
+	}
+
+	client := meta.(*conns.AWSClient)
+
+	switch when {
+	case Before:
+		ctx = tftags.NewContext(ctx, client.DefaultTagsConfig, client.IgnoreTagsConfig)
+
+		switch why {
+		case Create, Update:
+			inContext, _ := tftags.FromContext(ctx)
+			inContext.TagsIn = client.DefaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+		}
+
+		if why == Update && d.HasChange("tags_all") {
+			o, n := d.GetChange("tags_all")
+
+			if err := r.servicePackage.UpdateTags(ctx, meta, r.identifier(d), o, n); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "updating tags for %s (%s): %s", r.typeName, d.Id(), err)
+			}
+		}
+	case After:
+		// The resource has been deleted.
+		if d.Id() == "" {
+			return ctx, diags
+		}
+
+		inContext, ok := tftags.FromContext(ctx)
+
+		if !ok {
+			return ctx, diags
+		}
+
+		tags, ok := inContext.GetTagsOut()
+
+		// Create has tagged the resource with the tags specified in configuration, so there's no need to list them.
+		if !ok && why == Create {
+			tags, ok = inContext.TagsIn, true
+		}
+
+		if !ok {
+			var err error
+
+			tags, err = r.servicePackage.ListTags(ctx, meta, r.identifier(d))
+
+			if err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "listing tags for %s (%s): %s", r.typeName, d.Id(), err)
+			}
+		}
+
+		tags = tags.IgnoreAWS().IgnoreConfig(inContext.IgnoreConfig)
+
+		//lintignore:AWSR002
+		if err := d.Set("tags", tags.RemoveDefaultConfig(inContext.DefaultConfig).Map()); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+		}
+
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+		}
+	}
+
+	return ctx, diags
+}
+
+// identifier returns the value of the resource's identifier attribute passed to ListTags and UpdateTags.
+func (r tagsInterceptor) identifier(d *schema.ResourceData) string {
+	if r.tags.IdentifierAttribute == "id" {
+		return d.Id()
+	}
+
+	return d.Get(r.tags.IdentifierAttribute).(string)
+}
+
+// preventDestroyInterceptor prevents the deletion of resources whose tags match the provider's prevent_destroy_tags configuration.
+type preventDestroyInterceptor struct {
+	typeName string
+}
+
+func (r preventDestroyInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || why != Delete {
+		return ctx, diags
+	}
+
+	// The resource's current tags are read from state.
+	v, ok := d.Get("tags_all").(map[string]interface{})
+
+	if !ok {
+		return ctx, diags
+	}
+
+	if err := meta.(*conns.AWSClient).PreventDestroy(tftags.New(v)); err != nil {
+		return ctx, sdkdiag.AppendErrorf(diags, "deleting %s (%s): %s", r.typeName, d.Id(), err)
+	}
+
+	return ctx, diags
+}
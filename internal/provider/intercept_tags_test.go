@@ -0,0 +1,520 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestTagsInterceptors exercises the Plugin SDK and Plugin Framework tagging interceptors end to end,
+// creating, updating and reading resources that register their tags through the muxed provider server.
+func TestTagsInterceptors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName   string
+		service    string
+		config     map[string]tftypes.Value
+		dependsOn  map[string]map[string]tftypes.Value // Resources created before the resource under test.
+		createTags int                                 // Expected TagResource calls on Create.
+		wantARN    string
+	}{
+		"sdk destination": {
+			typeName: "aws_cloudwatch_log_destination",
+			service:  names.Logs,
+			config: map[string]tftypes.Value{
+				"name":       tftypes.NewValue(tftypes.String, "test"),
+				"role_arn":   tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/test"),
+				"target_arn": tftypes.NewValue(tftypes.String, "arn:aws:kinesis:us-east-1:123456789012:stream/test"),
+			},
+			// The destination is created and then tagged.
+			createTags: 1,
+			wantARN:    "arn:aws:logs:us-east-1:123456789012:destination:test",
+		},
+		"framework index": {
+			typeName: "aws_resourceexplorer2_index",
+			service:  names.ResourceExplorer2,
+			config: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "LOCAL"),
+			},
+			// The index is tagged on creation.
+			createTags: 0,
+		},
+		"framework view": {
+			typeName: "aws_resourceexplorer2_view",
+			service:  names.ResourceExplorer2,
+			config: map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			},
+			dependsOn: map[string]map[string]tftypes.Value{
+				"aws_resourceexplorer2_index": {
+					"type": tftypes.NewValue(tftypes.String, "LOCAL"),
+				},
+			},
+			createTags: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server := fakeaws.NewTestServer(t)
+			p := newTestProviderServer(ctx, t, server)
+
+			for typeName, config := range testCase.dependsOn {
+				p.create(typeName, config)
+			}
+
+			config := withTags(testCase.config, map[string]string{"key1": "value1"})
+			state := p.create(testCase.typeName, config)
+
+			if diff := cmp.Diff(tagsAttribute(t, state, "tags"), map[string]string{"key1": "value1"}); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(tagsAttribute(t, state, "tags_all"), map[string]string{"key1": "value1", "default": "value"}); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if got, want := server.Calls(testCase.service, "TagResource"), testCase.createTags; got != want {
+				t.Errorf("TagResource calls = %d, want %d", got, want)
+			}
+			// The tags applied on Create aren't listed.
+			if got, want := server.Calls(testCase.service, "ListTagsForResource"), 0; got != want {
+				t.Errorf("ListTagsForResource calls = %d, want %d", got, want)
+			}
+
+			config = withTags(testCase.config, map[string]string{"key2": "value2"})
+			state = p.update(testCase.typeName, state, config)
+
+			if diff := cmp.Diff(tagsAttribute(t, state, "tags"), map[string]string{"key2": "value2"}); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(tagsAttribute(t, state, "tags_all"), map[string]string{"key2": "value2", "default": "value"}); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if got, want := server.Calls(testCase.service, "TagResource"), testCase.createTags+1; got != want {
+				t.Errorf("TagResource calls = %d, want %d", got, want)
+			}
+			if got, want := server.Calls(testCase.service, "UntagResource"), 1; got != want {
+				t.Errorf("UntagResource calls = %d, want %d", got, want)
+			}
+
+			state = p.read(testCase.typeName, state)
+
+			if diff := cmp.Diff(tagsAttribute(t, state, "tags"), map[string]string{"key2": "value2"}); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(tagsAttribute(t, state, "tags_all"), map[string]string{"key2": "value2", "default": "value"}); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if testCase.wantARN != "" {
+				if got, want := stringAttribute(t, state, "arn"), testCase.wantARN; got != want {
+					t.Errorf("arn = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestRequiredTagsWarnings verifies that required tags configured to warn are returned as plan warnings
+// by both Plugin SDK and Plugin Framework resources.
+func TestRequiredTagsWarnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName string
+		config   map[string]tftypes.Value
+	}{
+		"sdk destination": {
+			typeName: "aws_cloudwatch_log_destination",
+			config: map[string]tftypes.Value{
+				"name":       tftypes.NewValue(tftypes.String, "test"),
+				"role_arn":   tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/test"),
+				"target_arn": tftypes.NewValue(tftypes.String, "arn:aws:kinesis:us-east-1:123456789012:stream/test"),
+			},
+		},
+		"framework index": {
+			typeName: "aws_resourceexplorer2_index",
+			config: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "LOCAL"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server := fakeaws.NewTestServer(t)
+			p := newTestProviderServer(ctx, t, server, requiredTag{key: "owner", enforcement: "warn"})
+
+			block := p.block(testCase.typeName)
+			response := p.plan(testCase.typeName, tftypes.NewValue(block.ValueType(), nil), configValue(block, withTags(testCase.config, map[string]string{"key1": "value1"})))
+
+			var warnings []*tfprotov5.Diagnostic
+			for _, diagnostic := range response.Diagnostics {
+				if diagnostic.Severity == tfprotov5.DiagnosticSeverityWarning {
+					warnings = append(warnings, diagnostic)
+				}
+			}
+
+			if got, want := len(warnings), 1; got != want {
+				t.Fatalf("warnings = %d, want %d", got, want)
+			}
+			if got, want := warnings[0].Summary, "Required tags"; got != want {
+				t.Errorf("summary = %q, want %q", got, want)
+			}
+			if got, want := warnings[0].Attribute, tftypes.NewAttributePath().WithAttributeName("tags"); !got.Equal(want) {
+				t.Errorf("attribute = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+// testProviderServer drives the muxed provider server as Terraform would for a single resource lifecycle.
+type testProviderServer struct {
+	ctx     context.Context
+	schemas map[string]*tfprotov5.Schema
+	server  tfprotov5.ProviderServer
+	t       *testing.T
+}
+
+// requiredTag is a required_tags provider configuration block.
+type requiredTag struct {
+	key         string
+	enforcement string
+}
+
+func newTestProviderServer(ctx context.Context, t *testing.T, fake *fakeaws.Server, requiredTags ...requiredTag) *testProviderServer {
+	t.Helper()
+
+	providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	p := &testProviderServer{
+		ctx:    ctx,
+		server: providerServerFactory(),
+		t:      t,
+	}
+
+	schemaResponse, err := p.server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	p.checkDiagnostics(schemaResponse.Diagnostics)
+	p.schemas = schemaResponse.ResourceSchemas
+
+	providerType := schemaResponse.Provider.Block.ValueType().(tftypes.Object)
+	defaultTagsType := providerType.AttributeTypes["default_tags"].(tftypes.List).ElementType
+	requiredTagsType := providerType.AttributeTypes["required_tags"].(tftypes.List).ElementType
+
+	requiredTagsValues := []tftypes.Value{}
+	for _, v := range requiredTags {
+		requiredTagsValues = append(requiredTagsValues, configValue(nestedBlock(schemaResponse.Provider.Block, "required_tags"), map[string]tftypes.Value{
+			"key":         tftypes.NewValue(tftypes.String, v.key),
+			"enforcement": tftypes.NewValue(tftypes.String, v.enforcement),
+		}))
+	}
+
+	config := configValue(schemaResponse.Provider.Block, map[string]tftypes.Value{
+		"access_key":              tftypes.NewValue(tftypes.String, fakeaws.AccessKey),
+		"secret_key":              tftypes.NewValue(tftypes.String, fakeaws.SecretKey),
+		"region":                  tftypes.NewValue(tftypes.String, fakeaws.DefaultRegion),
+		"endpoint_url":            tftypes.NewValue(tftypes.String, fake.URL),
+		"emulator":                tftypes.NewValue(tftypes.Bool, true),
+		"skip_metadata_api_check": tftypes.NewValue(tftypes.String, "true"),
+		"default_tags": tftypes.NewValue(tftypes.List{ElementType: defaultTagsType}, []tftypes.Value{
+			tftypes.NewValue(defaultTagsType, map[string]tftypes.Value{
+				"tags": tagsValue(map[string]string{"default": "value"}),
+			}),
+		}),
+		"required_tags": tftypes.NewValue(tftypes.List{ElementType: requiredTagsType}, requiredTagsValues),
+	})
+
+	configureResponse, err := p.server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: p.dynamicValue(schemaResponse.Provider.Block.ValueType(), config),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	p.checkDiagnostics(configureResponse.Diagnostics)
+
+	return p
+}
+
+func (p *testProviderServer) create(typeName string, values map[string]tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	block := p.block(typeName)
+
+	return p.apply(typeName, tftypes.NewValue(block.ValueType(), nil), configValue(block, values))
+}
+
+func (p *testProviderServer) update(typeName string, prior tftypes.Value, values map[string]tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	return p.apply(typeName, prior, configValue(p.block(typeName), values))
+}
+
+func (p *testProviderServer) read(typeName string, state tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	typ := p.block(typeName).ValueType()
+	response, err := p.server.ReadResource(p.ctx, &tfprotov5.ReadResourceRequest{
+		CurrentState: p.dynamicValue(typ, state),
+		TypeName:     typeName,
+	})
+
+	if err != nil {
+		p.t.Fatalf("unexpected error: %s", err)
+	}
+
+	p.checkDiagnostics(response.Diagnostics)
+
+	return p.value(typ, response.NewState)
+}
+
+// plan plans a change to a resource.
+func (p *testProviderServer) plan(typeName string, prior, config tftypes.Value) *tfprotov5.PlanResourceChangeResponse {
+	p.t.Helper()
+
+	block := p.block(typeName)
+	typ := block.ValueType()
+
+	response, err := p.server.PlanResourceChange(p.ctx, &tfprotov5.PlanResourceChangeRequest{
+		Config:           p.dynamicValue(typ, config),
+		PriorState:       p.dynamicValue(typ, prior),
+		ProposedNewState: p.dynamicValue(typ, proposedNewState(block, prior, config)),
+		TypeName:         typeName,
+	})
+
+	if err != nil {
+		p.t.Fatalf("unexpected error: %s", err)
+	}
+
+	p.checkDiagnostics(response.Diagnostics)
+
+	return response
+}
+
+// apply plans and applies a change to a resource, returning the new state.
+func (p *testProviderServer) apply(typeName string, prior, config tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	typ := p.block(typeName).ValueType()
+	planResponse := p.plan(typeName, prior, config)
+
+	applyResponse, err := p.server.ApplyResourceChange(p.ctx, &tfprotov5.ApplyResourceChangeRequest{
+		Config:         p.dynamicValue(typ, config),
+		PlannedPrivate: planResponse.PlannedPrivate,
+		PlannedState:   planResponse.PlannedState,
+		PriorState:     p.dynamicValue(typ, prior),
+		TypeName:       typeName,
+	})
+
+	if err != nil {
+		p.t.Fatalf("unexpected error: %s", err)
+	}
+
+	p.checkDiagnostics(applyResponse.Diagnostics)
+
+	return p.value(typ, applyResponse.NewState)
+}
+
+func (p *testProviderServer) block(typeName string) *tfprotov5.SchemaBlock {
+	p.t.Helper()
+
+	schema, ok := p.schemas[typeName]
+
+	if !ok {
+		p.t.Fatalf("resource %s not found", typeName)
+	}
+
+	return schema.Block
+}
+
+func (p *testProviderServer) checkDiagnostics(diagnostics []*tfprotov5.Diagnostic) {
+	p.t.Helper()
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov5.DiagnosticSeverityError {
+			p.t.Fatalf("unexpected error: %s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
+
+func (p *testProviderServer) dynamicValue(typ tftypes.Type, value tftypes.Value) *tfprotov5.DynamicValue {
+	p.t.Helper()
+
+	dv, err := tfprotov5.NewDynamicValue(typ, value)
+
+	if err != nil {
+		p.t.Fatalf("unexpected error: %s", err)
+	}
+
+	return &dv
+}
+
+func (p *testProviderServer) value(typ tftypes.Type, dv *tfprotov5.DynamicValue) tftypes.Value {
+	p.t.Helper()
+
+	value, err := dv.Unmarshal(typ)
+
+	if err != nil {
+		p.t.Fatalf("unexpected error: %s", err)
+	}
+
+	return value
+}
+
+// nestedBlock returns the schema of a block nested within a schema block.
+func nestedBlock(block *tfprotov5.SchemaBlock, typeName string) *tfprotov5.SchemaBlock {
+	for _, nested := range block.BlockTypes {
+		if nested.TypeName == typeName {
+			return nested.Block
+		}
+	}
+
+	return nil
+}
+
+// configValue returns a configuration value for a schema block.
+// Attributes and nested blocks not in values are null, or empty for list and set nested blocks.
+func configValue(block *tfprotov5.SchemaBlock, values map[string]tftypes.Value) tftypes.Value {
+	typ := block.ValueType().(tftypes.Object)
+	vals := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for _, attribute := range block.Attributes {
+		vals[attribute.Name] = tftypes.NewValue(attribute.ValueType(), nil)
+	}
+
+	for _, nested := range block.BlockTypes {
+		switch nested.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			vals[nested.TypeName] = tftypes.NewValue(nested.ValueType(), []tftypes.Value{})
+		default:
+			vals[nested.TypeName] = tftypes.NewValue(nested.ValueType(), nil)
+		}
+	}
+
+	for k, v := range values {
+		vals[k] = v
+	}
+
+	return tftypes.NewValue(typ, vals)
+}
+
+// proposedNewState returns the proposed new state for a change, as Terraform calculates it:
+// the configuration, with null computed attributes taken from the prior state.
+func proposedNewState(block *tfprotov5.SchemaBlock, prior, config tftypes.Value) tftypes.Value {
+	if prior.IsNull() {
+		return config
+	}
+
+	var priorVals, configVals map[string]tftypes.Value
+
+	if err := prior.As(&priorVals); err != nil {
+		panic(err)
+	}
+	if err := config.As(&configVals); err != nil {
+		panic(err)
+	}
+
+	for _, attribute := range block.Attributes {
+		if attribute.Computed && configVals[attribute.Name].IsNull() {
+			configVals[attribute.Name] = priorVals[attribute.Name]
+		}
+	}
+
+	return tftypes.NewValue(block.ValueType(), configVals)
+}
+
+func withTags(values map[string]tftypes.Value, tags map[string]string) map[string]tftypes.Value {
+	vals := make(map[string]tftypes.Value, len(values)+1)
+
+	for k, v := range values {
+		vals[k] = v
+	}
+
+	vals["tags"] = tagsValue(tags)
+
+	return vals
+}
+
+func tagsValue(tags map[string]string) tftypes.Value {
+	vals := make(map[string]tftypes.Value, len(tags))
+
+	for k, v := range tags {
+		vals[k] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, vals)
+}
+
+func tagsAttribute(t *testing.T, state tftypes.Value, name string) map[string]string {
+	t.Helper()
+
+	var vals map[string]tftypes.Value
+
+	if err := state.As(&vals); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var tagVals map[string]tftypes.Value
+
+	if err := vals[name].As(&tagVals); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tags := make(map[string]string, len(tagVals))
+
+	for k, v := range tagVals {
+		var s string
+
+		if err := v.As(&s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		tags[k] = s
+	}
+
+	return tags
+}
+
+func stringAttribute(t *testing.T, state tftypes.Value, name string) string {
+	t.Helper()
+
+	var vals map[string]tftypes.Value
+
+	if err := state.As(&vals); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var s string
+
+	if err := vals[name].As(&s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return s
+}
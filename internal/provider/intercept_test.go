@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type testInterceptor struct {
+	calls  *[]string
+	failOn when
+	name   string
+}
+
+func (r testInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	*r.calls = append(*r.calls, fmt.Sprintf("%s-%d-%d", r.name, when, why))
+
+	if when == r.failOn {
+		return ctx, append(diags, diag.Errorf("%s failed", r.name)...)
+	}
+
+	return ctx, diags
+}
+
+func TestInterceptedHandler(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		failOn    when
+		wantCalls []string
+		wantError bool
+	}{
+		"success": {
+			wantCalls: []string{"first-1-1", "second-1-1", "handler", "second-2-1", "first-2-1"},
+		},
+		"before error": {
+			failOn:    Before,
+			wantCalls: []string{"first-1-1"},
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			interceptors := crudInterceptors{
+				testInterceptor{calls: &calls, failOn: testCase.failOn, name: "first"},
+				testInterceptor{calls: &calls, name: "second"},
+			}
+			f := wrappedCreateContextFunc(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				calls = append(calls, "handler")
+
+				return nil
+			}, interceptors, false)
+
+			diags := f(context.Background(), nil, new(conns.AWSClient))
+
+			if got, want := diags.HasError(), testCase.wantError; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+
+			if got, want := strings.Join(calls, ","), strings.Join(testCase.wantCalls, ","); got != want {
+				t.Errorf("calls = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestPreventDestroyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		preventDestroyTags map[string]string
+		tagsAll            map[string]interface{}
+		wantDeleted        bool
+	}{
+		"not configured": {
+			tagsAll:     map[string]interface{}{"Environment": "production"},
+			wantDeleted: true,
+		},
+		"no match": {
+			preventDestroyTags: map[string]string{"Environment": "prod*"},
+			tagsAll:            map[string]interface{}{"Environment": "staging"},
+			wantDeleted:        true,
+		},
+		"match": {
+			preventDestroyTags: map[string]string{"Environment": "prod*"},
+			tagsAll:            map[string]interface{}{"Environment": "production"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags_all": {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			}
+			d := r.TestResourceData()
+			d.SetId("test")
+			if err := d.Set("tags_all", testCase.tagsAll); err != nil {
+				t.Fatal(err)
+			}
+
+			var deleted bool
+			f := wrappedDeleteContextFunc(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				deleted = true
+
+				return nil
+			}, crudInterceptors{preventDestroyInterceptor{typeName: "aws_test"}}, false)
+
+			meta := &conns.AWSClient{PreventDestroyTags: tftags.New(testCase.preventDestroyTags)}
+			diags := f(context.Background(), d, meta)
+
+			if got, want := deleted, testCase.wantDeleted; got != want {
+				t.Errorf("deleted = %t, want %t", got, want)
+			}
+
+			if got, want := diags.HasError(), !testCase.wantDeleted; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -12,9 +12,11 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
@@ -217,6 +219,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"api_rate_limit":                apiRateLimitSchema(),
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -242,6 +245,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": defaultTimeoutsSchema(),
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -254,6 +258,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"emulator": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Configure the provider for a local emulator of the AWS APIs. " +
+					"Enables `s3_use_path_style`, `skip_credentials_validation`, `skip_region_validation` and `skip_requesting_account_id`.",
+			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Endpoint to use for all AWS services that don't have an `endpoints` override. " +
+					"Can also be configured using the `AWS_ENDPOINT_URL` environment variable.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -305,6 +321,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"prevent_destroy_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Resource tags that prevent the deletion of resources tagged with them. A `*` in a value matches any sequence of characters.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -317,6 +339,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": requiredTagsSchema(),
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -459,7 +482,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ce_cost_category": ce.DataSourceCostCategory(),
 			"aws_ce_tags":          ce.DataSourceTags(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":  cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resources": cloudcontrol.DataSourceResources(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -683,6 +707,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
 			"aws_iam_policy":                  iam.DataSourcePolicy(),
 			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_lint":             iam.DataSourcePolicyLint(),
 			"aws_iam_role":                    iam.DataSourceRole(),
 			"aws_iam_roles":                   iam.DataSourceRoles(),
 			"aws_iam_saml_provider":           iam.DataSourceSAMLProvider(),
@@ -2229,6 +2254,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		return configure(ctx, provider, d)
 	}
 
+	// Resources not registered by a service package are only wrapped so that their reads may be batched during refresh,
+	// and to enforce prevent_destroy_tags.
+	for typeName, r := range provider.ResourcesMap {
+		var interceptors crudInterceptors
+
+		if _, ok := r.Schema["tags_all"]; ok {
+			interceptors = append(interceptors, preventDestroyInterceptor{typeName: typeName})
+		}
+
+		if v := r.ReadWithoutTimeout; v != nil {
+			r.ReadWithoutTimeout = interceptedHandler(v, interceptors, Read)
+		}
+		if v := r.ReadContext; v != nil {
+			r.ReadContext = interceptedHandler(v, interceptors, Read)
+		}
+
+		if len(interceptors) == 0 {
+			continue
+		}
+
+		if v := r.DeleteWithoutTimeout; v != nil {
+			r.DeleteWithoutTimeout = interceptedHandler(v, interceptors, Delete)
+		}
+		if v := r.DeleteContext; v != nil {
+			r.DeleteContext = interceptedHandler(v, interceptors, Delete)
+		}
+	}
+
 	var errs *multierror.Error
 	servicePackages := servicePackages(ctx)
 
@@ -2243,8 +2296,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			ds := v.Factory()
 
+			regional := isRegional(sp, ds)
+
+			if regional {
+				ds.Schema["region"] = regionSchema(false)
+			}
+
 			if v := ds.ReadWithoutTimeout; v != nil {
-				ds.ReadWithoutTimeout = wrappedReadContextFunc(v)
+				ds.ReadWithoutTimeout = wrappedReadContextFunc(v, nil, regional)
 			}
 
 			provider.DataSourcesMap[typeName] = ds
@@ -2260,26 +2319,72 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			r := v.Factory()
 
+			var interceptors crudInterceptors
+
+			if _, ok := r.Schema["tags_all"]; ok {
+				interceptors = append(interceptors, preventDestroyInterceptor{typeName: typeName})
+			}
+
+			if v := sp.ResourceTags(typeName); v != nil {
+				spWithTags, ok := sp.(intf.ServicePackageWithTags)
+
+				if !ok {
+					errs = multierror.Append(errs, fmt.Errorf("resource %s declares tags but service package %s doesn't implement ListTags and UpdateTags", typeName, sp.ServicePackageName()))
+					continue
+				}
+
+				if _, ok := r.Schema["tags"]; !ok {
+					errs = multierror.Append(errs, fmt.Errorf("resource %s declares tags but has no tags attribute", typeName))
+					continue
+				}
+
+				if _, ok := r.Schema["tags_all"]; !ok {
+					errs = multierror.Append(errs, fmt.Errorf("resource %s declares tags but has no tags_all attribute", typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, tagsInterceptor{
+					servicePackage: spWithTags,
+					tags:           v,
+					typeName:       typeName,
+				})
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(verify.SetTagsDiff, v)
+				} else {
+					r.CustomizeDiff = verify.SetTagsDiff
+				}
+			}
+
+			regional := isRegional(sp, r)
+
+			if regional {
+				r.Schema["region"] = regionSchema(true)
+			}
+
 			if v := r.CreateWithoutTimeout; v != nil {
-				r.CreateWithoutTimeout = wrappedCreateContextFunc(v)
+				r.CreateWithoutTimeout = wrappedCreateContextFunc(v, interceptors, regional)
 			}
 			if v := r.ReadWithoutTimeout; v != nil {
-				r.ReadWithoutTimeout = wrappedReadContextFunc(v)
+				r.ReadWithoutTimeout = wrappedReadContextFunc(v, interceptors, regional)
 			}
 			if v := r.UpdateWithoutTimeout; v != nil {
-				r.UpdateWithoutTimeout = wrappedUpdateContextFunc(v)
+				r.UpdateWithoutTimeout = wrappedUpdateContextFunc(v, interceptors, regional)
 			}
 			if v := r.DeleteWithoutTimeout; v != nil {
-				r.DeleteWithoutTimeout = wrappedDeleteContextFunc(v)
+				r.DeleteWithoutTimeout = wrappedDeleteContextFunc(v, interceptors, regional)
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
-					r.Importer.StateContext = wrappedStateContextFunc(v)
+					r.Importer.StateContext = wrappedStateContextFunc(v, regional)
 				}
 			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = wrappedCustomizeDiffFunc(v)
 			}
+			if regional {
+				r.CustomizeDiff = regionalCustomizeDiffFunc(r.CustomizeDiff)
+			}
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
 					stateUpgrader.Upgrade = wrappedStateUpgradeFunc(v)
@@ -2294,6 +2399,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		return nil, err
 	}
 
+	// The resource type name is passed to verify.SetTagsDiff for the required_tags check.
+	for typeName, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; !ok {
+			continue
+		}
+
+		if v := r.CustomizeDiff; v != nil {
+			r.CustomizeDiff = resourceTypeCustomizeDiffFunc(v, typeName)
+		}
+	}
+
 	// Set the provider Meta (instance data) here.
 	// It will be overwritten by the result of the call to ConfigureContextFunc,
 	// but can be used pre-configuration by other (non-primary) provider servers.
@@ -2323,6 +2439,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Emulator:                       d.Get("emulator").(bool),
+		EndpointURL:                    d.Get("endpoint_url").(string),
 		Endpoints:                      make(map[string]string),
 		HTTPProxy:                      d.Get("http_proxy").(string),
 		Insecure:                       d.Get("insecure").(bool),
@@ -2346,9 +2464,28 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(v.([]interface{})[0].(map[string]interface{}))
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID, config.AssumeRole.SourceIdentity)
+	if v, ok := d.GetOk("api_rate_limit"); ok && len(v.([]interface{})) > 0 {
+		apiRateLimits, err := expandAPIRateLimits(v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.APIRateLimits = apiRateLimits
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		assumeRoles, err := expandAssumeRoles(v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		for i, assumeRole := range assumeRoles {
+			log.Printf("[INFO] assume_role configuration set: (Hop: %d, ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", i, assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.SourceIdentity)
+		}
+
+		config.AssumeRole = assumeRoles
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -2360,6 +2497,30 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		defaultTimeouts, err := expandDefaultTimeouts(v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.DefaultTimeouts = defaultTimeouts
+	}
+
+	if v, ok := d.GetOk("prevent_destroy_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		config.PreventDestroyTags = tftags.New(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 {
+		requiredTags, err := expandRequiredTags(v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RequiredTagsConfig = requiredTags
+	}
+
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
 		endpoints, err := expandEndpoints(v.(*schema.Set).List())
 
@@ -2412,6 +2573,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		return nil, diags
 	}
 
+	diags = append(diags, checkDefaultTimeouts(provider, config.DefaultTimeouts)...)
+
 	// Configure each service.
 	for _, v := range meta.ServicePackages {
 		if err := v.Configure(ctx, meta); err != nil {
@@ -2426,27 +2589,59 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func apiRateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate of AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of requests sent at once after a period of inactivity.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of concurrent requests.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The sustained rate of requests.",
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The service whose requests are limited, e.g. `ec2` or `route53`. If not set, the limits apply to each service without its own.",
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  validAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use assume_role.duration instead",
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -2584,6 +2779,34 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandAssumeRoles(tfList []interface{}) ([]*awsbase.AssumeRole, error) {
+	var assumeRoles []*awsbase.AssumeRole
+
+	for i, tfMapRaw := range tfList {
+		// An empty block is still a hop in the chain.
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			tfMap = make(map[string]interface{})
+		}
+
+		duration, _ := tfMap["duration"].(string)
+		durationSeconds, _ := tfMap["duration_seconds"].(int)
+
+		if duration != "" && durationSeconds != 0 {
+			return nil, fmt.Errorf("assume_role.%d: only one of duration or duration_seconds can be specified", i)
+		}
+
+		if v, _ := tfMap["role_arn"].(string); v == "" && len(tfList) > 1 {
+			return nil, fmt.Errorf("assume_role.%d: role_arn must be specified when more than one assume_role is configured", i)
+		}
+
+		assumeRoles = append(assumeRoles, expandAssumeRole(tfMap))
+	}
+
+	return assumeRoles, nil
+}
+
 func expandAssumeRole(tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -2706,6 +2929,40 @@ func expandIgnoreTags(tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandAPIRateLimits(tfList []interface{}) (map[string]conns.APIRateLimit, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	apiRateLimits := make(map[string]conns.APIRateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+
+		if _, ok := apiRateLimits[service]; ok {
+			if service == conns.APIRateLimitDefaultService {
+				return nil, fmt.Errorf("duplicate api_rate_limit configuration without service")
+			}
+
+			return nil, fmt.Errorf("duplicate api_rate_limit configuration for service %q", service)
+		}
+
+		apiRateLimits[service] = conns.APIRateLimit{
+			Burst:                 tfMap["burst"].(int),
+			MaxConcurrentRequests: tfMap["max_concurrent_requests"].(int),
+			RequestsPerSecond:     tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	return apiRateLimits, nil
+}
+
 func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -2759,44 +3016,46 @@ func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	return endpoints, nil
 }
 
-func wrappedCreateContextFunc(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+func wrappedCreateContextFunc(f schema.CreateContextFunc, interceptors crudInterceptors, regional bool) schema.CreateContextFunc {
+	return wrappedHandler(f, interceptors, Create, regional)
+}
 
-		return f(ctx, d, meta)
-	}
+func wrappedReadContextFunc(f schema.ReadContextFunc, interceptors crudInterceptors, regional bool) schema.ReadContextFunc {
+	return wrappedHandler(f, interceptors, Read, regional)
 }
 
-func wrappedReadContextFunc(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+func wrappedUpdateContextFunc(f schema.UpdateContextFunc, interceptors crudInterceptors, regional bool) schema.UpdateContextFunc {
+	return wrappedHandler(f, interceptors, Update, regional)
+}
 
-		return f(ctx, d, meta)
-	}
+func wrappedDeleteContextFunc(f schema.DeleteContextFunc, interceptors crudInterceptors, regional bool) schema.DeleteContextFunc {
+	return wrappedHandler(f, interceptors, Delete, regional)
 }
 
-func wrappedUpdateContextFunc(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+// wrappedHandler returns a CRUD handler that runs the specified interceptors around the handler.
+// The handlers of regional resources are called with an AWSClient for the resource's Region.
+func wrappedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f F, interceptors crudInterceptors, why why, regional bool) F {
+	f = interceptedHandler(f, interceptors, why)
 
-		return f(ctx, d, meta)
+	if regional {
+		f = regionalHandler(f)
 	}
+
+	return f
 }
 
-func wrappedDeleteContextFunc(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func wrappedStateContextFunc(f schema.StateContextFunc, regional bool) schema.StateContextFunc {
+	wrapped := func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
 
 		return f(ctx, d, meta)
 	}
-}
 
-func wrappedStateContextFunc(f schema.StateContextFunc) schema.StateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, d, meta)
+	if regional {
+		return regionalStateContextFunc(wrapped)
 	}
+
+	return wrapped
 }
 
 func wrappedCustomizeDiffFunc(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
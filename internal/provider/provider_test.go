@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -49,6 +50,71 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	tfList := []interface{}{
+		map[string]interface{}{
+			"duration":         "1h",
+			"duration_seconds": 0,
+			"external_id":      "hub",
+			"role_arn":         "arn:aws:iam::111111111111:role/hub",
+		},
+		map[string]interface{}{
+			"duration":         "",
+			"duration_seconds": 900,
+			"role_arn":         "arn:aws:iam::222222222222:role/workload",
+			"session_name":     "workload",
+		},
+	}
+
+	got, err := expandAssumeRoles(tfList)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(got), 2; got != want {
+		t.Fatalf("%d roles, want %d", got, want)
+	}
+
+	if got, want := got[0].ExternalID, "hub"; got != want {
+		t.Errorf("ExternalID = %q, want %q", got, want)
+	}
+
+	if got, want := got[0].Duration, time.Hour; got != want {
+		t.Errorf("Duration = %s, want %s", got, want)
+	}
+
+	if got, want := got[1].RoleARN, "arn:aws:iam::222222222222:role/workload"; got != want {
+		t.Errorf("RoleARN = %q, want %q", got, want)
+	}
+
+	if got, want := got[1].Duration, 15*time.Minute; got != want {
+		t.Errorf("Duration = %s, want %s", got, want)
+	}
+
+	tfList[0].(map[string]interface{})["duration_seconds"] = 3600
+
+	if _, err := expandAssumeRoles(tfList); err == nil {
+		t.Error("expected error for conflicting durations")
+	}
+
+	tfList[0].(map[string]interface{})["duration_seconds"] = 0
+
+	if _, err := expandAssumeRoles([]interface{}{nil, tfList[0]}); err == nil || !strings.Contains(err.Error(), "assume_role.0:") {
+		t.Errorf("expected error for empty assume_role.0, got %v", err)
+	}
+
+	if _, err := expandAssumeRoles([]interface{}{tfList[0], map[string]interface{}{"duration": "", "duration_seconds": 0, "role_arn": ""}}); err == nil || !strings.Contains(err.Error(), "assume_role.1:") {
+		t.Errorf("expected error for empty assume_role.1 role_arn, got %v", err)
+	}
+
+	if got, err := expandAssumeRoles([]interface{}{nil}); err != nil || len(got) != 1 || got[0].RoleARN != "" {
+		t.Errorf("expected single empty assume_role to be allowed, got %v, %v", got, err)
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	testcases := []struct {
 		endpoints        map[string]string
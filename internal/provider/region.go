@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegional returns whether the `region` argument is added to a service package's resource or data source.
+// Resources in the provider's ResourcesMap that are not registered by a service package never have the argument added.
+func isRegional(sp intf.ServicePackage, r *schema.Resource) bool {
+	if names.IsGlobalService(sp.ServicePackageName()) {
+		return false
+	}
+
+	// The resource already has a `region` attribute.
+	if _, ok := r.Schema["region"]; ok {
+		return false
+	}
+
+	// Only the provider's wrapped handlers can be called with a regional AWSClient.
+	return r.ReadWithoutTimeout != nil
+}
+
+// regionSchema returns the schema of the `region` argument added to regional resources and data sources.
+func regionSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionalHandler returns a CRUD handler that calls the handler with an AWSClient for the resource's Region.
+func regionalHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f F) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		client, err := meta.(*conns.AWSClient).RegionalClient(d.Get("region").(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		diags = append(diags, f(ctx, d, client)...)
+
+		// The resource has been deleted.
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if err := d.Set("region", client.Region); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting region: %s", err)
+		}
+
+		return diags
+	}
+}
+
+// regionalStateContextFunc returns an import handler that accepts import IDs with a Region suffix
+// and calls the handler with an AWSClient for that Region.
+func regionalStateContextFunc(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		id, region := conns.SplitRegionalImportID(d.Id())
+
+		client, err := meta.(*conns.AWSClient).RegionalClient(region)
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		if err := d.Set("region", client.Region); err != nil {
+			return nil, err
+		}
+
+		return f(ctx, d, client)
+	}
+}
+
+// regionalCustomizeDiffFunc returns a CustomizeDiff function that defaults the resource's Region
+// to the provider's Region and calls the function with an AWSClient for the resource's Region.
+func regionalCustomizeDiffFunc(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		client := meta.(*conns.AWSClient)
+
+		if err := setRegionDiff(d, client.Region); err != nil {
+			return err
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		client, err := client.RegionalClient(d.Get("region").(string))
+
+		if err != nil {
+			return err
+		}
+
+		return f(ctx, d, client)
+	}
+}
+
+// setRegionDiff plans the provider's Region as the resource's Region if no Region is configured.
+func setRegionDiff(d *schema.ResourceDiff, providerRegion string) error {
+	if config := d.GetRawConfig(); config.IsNull() || !config.IsKnown() || !config.GetAttr("region").IsNull() {
+		return nil
+	}
+
+	// Don't replace existing resources whose state predates the `region` argument.
+	if o, _ := d.GetChange("region"); d.Id() != "" && o.(string) == "" {
+		return nil
+	}
+
+	if d.Get("region").(string) == providerRegion {
+		return nil
+	}
+
+	return d.SetNew("region", providerRegion)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestRegionalStateContextFunc(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID   string
+		wantID     string
+		wantRegion string
+		wantError  bool
+	}{
+		"no Region": {
+			importID:   "example",
+			wantID:     "example",
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"provider Region": {
+			importID:   "example@us-west-2", //lintignore:AWSAT003
+			wantID:     "example",
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"other Region, provider not configured": {
+			importID:  "example@eu-west-1", //lintignore:AWSAT003
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"region": regionSchema(true),
+				},
+			}
+			d := r.TestResourceData()
+			d.SetId(testCase.importID)
+
+			f := regionalStateContextFunc(schema.ImportStatePassthroughContext)
+			_, err := f(context.Background(), d, &conns.AWSClient{Region: "us-west-2"}) //lintignore:AWSAT003
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if testCase.wantError {
+				return
+			}
+
+			if got, want := d.Id(), testCase.wantID; got != want {
+				t.Errorf("ID = %s, want %s", got, want)
+			}
+
+			if got, want := d.Get("region").(string), testCase.wantRegion; got != want {
+				t.Errorf("region = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	requiredTagsEnforcementError = "error"
+	requiredTagsEnforcementWarn  = "warn"
+)
+
+func requiredTagsEnforcement_Values() []string {
+	return []string{
+		requiredTagsEnforcementError,
+		requiredTagsEnforcementWarn,
+	}
+}
+
+func requiredTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with tags that resources are required to have.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enforcement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Whether a resource without the tag causes a plan `error` (the default) or `warn`ing.",
+					ValidateFunc: validation.StringInSlice(requiredTagsEnforcement_Values(), false),
+				},
+				"exclude_resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Resource types that don't require the tag. A `*` matches any sequence of characters.",
+				},
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The required tag key.",
+				},
+				"resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Resource types that require the tag. A `*` matches any sequence of characters. If not set, all resource types that support tags require the tag.",
+				},
+				"value_regex": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "A regular expression that the tag's value must match.",
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+			},
+		},
+	}
+}
+
+func expandRequiredTags(tfList []interface{}) (*tftags.RequiredConfig, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		requiredTag := tftags.RequiredTag{
+			Key:  tfMap["key"].(string),
+			Warn: tfMap["enforcement"].(string) == requiredTagsEnforcementWarn,
+		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				requiredTag.ExcludeResourceTypes = append(requiredTag.ExcludeResourceTypes, tftags.WildcardRegexp(v))
+			}
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				requiredTag.ResourceTypes = append(requiredTag.ResourceTypes, tftags.WildcardRegexp(v))
+			}
+		}
+
+		if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+			re, err := tftags.AnchoredRegexp(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("compiling required_tags value_regex for tag %q: %w", requiredTag.Key, err)
+			}
+
+			requiredTag.ValueRegex = re
+		}
+
+		requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+	}
+
+	return requiredConfig, nil
+}
+
+// resourceTypeCustomizeDiffFunc returns a CustomizeDiff function that passes the resource's type name in Context.
+func resourceTypeCustomizeDiffFunc(f schema.CustomizeDiffFunc, typeName string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		return f(conns.NewResourceContext(ctx, typeName), d, meta)
+	}
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func defaultTimeoutsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with default operation timeouts for a resource type. A timeout set in a resource's `timeouts` configuration block takes precedence.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"create": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default timeout for create operations, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"delete": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default timeout for delete operations, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"read": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default timeout for read operations, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"resource_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The resource type name, e.g. `aws_db_instance`.",
+				},
+				"update": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default timeout for update operations, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTimeouts(tfList []interface{}) (map[string]conns.ResourceTimeouts, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	defaultTimeouts := make(map[string]conns.ResourceTimeouts)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		typeName := tfMap["resource_type"].(string)
+
+		if _, ok := defaultTimeouts[typeName]; ok {
+			return nil, fmt.Errorf("duplicate default_timeouts configuration for resource type %q", typeName)
+		}
+
+		var timeouts conns.ResourceTimeouts
+
+		for k, v := range map[string]*time.Duration{
+			schema.TimeoutCreate: &timeouts.Create,
+			schema.TimeoutDelete: &timeouts.Delete,
+			schema.TimeoutRead:   &timeouts.Read,
+			schema.TimeoutUpdate: &timeouts.Update,
+		} {
+			if s := tfMap[k].(string); s != "" {
+				d, err := time.ParseDuration(s)
+
+				if err != nil {
+					return nil, fmt.Errorf("parsing default_timeouts %s timeout for resource type %q: %w", k, typeName, err)
+				}
+
+				*v = d
+			}
+		}
+
+		defaultTimeouts[typeName] = timeouts
+	}
+
+	return defaultTimeouts, nil
+}
+
+// checkDefaultTimeouts warns about default_timeouts configuration for SDK resource types that don't support timeouts.
+func checkDefaultTimeouts(provider *schema.Provider, defaultTimeouts map[string]conns.ResourceTimeouts) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for typeName := range defaultTimeouts {
+		r, ok := provider.ResourcesMap[typeName]
+
+		if !ok {
+			// Plugin Framework resources apply their default timeouts when configured.
+			continue
+		}
+
+		if r.Timeouts == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Resource type does not support timeouts",
+				Detail:   fmt.Sprintf("The default_timeouts configuration for resource type %q has no effect.", typeName),
+			})
+		}
+	}
+
+	return diags
+}
+
+// defaultTimeoutsProviderServer wraps the primary (Plugin SDK) provider server and applies the provider's
+// default_timeouts configuration to planned resource changes.
+// The SDK resolves a resource's timeouts from its configuration, starting from the resource's defaults, when planning
+// and passes them to later operations in the planned change's private data.
+// Overriding them there, rather than the resource's defaults, keeps each provider configuration's defaults to itself.
+type defaultTimeoutsProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s defaultTimeoutsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || len(response.PlannedPrivate) == 0 || request.Config == nil {
+		return response, err
+	}
+
+	client, ok := s.provider.Meta().(*conns.AWSClient)
+
+	if !ok {
+		return response, nil
+	}
+
+	timeouts, ok := client.ResourceTimeouts(request.TypeName)
+
+	if !ok {
+		return response, nil
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+
+	if !ok || r.Timeouts == nil {
+		return response, nil
+	}
+
+	config, err := ctyValue(request.Config, r.CoreConfigSchema().ImpliedType())
+
+	if err != nil {
+		return nil, err
+	}
+
+	private, err := applyDefaultTimeouts(response.PlannedPrivate, config, timeouts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.PlannedPrivate = private
+
+	return response, nil
+}
+
+// applyDefaultTimeouts sets the default timeouts in a planned resource change's private data.
+// Only the operations for which the resource declares a timeout, and whose timeout isn't set in the resource's
+// `timeouts` configuration block, are overridden.
+func applyDefaultTimeouts(private []byte, config cty.Value, timeouts conns.ResourceTimeouts) ([]byte, error) {
+	// The resource has been removed from configuration.
+	if config.IsNull() {
+		return private, nil
+	}
+
+	configured := make(map[string]bool)
+
+	if v := config.GetAttr(schema.TimeoutsConfigKey); !v.IsNull() && v.IsKnown() {
+		for name := range v.Type().AttributeTypes() {
+			configured[name] = !v.GetAttr(name).IsNull()
+		}
+	}
+
+	if configured[schema.TimeoutDefault] {
+		return private, nil
+	}
+
+	var m map[string]interface{}
+
+	if err := json.Unmarshal(private, &m); err != nil {
+		return nil, err
+	}
+
+	v, ok := m[schema.TimeoutKey].(map[string]interface{})
+
+	if !ok {
+		return private, nil
+	}
+
+	for k, timeout := range map[string]time.Duration{
+		schema.TimeoutCreate: timeouts.Create,
+		schema.TimeoutRead:   timeouts.Read,
+		schema.TimeoutUpdate: timeouts.Update,
+		schema.TimeoutDelete: timeouts.Delete,
+	} {
+		if _, ok := v[k]; ok && timeout > 0 && !configured[k] {
+			v[k] = timeout.Nanoseconds()
+		}
+	}
+
+	return json.Marshal(m)
+}
+
+// ctyValue decodes a terraform-plugin-go dynamic value into a cty value of the specified type.
+func ctyValue(dv *tfprotov5.DynamicValue, ty cty.Type) (cty.Value, error) {
+	if len(dv.MsgPack) > 0 {
+		return ctymsgpack.Unmarshal(dv.MsgPack, ty)
+	}
+
+	return ctyjson.Unmarshal(dv.JSON, ty)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestCheckDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": {
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(10 * time.Minute),
+				},
+			},
+			"aws_test_no_timeouts": {},
+		},
+	}
+
+	diags := checkDefaultTimeouts(provider, map[string]conns.ResourceTimeouts{
+		"aws_test":             {Create: 90 * time.Minute},
+		"aws_test_framework":   {Create: time.Hour},
+		"aws_test_no_timeouts": {Create: time.Hour},
+	})
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("%d diagnostics, want %d", got, want)
+	}
+
+	if timeouts := provider.ResourcesMap["aws_test"].Timeouts; *timeouts.Create != 10*time.Minute {
+		t.Errorf("resource's default Create timeout modified: %s", *timeouts.Create)
+	}
+}
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	timeoutsType := cty.Object(map[string]cty.Type{
+		"create": cty.String,
+		"delete": cty.String,
+	})
+	configType := cty.Object(map[string]cty.Type{
+		"name":     cty.String,
+		"timeouts": timeoutsType,
+	})
+	// The resource declares create and delete timeouts, both 10 minutes.
+	private := []byte(`{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":600000000000,"delete":600000000000},"schema_version":"0"}`)
+	defaults := conns.ResourceTimeouts{
+		Create: 90 * time.Minute,
+		Update: 90 * time.Minute,
+		Delete: 30 * time.Minute,
+	}
+
+	testCases := map[string]struct {
+		config cty.Value
+		want   map[string]time.Duration
+	}{
+		"no timeouts": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("test"),
+				"timeouts": cty.NullVal(timeoutsType),
+			}),
+			want: map[string]time.Duration{"create": 90 * time.Minute, "delete": 30 * time.Minute},
+		},
+		"configured create timeout": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("test"),
+				"timeouts": cty.ObjectVal(map[string]cty.Value{
+					"create": cty.StringVal("5m"),
+					"delete": cty.NullVal(cty.String),
+				}),
+			}),
+			// The configured timeout has already been applied by the SDK.
+			want: map[string]time.Duration{"create": 10 * time.Minute, "delete": 30 * time.Minute},
+		},
+		"removed from configuration": {
+			config: cty.NullVal(configType),
+			want:   map[string]time.Duration{"create": 10 * time.Minute, "delete": 10 * time.Minute},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := applyDefaultTimeouts(private, testCase.config, defaults)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var m map[string]interface{}
+			if err := json.Unmarshal(output, &m); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := make(map[string]time.Duration)
+			for k, v := range m[schema.TimeoutKey].(map[string]interface{}) {
+				got[k] = time.Duration(v.(float64))
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	tfList := []interface{}{
+		map[string]interface{}{
+			"resource_type": "aws_db_instance",
+			"create":        "90m",
+			"read":          "",
+			"update":        "2h",
+			"delete":        "",
+		},
+	}
+
+	got, err := expandDefaultTimeouts(tfList)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := (conns.ResourceTimeouts{Create: 90 * time.Minute, Update: 2 * time.Hour}); got["aws_db_instance"] != want {
+		t.Errorf("got %v, want %v", got["aws_db_instance"], want)
+	}
+
+	if _, err := expandDefaultTimeouts(append(tfList, tfList[0])); err == nil {
+		t.Error("expected error for duplicate resource type")
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// planWarningsProviderServer wraps the primary (Plugin SDK) provider server and returns any warnings
+// recorded while planning a resource change (see conns.AddPlanWarning) as plan diagnostics.
+type planWarningsProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s planWarningsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, warnings := conns.NewPlanWarningsContext(ctx)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	for _, v := range warnings() {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityWarning,
+			Summary:   v.Summary,
+			Detail:    v.Detail,
+			Attribute: attributePath(v.AttributePath),
+		})
+	}
+
+	return response, nil
+}
+
+// attributePath converts a Plugin SDK diagnostic's attribute path to a terraform-plugin-go attribute path.
+func attributePath(path cty.Path) *tftypes.AttributePath {
+	if len(path) == 0 {
+		return nil
+	}
+
+	attributePath := tftypes.NewAttributePath()
+
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			attributePath = attributePath.WithAttributeName(step.Name)
+		case cty.IndexStep:
+			switch step.Key.Type() {
+			case cty.String:
+				attributePath = attributePath.WithElementKeyString(step.Key.AsString())
+			case cty.Number:
+				v, _ := step.Key.AsBigFloat().Int64()
+				attributePath = attributePath.WithElementKeyInt(int(v))
+			default:
+				return attributePath
+			}
+		default:
+			return attributePath
+		}
+	}
+
+	return attributePath
+}
@@ -41,12 +41,13 @@ func init() {
 }
 
 func sweepCertificates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_acm_certificate")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ACMConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListCertificatesPagesWithContext(ctx, &acm.ListCertificatesInput{}, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
@@ -83,16 +84,11 @@ func sweepCertificates(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting ACM certificate: %s", arn)
-			_, err = conn.DeleteCertificateWithContext(ctx, &acm.DeleteCertificateInput{
-				CertificateArn: aws.String(arn),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting ACM certificate (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceCertificate()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(output.Certificate.DomainName)), sweep.WithCreationTime(aws.TimeValue(output.Certificate.CreatedAt))))
 		}
 
 		return !lastPage
@@ -103,7 +99,11 @@ func sweepCertificates(region string) error {
 			log.Printf("[WARN] Skipping ACM certificate sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
-		return fmt.Errorf("error retrieving ACM certificates: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving ACM certificates: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping ACM certificates: %w", err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepCertificateAuthorities(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_acmpca_certificate_authority")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
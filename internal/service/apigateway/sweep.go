@@ -6,11 +6,9 @@ package apigateway
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -53,50 +51,49 @@ func init() {
 }
 
 func sweepRestAPIs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_api_gateway_rest_api")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).APIGatewayConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
 
 	err = conn.GetRestApisPagesWithContext(ctx, &apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, item := range page.Items {
-			input := &apigateway.DeleteRestApiInput{
-				RestApiId: item.Id,
-			}
-			log.Printf("[INFO] Deleting API Gateway REST API: %s", input)
-			// TooManyRequestsException: Too Many Requests can take over a minute to resolve itself
-			err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteRestApiWithContext(ctx, input)
-				if err != nil {
-					if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeTooManyRequestsException) {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete API Gateway REST API %s: %s", *item.Name, err)
-				continue
-			}
+			r := ResourceRestAPI()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(item.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(item.Name)), sweep.WithTags(aws.StringValueMap(item.Tags)), sweep.WithCreationTime(aws.TimeValue(item.CreatedDate))))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping API Gateway REST API sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("retrieving API Gateway REST APIs: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("retrieving API Gateway REST APIs for %s: %w", region, err))
 	}
 
-	return nil
+	if err = sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway REST APIs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping API Gateway REST API sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_api_gateway_vpc_link")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -134,7 +131,7 @@ func sweepVPCLinks(region string) error {
 }
 
 func sweepClientCertificates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_api_gateway_client_certificate")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -177,7 +174,7 @@ func sweepClientCertificates(region string) error {
 }
 
 func sweepUsagePlans(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_api_gateway_usage_plan")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -225,7 +222,7 @@ func sweepUsagePlans(region string) error {
 }
 
 func sweepAPIKeys(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_api_gateway_api_key")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -272,7 +269,7 @@ func sweepAPIKeys(region string) error {
 }
 
 func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_api_gateway_domain_name")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -35,7 +35,7 @@ func init() {
 }
 
 func sweepAPIs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_apigatewayv2_api")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -79,7 +79,7 @@ func sweepAPIs(region string) error {
 }
 
 func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_apigatewayv2_domain_name")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -122,7 +122,7 @@ func sweepDomainNames(region string) error {
 }
 
 func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_apigatewayv2_vpc_link")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -51,7 +51,7 @@ func init() {
 }
 
 func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appconfig_application")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -104,7 +104,7 @@ func sweepApplications(region string) error {
 }
 
 func sweepConfigurationProfiles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appconfig_configuration_profile")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -181,7 +181,7 @@ func sweepConfigurationProfiles(region string) error {
 }
 
 func sweepDeploymentStrategies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appconfig_deployment_strategy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -240,7 +240,7 @@ func sweepDeploymentStrategies(region string) error {
 }
 
 func sweepEnvironments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appconfig_environment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -317,7 +317,7 @@ func sweepEnvironments(region string) error {
 }
 
 func sweepHostedConfigurationVersions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appconfig_hosted_configuration_version")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
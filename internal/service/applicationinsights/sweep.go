@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_applicationinsights_application")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -65,7 +65,7 @@ func init() {
 }
 
 func sweepMeshes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appmesh_mesh")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -109,7 +109,7 @@ func sweepMeshes(region string) error {
 }
 
 func sweepVirtualGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appmesh_virtual_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -184,7 +184,7 @@ func sweepVirtualGateways(region string) error {
 }
 
 func sweepVirtualNodes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appmesh_virtual_node")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -255,7 +255,7 @@ func sweepVirtualNodes(region string) error {
 }
 
 func sweepVirtualRouters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appmesh_virtual_router")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -326,7 +326,7 @@ func sweepVirtualRouters(region string) error {
 }
 
 func sweepVirtualServices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appmesh_virtual_service")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -397,7 +397,7 @@ func sweepVirtualServices(region string) error {
 }
 
 func sweepGatewayRoutes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appmesh_gateway_route")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -493,7 +493,7 @@ func sweepGatewayRoutes(region string) error {
 }
 
 func sweepRoutes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appmesh_route")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
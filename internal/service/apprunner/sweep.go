@@ -35,7 +35,7 @@ func init() {
 }
 
 func sweepAutoScalingConfigurationVersions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_apprunner_auto_scaling_configuration_version")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -101,7 +101,7 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 }
 
 func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_apprunner_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -162,7 +162,7 @@ func sweepConnections(region string) error {
 }
 
 func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_apprunner_service")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -37,7 +37,7 @@ func init() {
 }
 
 func sweepDirectoryConfigs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appstream_directory_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -81,7 +81,7 @@ func sweepDirectoryConfigs(region string) error {
 }
 
 func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appstream_fleet")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -125,7 +125,7 @@ func sweepFleets(region string) error {
 }
 
 func sweepImageBuilders(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appstream_image_builder")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -169,7 +169,7 @@ func sweepImageBuilders(region string) error {
 }
 
 func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appstream_stack")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
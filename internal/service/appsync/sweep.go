@@ -36,7 +36,7 @@ func init() {
 }
 
 func sweepGraphQLAPIs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appsync_graphql_api")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -92,7 +92,7 @@ func sweepGraphQLAPIs(region string) error {
 }
 
 func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appsync_domain_name")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -148,7 +148,7 @@ func sweepDomainNames(region string) error {
 }
 
 func sweepDomainNameAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_appsync_domain_name_api_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
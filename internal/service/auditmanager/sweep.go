@@ -52,7 +52,7 @@ func isCompleteSetupError(err error) bool {
 }
 
 func sweepAssessments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_auditmanager_assessment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -95,7 +95,7 @@ func sweepAssessments(region string) error {
 }
 
 func sweepControls(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_auditmanager_control")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -138,7 +138,7 @@ func sweepControls(region string) error {
 }
 
 func sweepFrameworks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_auditmanager_framework")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
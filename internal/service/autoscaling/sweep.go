@@ -28,7 +28,7 @@ func init() {
 }
 
 func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_autoscaling_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -73,7 +73,7 @@ func sweepGroups(region string) error {
 }
 
 func sweepLaunchConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_launch_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepScalingPlans(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_autoscalingplans_scaling_plan")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -53,7 +53,7 @@ func init() {
 }
 
 func sweepFramework(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_backup_framework")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -96,7 +96,7 @@ func sweepFramework(region string) error {
 }
 
 func sweepReportPlan(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_backup_report_plan")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -139,7 +139,7 @@ func sweepReportPlan(region string) error {
 }
 
 func sweepVaultLockConfiguration(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_backup_vault_lock_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -189,7 +189,7 @@ func sweepVaultLockConfiguration(region string) error {
 }
 
 func sweepVaultNotifications(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_backup_vault_notifications")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -239,7 +239,7 @@ func sweepVaultNotifications(region string) error {
 }
 
 func sweepVaultPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_backup_vault_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -282,7 +282,7 @@ func sweepVaultPolicies(region string) error {
 }
 
 func sweepVaults(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_backup_vault")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
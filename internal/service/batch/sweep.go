@@ -50,7 +50,7 @@ func init() {
 }
 
 func sweepComputeEnvironments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_batch_compute_environment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -168,7 +168,7 @@ func sweepComputeEnvironments(region string) error {
 }
 
 func sweepJobDefinitions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_batch_job_definition")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -214,7 +214,7 @@ func sweepJobDefinitions(region string) error {
 }
 
 func sweepJobQueues(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_batch_job_queue")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -259,7 +259,7 @@ func sweepJobQueues(region string) error {
 }
 
 func sweepSchedulingPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_batch_scheduling_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
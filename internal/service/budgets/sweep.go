@@ -31,7 +31,7 @@ func init() {
 }
 
 func sweepBudgetActions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_budgets_budget_action")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -78,7 +78,7 @@ func sweepBudgetActions(region string) error {
 }
 
 func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_budgets_budget")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
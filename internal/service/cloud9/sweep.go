@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepEnvironmentEC2s(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloud9_environment_ec2")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -0,0 +1,162 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/jmespath/go-jmespath"
+)
+
+// resourceFilter is a client-side filter on a resource's decoded properties.
+// If values is empty, the resource matches if the JMESPath expression's result is truthy.
+// Otherwise the resource matches if the expression's result equals any of the values.
+type resourceFilter struct {
+	expression string
+	values     []string
+}
+
+func expandResourceFilters(tfList []interface{}) []resourceFilter {
+	var filters []resourceFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filters = append(filters, resourceFilter{
+			expression: tfMap["name"].(string),
+			values:     flex.ExpandStringValueSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return filters
+}
+
+// matchResourceFilters returns whether the specified resource properties JSON matches all the filters.
+func matchResourceFilters(properties string, filters []resourceFilter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(properties), &data); err != nil {
+		return false, fmt.Errorf("decoding properties: %w", err)
+	}
+
+	for _, filter := range filters {
+		result, err := jmespath.Search(filter.expression, data)
+
+		if err != nil {
+			return false, fmt.Errorf("evaluating %q: %w", filter.expression, err)
+		}
+
+		if len(filter.values) == 0 {
+			if !jmespathTruthy(result) {
+				return false, nil
+			}
+
+			continue
+		}
+
+		v, ok := jmespathString(result)
+
+		if !ok {
+			return false, nil
+		}
+
+		matched := false
+		for _, value := range filter.values {
+			if v == value {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// missingFilterProperties returns whether any of the filters selects no value from the specified resource properties JSON.
+// Resource types' list handlers often return only the properties that identify a resource,
+// so such a resource's full properties must be read before it can be filtered.
+func missingFilterProperties(properties string, filters []resourceFilter) (bool, error) {
+	if len(filters) == 0 {
+		return false, nil
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(properties), &data); err != nil {
+		return false, fmt.Errorf("decoding properties: %w", err)
+	}
+
+	for _, filter := range filters {
+		result, err := jmespath.Search(filter.expression, data)
+
+		if err != nil {
+			return false, fmt.Errorf("evaluating %q: %w", filter.expression, err)
+		}
+
+		if result == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// jmespathTruthy returns whether a JMESPath result is truthy.
+// false, null and empty strings, arrays and objects are falsy.
+func jmespathTruthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+
+	return true
+}
+
+// jmespathString returns the string representation of a scalar JMESPath result.
+func jmespathString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	}
+
+	return "", false
+}
+
+func validJMESPath(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := jmespath.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JMESPath expression: %w", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,107 @@
+package cloudcontrol
+
+import (
+	"testing"
+)
+
+func TestMatchResourceFilters(t *testing.T) {
+	t.Parallel()
+
+	properties := `{"LogGroupName":"example","RetentionInDays":7,"Tags":[{"Key":"Environment","Value":"production"}]}`
+
+	testCases := map[string]struct {
+		filters []resourceFilter
+		want    bool
+	}{
+		"no filters": {
+			want: true,
+		},
+		"equality match": {
+			filters: []resourceFilter{{expression: "LogGroupName", values: []string{"other", "example"}}},
+			want:    true,
+		},
+		"equality no match": {
+			filters: []resourceFilter{{expression: "LogGroupName", values: []string{"other"}}},
+		},
+		"equality number": {
+			filters: []resourceFilter{{expression: "RetentionInDays", values: []string{"7"}}},
+			want:    true,
+		},
+		"equality missing property": {
+			filters: []resourceFilter{{expression: "KmsKeyId", values: []string{""}}},
+		},
+		"jmespath match": {
+			filters: []resourceFilter{{expression: "Tags[?Key=='Environment' && Value=='production']"}},
+			want:    true,
+		},
+		"jmespath no match": {
+			filters: []resourceFilter{{expression: "RetentionInDays > `30`"}},
+		},
+		"all filters must match": {
+			filters: []resourceFilter{
+				{expression: "LogGroupName", values: []string{"example"}},
+				{expression: "RetentionInDays > `30`"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := matchResourceFilters(properties, testCase.filters)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMissingFilterProperties(t *testing.T) {
+	t.Parallel()
+
+	properties := `{"LogGroupName":"example"}`
+
+	testCases := map[string]struct {
+		filters []resourceFilter
+		want    bool
+	}{
+		"no filters": {},
+		"listed property": {
+			filters: []resourceFilter{{expression: "LogGroupName", values: []string{"other"}}},
+		},
+		"unlisted property": {
+			filters: []resourceFilter{{expression: "RetentionInDays", values: []string{"7"}}},
+			want:    true,
+		},
+		"jmespath unlisted property": {
+			filters: []resourceFilter{{expression: "Tags[?Key=='Environment']"}},
+			want:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := missingFilterProperties(properties, testCase.filters)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
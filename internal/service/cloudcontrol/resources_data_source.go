@@ -0,0 +1,213 @@
+package cloudcontrol
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validJMESPath,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"filter_jmespath": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validJMESPath,
+			},
+			"identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlClient()
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudcontrol.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("resource_model"); ok {
+		input.ResourceModel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_version_id"); ok {
+		input.TypeVersionId = aws.String(v.(string))
+	}
+
+	filters := expandResourceFilters(d.Get("filter").(*schema.Set).List())
+
+	if v, ok := d.GetOk("filter_jmespath"); ok {
+		filters = append(filters, resourceFilter{expression: v.(string)})
+	}
+
+	resourceDescriptions, err := findResources(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("listing Cloud Control API (%s) Resources: %s", typeName, err)
+	}
+
+	var identifiers []string
+	var tfList []interface{}
+
+	for _, v := range resourceDescriptions {
+		properties := aws.ToString(v.Properties)
+
+		missing, err := missingFilterProperties(properties, filters)
+
+		if err != nil {
+			return diag.Errorf("filtering Cloud Control API (%s) Resource (%s): %s", typeName, aws.ToString(v.Identifier), err)
+		}
+
+		if missing {
+			resourceDescription, err := FindResource(ctx, conn, aws.ToString(v.Identifier), typeName, d.Get("type_version_id").(string), d.Get("role_arn").(string))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, aws.ToString(v.Identifier), err)
+			}
+
+			properties = aws.ToString(resourceDescription.Properties)
+		}
+
+		ok, err := matchResourceFilters(properties, filters)
+
+		if err != nil {
+			return diag.Errorf("filtering Cloud Control API (%s) Resource (%s): %s", typeName, aws.ToString(v.Identifier), err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		identifiers = append(identifiers, aws.ToString(v.Identifier))
+		tfList = append(tfList, map[string]interface{}{
+			"identifier": aws.ToString(v.Identifier),
+			"properties": properties,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(resourcesDataSourceID(d))))
+
+	d.Set("identifiers", identifiers)
+	if err := d.Set("resources", tfList); err != nil {
+		return diag.Errorf("setting resources: %s", err)
+	}
+
+	return nil
+}
+
+// resourcesDataSourceID returns a string identifying the resources selected by the data source's arguments.
+func resourcesDataSourceID(d *schema.ResourceData) string {
+	var buf bytes.Buffer
+
+	for _, k := range []string{"type_name", "type_version_id", "role_arn", "resource_model", "filter_jmespath"} {
+		buf.WriteString(fmt.Sprintf("%s=%s;", k, d.Get(k).(string)))
+	}
+
+	var filters []string
+	for _, filter := range expandResourceFilters(d.Get("filter").(*schema.Set).List()) {
+		sort.Strings(filter.values)
+		filters = append(filters, fmt.Sprintf("%s=%s", filter.expression, strings.Join(filter.values, ",")))
+	}
+	sort.Strings(filters)
+
+	buf.WriteString(fmt.Sprintf("filter=%s;", strings.Join(filters, ";")))
+
+	return buf.String()
+}
+
+func findResources(ctx context.Context, conn *cloudcontrol.Client, input *cloudcontrol.ListResourcesInput) ([]types.ResourceDescription, error) {
+	var output []types.ResourceDescription
+
+	pages := cloudcontrol.NewListResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceDescriptions...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,95 @@
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudControlResourcesDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identifiers.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.identifier", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.properties"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResourcesDataSource_filterJMESPath(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_filterJMESPath(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identifiers.0", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName    = %[1]q
+    RetentionInDays = 7
+  })
+}
+`, rName)
+}
+
+func testAccResourcesDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceConfig_base(rName), `
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  filter {
+    name   = "LogGroupName"
+    values = [aws_cloudcontrolapi_resource.test.id]
+  }
+}
+`)
+}
+
+func testAccResourcesDataSourceConfig_filterJMESPath(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceConfig_base(rName), `
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name       = aws_cloudcontrolapi_resource.test.type_name
+  filter_jmespath = "LogGroupName == '${aws_cloudcontrolapi_resource.test.id}' && RetentionInDays == `+"`7`"+`"
+}
+`)
+}
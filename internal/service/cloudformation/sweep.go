@@ -4,6 +4,7 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -39,7 +40,7 @@ func init() {
 }
 
 func sweepStackSetInstances(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudformation_stack_set_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -113,7 +114,7 @@ func sweepStackSetInstances(region string) error {
 }
 
 func sweepStackSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudformation_stack_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -159,7 +160,7 @@ func sweepStackSets(region string) error {
 }
 
 func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudformation_stack")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -175,40 +176,34 @@ func sweepStacks(region string) error {
 			cloudformation.StackStatusUpdateComplete,
 		}),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListStacksPagesWithContext(ctx, input, func(page *cloudformation.ListStacksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, stack := range page.StackSummaries {
 			name := aws.StringValue(stack.StackName)
+			r := ResourceStack()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(stack.StackId))
 
-			updateTerminationProtectionInput := &cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   stack.StackName,
-			}
-
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtectionWithContext(ctx, updateTerminationProtectionInput)
+			// Termination protection must be disabled before the stack can be deleted.
+			deleteStack := func(ctx context.Context) error {
+				input := &cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(false),
+					StackName:                   aws.String(name),
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error disabling termination protection for CloudFormation Stack (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if _, err := conn.UpdateTerminationProtectionWithContext(ctx, input); err != nil {
+					return fmt.Errorf("error disabling termination protection for CloudFormation Stack (%s): %w", name, err)
+				}
 
-			input := &cloudformation.DeleteStackInput{
-				StackName: stack.StackName,
+				return sweep.DeleteResource(ctx, r, d, client)
 			}
 
-			log.Printf("[INFO] Deleting CloudFormation Stack: %s", name)
-			_, err = conn.DeleteStackWithContext(ctx, input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudFormation Stack (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(d.Id(), deleteStack, sweep.WithName(name), sweep.WithCreationTime(aws.TimeValue(stack.CreationTime))))
 		}
 
 		return !lastPage
@@ -223,5 +218,11 @@ func sweepStacks(region string) error {
 		return fmt.Errorf("error listing CloudFormation Stacks: %s", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudFormation Stacks: %w", err)
+	}
+
+	return nil
 }
@@ -89,7 +89,7 @@ func init() {
 }
 
 func sweepCachePolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_cache_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -149,7 +149,7 @@ func sweepCachePolicies(region string) error {
 }
 
 func sweepDistributions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_distribution")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -207,7 +207,7 @@ func sweepDistributions(region string) error {
 }
 
 func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_function")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -265,12 +265,13 @@ func sweepFunctions(region string) error {
 }
 
 func sweepKeyGroup(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_key_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).CloudFrontConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	input := &cloudfront.ListKeyGroupsInput{}
@@ -286,33 +287,28 @@ func sweepKeyGroup(region string) error {
 			return sweeperErrs.ErrorOrNil()
 		}
 
-		if output == nil || output.KeyGroupList == nil || len(output.KeyGroupList.Items) == 0 {
-			log.Print("[DEBUG] No CloudFront key group to sweep")
-			return nil
+		if output == nil || output.KeyGroupList == nil {
+			break
 		}
 
 		for _, item := range output.KeyGroupList.Items {
-			id := item.KeyGroup.Id
+			id := aws.StringValue(item.KeyGroup.Id)
 			out, err := conn.GetKeyGroupWithContext(ctx, &cloudfront.GetKeyGroupInput{
-				Id: id,
+				Id: aws.String(id),
 			})
 			if err != nil {
-				sweeperErr := fmt.Errorf("error reading CloudFront key group %s: %w", aws.StringValue(id), err)
+				sweeperErr := fmt.Errorf("error reading CloudFront key group %s: %w", id, err)
 				log.Printf("[ERROR] %s", sweeperErr)
 				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
 				continue
 			}
 
-			_, err = conn.DeleteKeyGroupWithContext(ctx, &cloudfront.DeleteKeyGroupInput{
-				Id:      id,
-				IfMatch: out.ETag,
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error sweeping CloudFront key group %s: %w", aws.StringValue(id), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceKeyGroup()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("etag", out.ETag)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(item.KeyGroup.KeyGroupConfig.Name))))
 		}
 
 		if output.KeyGroupList.NextMarker == nil {
@@ -321,16 +317,21 @@ func sweepKeyGroup(region string) error {
 		input.Marker = output.KeyGroupList.NextMarker
 	}
 
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping CloudFront key groups: %w", err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepMonitoringSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_monitoring_subscription")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).CloudFrontConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
@@ -348,33 +349,36 @@ func sweepMonitoringSubscriptions(region string) error {
 		return fmt.Errorf("error listing CloudFront Distributions: %s", err)
 	}
 
-	if len(distributionSummaries) == 0 {
-		log.Print("[DEBUG] No CloudFront Distributions found")
-		return nil
-	}
-
 	for _, distributionSummary := range distributionSummaries {
+		id := aws.StringValue(distributionSummary.Id)
 
-		_, err := conn.GetMonitoringSubscriptionWithContext(ctx, &cloudfront.GetMonitoringSubscriptionInput{
-			DistributionId: distributionSummary.Id,
-		})
-		if err != nil {
-			return fmt.Errorf("error reading CloudFront Monitoring Subscription %s: %s", aws.StringValue(distributionSummary.Id), err)
+		_, err := FindMonitoringSubscriptionByDistributionID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			continue
 		}
 
-		_, err = conn.DeleteMonitoringSubscriptionWithContext(ctx, &cloudfront.DeleteMonitoringSubscriptionInput{
-			DistributionId: distributionSummary.Id,
-		})
 		if err != nil {
-			return fmt.Errorf("error deleting CloudFront Monitoring Subscription %s: %s", aws.StringValue(distributionSummary.Id), err)
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error reading CloudFront Monitoring Subscription %s: %w", id, err))
+			continue
 		}
+
+		r := ResourceMonitoringSubscription()
+		d := r.Data(nil)
+		d.SetId(id)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping CloudFront Monitoring Subscriptions: %w", err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepRealtimeLogsConfig(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_realtime_log_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -422,7 +426,7 @@ func sweepRealtimeLogsConfig(region string) error {
 }
 
 func sweepFieldLevelEncryptionConfigs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_field_level_encryption_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -480,7 +484,7 @@ func sweepFieldLevelEncryptionConfigs(region string) error {
 }
 
 func sweepFieldLevelEncryptionProfiles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_field_level_encryption_profile")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -538,7 +542,7 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 }
 
 func sweepOriginRequestPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_origin_request_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -598,7 +602,7 @@ func sweepOriginRequestPolicies(region string) error {
 }
 
 func sweepResponseHeadersPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_response_headers_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -658,7 +662,7 @@ func sweepResponseHeadersPolicies(region string) error {
 }
 
 func sweepOriginAccessControls(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudfront_origin_access_control")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
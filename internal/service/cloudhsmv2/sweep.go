@@ -28,7 +28,7 @@ func init() {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudhsm_v2_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -75,7 +75,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepHSMs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudhsm_v2_hsm")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -24,12 +23,13 @@ func init() {
 }
 
 func sweeps(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudtrail")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).CloudTrailConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListTrailsPagesWithContext(ctx, &cloudtrail.ListTrailsInput{}, func(page *cloudtrail.ListTrailsOutput, lastPage bool) bool {
@@ -65,19 +65,11 @@ func sweeps(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting CloudTrail: %s", name)
-			_, err = conn.DeleteTrailWithContext(ctx, &cloudtrail.DeleteTrailInput{
-				Name: aws.String(name),
-			})
-			if tfawserr.ErrCodeEquals(err, cloudtrail.ErrCodeTrailNotFoundException) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudTrail (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceCloudTrail()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
@@ -90,5 +82,9 @@ func sweeps(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudTrails: %w", err))
 	}
 
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping CloudTrails: %w", err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepCompositeAlarms(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_composite_alarm")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
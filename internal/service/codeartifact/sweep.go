@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -28,14 +27,14 @@ func init() {
 }
 
 func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_codeartifact_domain")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).CodeArtifactConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &codeartifact.ListDomainsInput{}
-	var sweeperErrs *multierror.Error
 
 	err = conn.ListDomainsPagesWithContext(ctx, input, func(page *codeartifact.ListDomainsOutput, lastPage bool) bool {
 		for _, domainPtr := range page.Domains {
@@ -43,20 +42,11 @@ func sweepDomains(region string) error {
 				continue
 			}
 
-			domain := aws.StringValue(domainPtr.Name)
-			input := &codeartifact.DeleteDomainInput{
-				Domain: domainPtr.Name,
-			}
-
-			log.Printf("[INFO] Deleting CodeArtifact Domain: %s", domain)
+			r := ResourceDomain()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(domainPtr.Arn))
 
-			_, err := conn.DeleteDomainWithContext(ctx, input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CodeArtifact Domain (%s): %w", domain, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(domainPtr.Name)), sweep.WithCreationTime(aws.TimeValue(domainPtr.CreatedTime))))
 		}
 
 		return !lastPage
@@ -71,18 +61,24 @@ func sweepDomains(region string) error {
 		return fmt.Errorf("error listing CodeArtifact Domains: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeArtifact Domains: %w", err)
+	}
+
+	return nil
 }
 
 func sweepRepositories(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_codeartifact_repository")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).CodeArtifactConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &codeartifact.ListRepositoriesInput{}
-	var sweeperErrs *multierror.Error
 
 	err = conn.ListRepositoriesPagesWithContext(ctx, input, func(page *codeartifact.ListRepositoriesOutput, lastPage bool) bool {
 		for _, repositoryPtr := range page.Repositories {
@@ -90,22 +86,11 @@ func sweepRepositories(region string) error {
 				continue
 			}
 
-			repository := aws.StringValue(repositoryPtr.Name)
-			input := &codeartifact.DeleteRepositoryInput{
-				Repository:  repositoryPtr.Name,
-				Domain:      repositoryPtr.DomainName,
-				DomainOwner: repositoryPtr.DomainOwner,
-			}
-
-			log.Printf("[INFO] Deleting CodeArtifact Repository: %s", repository)
+			r := ResourceRepository()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(repositoryPtr.Arn))
 
-			_, err := conn.DeleteRepositoryWithContext(ctx, input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CodeArtifact Repository (%s): %w", repository, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(repositoryPtr.Name))))
 		}
 
 		return !lastPage
@@ -120,5 +105,11 @@ func sweepRepositories(region string) error {
 		return fmt.Errorf("error listing CodeArtifact Repositories: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeArtifact Repositories: %w", err)
+	}
+
+	return nil
 }
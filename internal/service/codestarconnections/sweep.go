@@ -30,7 +30,7 @@ func init() {
 }
 
 func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_codestarconnections_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -74,7 +74,7 @@ func sweepConnections(region string) error {
 }
 
 func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_codestarconnections_host")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
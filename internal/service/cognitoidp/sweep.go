@@ -30,12 +30,13 @@ func init() {
 }
 
 func sweepUserPoolDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cognito_user_pool_domain")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).CognitoIDPConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(50),
@@ -56,16 +57,12 @@ func sweepUserPoolDomains(region string) error {
 				continue
 			}
 			if output.UserPool != nil && output.UserPool.Domain != nil {
-				domain := aws.StringValue(output.UserPool.Domain)
-
-				log.Printf("[INFO] Deleting Cognito user pool domain: %s", domain)
-				_, err := conn.DeleteUserPoolDomainWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolDomainInput{
-					Domain:     output.UserPool.Domain,
-					UserPoolId: u.Id,
-				})
-				if err != nil {
-					log.Printf("[ERROR] Failed deleting Cognito user pool domain (%s): %s", domain, err)
-				}
+				r := ResourceUserPoolDomain()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(output.UserPool.Domain))
+				d.Set("user_pool_id", u.Id)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(output.UserPool.Domain)), sweep.WithTags(aws.StringValueMap(output.UserPool.UserPoolTags)), sweep.WithCreationTime(aws.TimeValue(output.UserPool.CreationDate))))
 			}
 		}
 		return !lastPage
@@ -79,16 +76,23 @@ func sweepUserPoolDomains(region string) error {
 		return fmt.Errorf("Error retrieving Cognito User Pools: %s", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Cognito User Pool Domains: %w", err)
+	}
+
 	return nil
 }
 
 func sweepUserPools(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cognito_user_pool")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).CognitoIDPConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(50),
@@ -101,15 +105,11 @@ func sweepUserPools(region string) error {
 		}
 
 		for _, userPool := range resp.UserPools {
-			name := aws.StringValue(userPool.Name)
+			r := ResourceUserPool()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(userPool.Id))
 
-			log.Printf("[INFO] Deleting Cognito User Pool: %s", name)
-			_, err := conn.DeleteUserPoolWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolInput{
-				UserPoolId: userPool.Id,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Cognito User Pool (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(userPool.Name)), sweep.WithCreationTime(aws.TimeValue(userPool.CreationDate))))
 		}
 		return !lastPage
 	})
@@ -122,5 +122,11 @@ func sweepUserPools(region string) error {
 		return fmt.Errorf("Error retrieving Cognito User Pools: %w", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Cognito User Pools: %w", err)
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@
 package configservice
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -42,12 +43,13 @@ func init() {
 }
 
 func sweepAggregateAuthorizations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_config_aggregate_authorization")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	aggregateAuthorizations, err := DescribeAggregateAuthorizations(ctx, conn)
 	if err != nil {
@@ -66,26 +68,30 @@ func sweepAggregateAuthorizations(region string) error {
 	log.Printf("[INFO] Found %d config aggregate authorizations", len(aggregateAuthorizations))
 
 	for _, auth := range aggregateAuthorizations {
-		log.Printf("[INFO] Deleting config authorization %s", *auth.AggregationAuthorizationArn)
-		_, err := conn.DeleteAggregationAuthorizationWithContext(ctx, &configservice.DeleteAggregationAuthorizationInput{
-			AuthorizedAccountId: auth.AuthorizedAccountId,
-			AuthorizedAwsRegion: auth.AuthorizedAwsRegion,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting config aggregate authorization %s: %s", *auth.AggregationAuthorizationArn, err)
-		}
+		r := ResourceAggregateAuthorization()
+		d := r.Data(nil)
+		d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(auth.AuthorizedAccountId), aws.StringValue(auth.AuthorizedAwsRegion)))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithCreationTime(aws.TimeValue(auth.CreationTime))))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Config Aggregate Authorizations: %w", err)
 	}
 
 	return nil
 }
 
 func sweepConfigurationAggregators(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_config_configuration_aggregator")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	resp, err := conn.DescribeConfigurationAggregatorsWithContext(ctx, &configservice.DescribeConfigurationAggregatorsInput{})
 	if err != nil {
@@ -104,27 +110,30 @@ func sweepConfigurationAggregators(region string) error {
 	log.Printf("[INFO] Found %d config configuration aggregators", len(resp.ConfigurationAggregators))
 
 	for _, agg := range resp.ConfigurationAggregators {
-		log.Printf("[INFO] Deleting config configuration aggregator %s", *agg.ConfigurationAggregatorName)
-		_, err := conn.DeleteConfigurationAggregatorWithContext(ctx, &configservice.DeleteConfigurationAggregatorInput{
-			ConfigurationAggregatorName: agg.ConfigurationAggregatorName,
-		})
+		r := ResourceConfigurationAggregator()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(agg.ConfigurationAggregatorName))
 
-		if err != nil {
-			return fmt.Errorf("error deleting config configuration aggregator %s: %w",
-				aws.StringValue(agg.ConfigurationAggregatorName), err)
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(agg.ConfigurationAggregatorName)), sweep.WithCreationTime(aws.TimeValue(agg.CreationTime))))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Config Configuration Aggregators: %w", err)
 	}
 
 	return nil
 }
 
 func sweepConfigurationRecorder(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_config_configuration_recorder")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	req := &configservice.DescribeConfigurationRecordersInput{}
 	resp, err := conn.DescribeConfigurationRecordersWithContext(ctx, req)
@@ -142,33 +151,44 @@ func sweepConfigurationRecorder(region string) error {
 	}
 
 	for _, cr := range resp.ConfigurationRecorders {
-		_, err := conn.StopConfigurationRecorderWithContext(ctx, &configservice.StopConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return err
-		}
+		name := aws.StringValue(cr.Name)
+		r := ResourceConfigurationRecorder()
+		d := r.Data(nil)
+		d.SetId(name)
+
+		// The configuration recorder must be stopped before it can be deleted.
+		deleteRecorder := func(ctx context.Context) error {
+			_, err := conn.StopConfigurationRecorderWithContext(ctx, &configservice.StopConfigurationRecorderInput{
+				ConfigurationRecorderName: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("Error stopping Configuration Recorder (%s): %w", name, err)
+			}
 
-		_, err = conn.DeleteConfigurationRecorderWithContext(ctx, &configservice.DeleteConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Configuration Recorder (%s): %s",
-				*cr.Name, err)
+			return sweep.DeleteResource(ctx, r, d, client)
 		}
+
+		sweepResources = append(sweepResources, sweep.NewSweepFunc(name, deleteRecorder, sweep.WithName(name)))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Configuration Recorders: %w", err)
 	}
 
 	return nil
 }
 
 func sweepDeliveryChannels(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_config_delivery_channel")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	req := &configservice.DescribeDeliveryChannelsInput{}
 	var resp *configservice.DescribeDeliveryChannelsOutput
@@ -198,14 +218,17 @@ func sweepDeliveryChannels(region string) error {
 	}
 
 	for _, dc := range resp.DeliveryChannels {
-		_, err := conn.DeleteDeliveryChannelWithContext(ctx, &configservice.DeleteDeliveryChannelInput{
-			DeliveryChannelName: dc.Name,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Delivery Channel (%s): %s",
-				*dc.Name, err)
-		}
+		r := ResourceDeliveryChannel()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(dc.Name))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(dc.Name))))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Delivery Channels: %w", err)
 	}
 
 	return nil
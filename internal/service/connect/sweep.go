@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepInstance(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_connect_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepReportDefinitions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cur_report_definition")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
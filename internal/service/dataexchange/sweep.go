@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepDataSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dataexchange_data_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -10,7 +10,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -70,13 +69,16 @@ func init() {
 }
 
 func sweepAgents(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_agent")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn()
 
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
 	input := &datasync.ListAgentsInput{}
 	for {
 		output, err := conn.ListAgentsWithContext(ctx, input)
@@ -96,22 +98,11 @@ func sweepAgents(region string) error {
 		}
 
 		for _, agent := range output.Agents {
-			name := aws.StringValue(agent.Name)
-
-			log.Printf("[INFO] Deleting DataSync Agent: %s", name)
-			input := &datasync.DeleteAgentInput{
-				AgentArn: agent.AgentArn,
-			}
-
-			_, err := conn.DeleteAgentWithContext(ctx, input)
-
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "does not exist") {
-				continue
-			}
+			r := ResourceAgent()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(agent.AgentArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Agent (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(agent.Name))))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -121,17 +112,24 @@ func sweepAgents(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping DataSync Agents: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepLocationEFSs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_efs")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn()
 
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocationsWithContext(ctx, input)
@@ -156,20 +154,11 @@ func sweepLocationEFSs(region string) error {
 				log.Printf("[INFO] Skipping DataSync Location EFS: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location EFS: %s", uri)
-			input := &datasync.DeleteLocationInput{
-				LocationArn: location.LocationArn,
-			}
-
-			_, err := conn.DeleteLocationWithContext(ctx, input)
-
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+			r := ResourceLocationEFS()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(location.LocationArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location EFS (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -179,17 +168,24 @@ func sweepLocationEFSs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping DataSync Location EFSs: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepLocationFSxWindows(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_fsx_windows_file_system")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn()
 
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocationsWithContext(ctx, input)
@@ -214,20 +210,11 @@ func sweepLocationFSxWindows(region string) error {
 				log.Printf("[INFO] Skipping DataSync Location FSX Windows File System: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location FSX Windows File System: %s", uri)
-			input := &datasync.DeleteLocationInput{
-				LocationArn: location.LocationArn,
-			}
-
-			_, err := conn.DeleteLocationWithContext(ctx, input)
-
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+			r := ResourceLocationFSxWindowsFileSystem()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(location.LocationArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location FSX Windows (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -237,11 +224,15 @@ func sweepLocationFSxWindows(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping DataSync Location FSX Windows File Systems: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepLocationFSxLustres(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_fsx_lustre_file_system")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -298,7 +289,7 @@ func sweepLocationFSxLustres(region string) error {
 }
 
 func sweepLocationNFSs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_nfs")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -356,13 +347,16 @@ func sweepLocationNFSs(region string) error {
 }
 
 func sweepLocationS3s(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_s3")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn()
 
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocationsWithContext(ctx, input)
@@ -387,20 +381,11 @@ func sweepLocationS3s(region string) error {
 				log.Printf("[INFO] Skipping DataSync Location S3: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location S3: %s", uri)
-			input := &datasync.DeleteLocationInput{
-				LocationArn: location.LocationArn,
-			}
-
-			_, err := conn.DeleteLocationWithContext(ctx, input)
-
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+			r := ResourceLocationS3()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(location.LocationArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location S3 (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -410,11 +395,15 @@ func sweepLocationS3s(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping DataSync Location S3s: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepLocationSMBs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_smb")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -472,7 +461,7 @@ func sweepLocationSMBs(region string) error {
 }
 
 func sweepLocationHDFSs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_hdfs")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -530,13 +519,16 @@ func sweepLocationHDFSs(region string) error {
 }
 
 func sweepLocationObjectStorages(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_location_object_storage")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn()
 
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocationsWithContext(ctx, input)
@@ -561,20 +553,11 @@ func sweepLocationObjectStorages(region string) error {
 				log.Printf("[INFO] Skipping DataSync Location Object Storage: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location Object Storage: %s", uri)
-			input := &datasync.DeleteLocationInput{
-				LocationArn: location.LocationArn,
-			}
-
-			_, err := conn.DeleteLocationWithContext(ctx, input)
-
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+			r := ResourceLocationObjectStorage()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(location.LocationArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location Object Storage (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -584,17 +567,24 @@ func sweepLocationObjectStorages(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping DataSync Location Object Storages: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepTasks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_datasync_task")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn()
 
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
 	input := &datasync.ListTasksInput{}
 	for {
 		output, err := conn.ListTasksWithContext(ctx, input)
@@ -614,22 +604,11 @@ func sweepTasks(region string) error {
 		}
 
 		for _, task := range output.Tasks {
-			name := aws.StringValue(task.Name)
-
-			log.Printf("[INFO] Deleting DataSync Task: %s", name)
-			input := &datasync.DeleteTaskInput{
-				TaskArn: task.TaskArn,
-			}
-
-			_, err := conn.DeleteTaskWithContext(ctx, input)
-
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+			r := ResourceTask()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(task.TaskArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Task (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(task.Name))))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -639,5 +618,9 @@ func sweepTasks(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping DataSync Tasks: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
 }
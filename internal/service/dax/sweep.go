@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -22,12 +23,13 @@ func init() {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dax_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DAXConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	resp, err := conn.DescribeClustersWithContext(ctx, &dax.DescribeClustersInput{})
 	if err != nil {
@@ -48,13 +50,17 @@ func sweepClusters(region string) error {
 	log.Printf("[INFO] Found %d DAX clusters", len(resp.Clusters))
 
 	for _, cluster := range resp.Clusters {
-		log.Printf("[INFO] Deleting DAX cluster %s", *cluster.ClusterName)
-		_, err := conn.DeleteClusterWithContext(ctx, &dax.DeleteClusterInput{
-			ClusterName: cluster.ClusterName,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting DAX cluster %s: %s", *cluster.ClusterName, err)
-		}
+		r := ResourceCluster()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(cluster.ClusterName))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DAX clusters: %w", err)
 	}
 
 	return nil
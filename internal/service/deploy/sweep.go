@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_codedeploy_app")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
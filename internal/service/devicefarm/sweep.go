@@ -28,7 +28,7 @@ func init() {
 }
 
 func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_devicefarm_project")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -83,7 +83,7 @@ func sweepProjects(region string) error {
 }
 
 func sweepTestGridProjects(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_devicefarm_test_grid_project")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
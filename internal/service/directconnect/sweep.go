@@ -4,6 +4,7 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -58,7 +59,7 @@ func init() {
 }
 
 func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dx_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -113,7 +114,7 @@ func sweepConnections(region string) error {
 }
 
 func sweepGatewayAssociationProposals(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dx_gateway_association_proposal")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -170,7 +171,7 @@ func sweepGatewayAssociationProposals(region string) error {
 }
 
 func sweepGatewayAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dx_gateway_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -307,7 +308,7 @@ func sweepGatewayAssociations(region string) error {
 }
 
 func sweepGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dx_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -392,7 +393,7 @@ func sweepGateways(region string) error {
 }
 
 func sweepLags(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dx_lag")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -447,7 +448,7 @@ func sweepLags(region string) error {
 }
 
 func sweepMacSecKeys(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dx_macsec_key")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -462,6 +463,7 @@ func sweepMacSecKeys(region string) error {
 	// the MACsec key secret.
 	smConn := client.(*conns.AWSClient).SecretsManagerConn()
 	dxInput := &directconnect.DescribeConnectionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	output, err := dxConn.DescribeConnectionsWithContext(ctx, dxInput)
@@ -486,21 +488,25 @@ func sweepMacSecKeys(region string) error {
 		for _, key := range connection.MacSecKeys {
 			arn := aws.StringValue(key.SecretARN)
 
-			input := &secretsmanager.DeleteSecretInput{
-				SecretId: aws.String(arn),
-			}
+			deleteSecret := func(ctx context.Context) error {
+				input := &secretsmanager.DeleteSecretInput{
+					SecretId: aws.String(arn),
+				}
 
-			log.Printf("[DEBUG] Deleting MACSec secret key: %s", *input.SecretId)
-			_, err := smConn.DeleteSecretWithContext(ctx, input)
+				if _, err := smConn.DeleteSecretWithContext(ctx, input); err != nil {
+					return fmt.Errorf("error deleting MACsec Secret (%s): %w", arn, err)
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting MACsec Secret (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+				return nil
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(arn, deleteSecret))
 		}
 	}
 
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping MACsec Secrets: %w", err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
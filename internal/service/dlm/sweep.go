@@ -24,7 +24,7 @@ func init() {
 }
 
 func sweepLifecyclePolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dlm_lifecycle_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -36,7 +36,7 @@ func init() {
 }
 
 func sweepReplicationInstances(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dms_replication_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -77,7 +77,7 @@ func sweepReplicationInstances(region string) error {
 }
 
 func sweepReplicationTasks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dms_replication_task")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -121,7 +121,7 @@ func sweepReplicationTasks(region string) error {
 }
 
 func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dms_endpoint")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
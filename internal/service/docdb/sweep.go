@@ -70,7 +70,7 @@ func init() {
 }
 
 func sweepDBClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_docdb_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -78,29 +78,23 @@ func sweepDBClusters(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DocDBConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &docdb.DescribeDBClustersInput{}
 
-	err = conn.DescribeDBClustersPagesWithContext(ctx, input, func(out *docdb.DescribeDBClustersOutput, lastPage bool) bool {
-		for _, dBCluster := range out.DBClusters {
-			id := aws.StringValue(dBCluster.DBClusterIdentifier)
-			input := &docdb.DeleteDBClusterInput{
-				DBClusterIdentifier: dBCluster.DBClusterIdentifier,
-				SkipFinalSnapshot:   aws.Bool(true),
-			}
-
-			log.Printf("[INFO] Deleting DocDB Cluster: %s", id)
-
-			_, err := conn.DeleteDBClusterWithContext(ctx, input)
+	err = conn.DescribeDBClustersPagesWithContext(ctx, input, func(page *docdb.DescribeDBClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DocDB Cluster (%s): %s", id, err)
-				continue
-			}
+		for _, dBCluster := range page.DBClusters {
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(dBCluster.DBClusterIdentifier))
+			d.Set("skip_final_snapshot", true)
 
-			if err := WaitForDBClusterDeletion(ctx, conn, id, DBClusterDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Cluster (%s) to be deleted: %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithCreationTime(aws.TimeValue(dBCluster.ClusterCreateTime))))
 		}
+
 		return !lastPage
 	})
 
@@ -113,11 +107,17 @@ func sweepDBClusters(region string) error {
 		return fmt.Errorf("error retrieving DocDB Clusters: %w", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Clusters: %w", err)
+	}
+
 	return nil
 }
 
 func sweepDBClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_docdb_cluster_snapshot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -125,28 +125,22 @@ func sweepDBClusterSnapshots(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DocDBConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &docdb.DescribeDBClusterSnapshotsInput{}
 
-	err = conn.DescribeDBClusterSnapshotsPagesWithContext(ctx, input, func(out *docdb.DescribeDBClusterSnapshotsOutput, lastPage bool) bool {
-		for _, dBClusterSnapshot := range out.DBClusterSnapshots {
-			name := aws.StringValue(dBClusterSnapshot.DBClusterSnapshotIdentifier)
-			input := &docdb.DeleteDBClusterSnapshotInput{
-				DBClusterSnapshotIdentifier: dBClusterSnapshot.DBClusterSnapshotIdentifier,
-			}
-
-			log.Printf("[INFO] Deleting DocDB Cluster Snapshot: %s", name)
-
-			_, err := conn.DeleteDBClusterSnapshotWithContext(ctx, input)
+	err = conn.DescribeDBClusterSnapshotsPagesWithContext(ctx, input, func(page *docdb.DescribeDBClusterSnapshotsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DocDB Cluster Snapshot (%s): %s", name, err)
-				continue
-			}
+		for _, dBClusterSnapshot := range page.DBClusterSnapshots {
+			r := ResourceClusterSnapshot()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(dBClusterSnapshot.DBClusterSnapshotIdentifier))
 
-			if err := WaitForDBClusterSnapshotDeletion(ctx, conn, name, DBClusterSnapshotDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Cluster Snapshot (%s) to be deleted: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithCreationTime(aws.TimeValue(dBClusterSnapshot.SnapshotCreateTime))))
 		}
+
 		return !lastPage
 	})
 
@@ -159,11 +153,17 @@ func sweepDBClusterSnapshots(region string) error {
 		return fmt.Errorf("error retrieving DocDB Cluster Snapshots: %w", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Cluster Snapshots: %w", err)
+	}
+
 	return nil
 }
 
 func sweepDBClusterParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_docdb_cluster_parameter_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -171,29 +171,29 @@ func sweepDBClusterParameterGroups(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DocDBConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &docdb.DescribeDBClusterParameterGroupsInput{}
 
-	err = conn.DescribeDBClusterParameterGroupsPagesWithContext(ctx, input, func(out *docdb.DescribeDBClusterParameterGroupsOutput, lastPage bool) bool {
-		for _, dBClusterParameterGroup := range out.DBClusterParameterGroups {
+	err = conn.DescribeDBClusterParameterGroupsPagesWithContext(ctx, input, func(page *docdb.DescribeDBClusterParameterGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, dBClusterParameterGroup := range page.DBClusterParameterGroups {
 			name := aws.StringValue(dBClusterParameterGroup.DBClusterParameterGroupName)
-			input := &docdb.DeleteDBClusterParameterGroupInput{
-				DBClusterParameterGroupName: dBClusterParameterGroup.DBClusterParameterGroupName,
-			}
 
 			if strings.HasPrefix(name, "default.") {
 				log.Printf("[INFO] Skipping Document DB Parameter Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting DocDB Cluster Parameter Group: %s", name)
-
-			_, err := conn.DeleteDBClusterParameterGroupWithContext(ctx, input)
+			r := ResourceClusterParameterGroup()
+			d := r.Data(nil)
+			d.SetId(name)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DocDB Parameter Group (%s): %s", name, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
@@ -206,11 +206,17 @@ func sweepDBClusterParameterGroups(region string) error {
 		return fmt.Errorf("error retrieving DocDB Cluster Parameter Groups: %w", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Cluster Parameter Groups: %w", err)
+	}
+
 	return nil
 }
 
 func sweepDBInstances(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_docdb_cluster_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -255,7 +261,7 @@ func sweepDBInstances(region string) error {
 }
 
 func sweepGlobalClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_docdb_global_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -263,28 +269,22 @@ func sweepGlobalClusters(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DocDBConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &docdb.DescribeGlobalClustersInput{}
 
-	err = conn.DescribeGlobalClustersPagesWithContext(ctx, input, func(out *docdb.DescribeGlobalClustersOutput, lastPage bool) bool {
-		for _, globalCluster := range out.GlobalClusters {
-			id := aws.StringValue(globalCluster.GlobalClusterIdentifier)
-			input := &docdb.DeleteGlobalClusterInput{
-				GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
-			}
-
-			log.Printf("[INFO] Deleting DocDB Global Cluster: %s", id)
-
-			_, err := conn.DeleteGlobalClusterWithContext(ctx, input)
+	err = conn.DescribeGlobalClustersPagesWithContext(ctx, input, func(page *docdb.DescribeGlobalClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DocDB Global Cluster (%s): %s", id, err)
-				continue
-			}
+		for _, globalCluster := range page.GlobalClusters {
+			r := ResourceGlobalCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(globalCluster.GlobalClusterIdentifier))
 
-			if err := WaitForGlobalClusterDeletion(ctx, conn, id, GlobalClusterDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Global Cluster (%s) to be deleted: %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
@@ -297,11 +297,17 @@ func sweepGlobalClusters(region string) error {
 		return fmt.Errorf("error retrieving DocDB Global Clusters: %w", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Global Clusters: %w", err)
+	}
+
 	return nil
 }
 
 func sweepDBSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_docdb_subnet_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -309,28 +315,22 @@ func sweepDBSubnetGroups(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DocDBConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &docdb.DescribeDBSubnetGroupsInput{}
 
-	err = conn.DescribeDBSubnetGroupsPagesWithContext(ctx, input, func(out *docdb.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
-		for _, dBSubnetGroup := range out.DBSubnetGroups {
-			name := aws.StringValue(dBSubnetGroup.DBSubnetGroupName)
-			input := &docdb.DeleteDBSubnetGroupInput{
-				DBSubnetGroupName: dBSubnetGroup.DBSubnetGroupName,
-			}
-
-			log.Printf("[INFO] Deleting DocDB Subnet Group: %s", name)
-
-			_, err := conn.DeleteDBSubnetGroupWithContext(ctx, input)
+	err = conn.DescribeDBSubnetGroupsPagesWithContext(ctx, input, func(page *docdb.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DocDB Subnet Group (%s): %s", name, err)
-				continue
-			}
+		for _, dBSubnetGroup := range page.DBSubnetGroups {
+			r := ResourceSubnetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(dBSubnetGroup.DBSubnetGroupName))
 
-			if err := WaitForDBSubnetGroupDeletion(ctx, conn, name, DBSubnetGroupDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Subnet Group (%s) to be deleted: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
@@ -343,11 +343,17 @@ func sweepDBSubnetGroups(region string) error {
 		return fmt.Errorf("error retrieving DocDB Subnet Groups: %w", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Subnet Groups: %w", err)
+	}
+
 	return nil
 }
 
 func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_docdb_event_subscription")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -355,28 +361,22 @@ func sweepEventSubscriptions(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DocDBConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &docdb.DescribeEventSubscriptionsInput{}
 
-	err = conn.DescribeEventSubscriptionsPagesWithContext(ctx, input, func(out *docdb.DescribeEventSubscriptionsOutput, lastPage bool) bool {
-		for _, eventSubscription := range out.EventSubscriptionsList {
-			id := aws.StringValue(eventSubscription.CustSubscriptionId)
-			input := &docdb.DeleteEventSubscriptionInput{
-				SubscriptionName: eventSubscription.CustSubscriptionId,
-			}
-
-			log.Printf("[INFO] Deleting DocDB Event Subscription: %s", id)
-
-			_, err := conn.DeleteEventSubscriptionWithContext(ctx, input)
+	err = conn.DescribeEventSubscriptionsPagesWithContext(ctx, input, func(page *docdb.DescribeEventSubscriptionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DocDB Event Subscription (%s): %s", id, err)
-				continue
-			}
+		for _, eventSubscription := range page.EventSubscriptionsList {
+			r := ResourceEventSubscription()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(eventSubscription.CustSubscriptionId))
 
-			if _, err := waitEventSubscriptionDeleted(ctx, conn, id, EventSubscriptionDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Event Subscription (%s) to be deleted: %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
@@ -389,5 +389,11 @@ func sweepEventSubscriptions(region string) error {
 		return fmt.Errorf("error retrieving DocDB Event Subscriptions: %w", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DocDB Event Subscriptions: %w", err)
+	}
+
 	return nil
 }
@@ -40,7 +40,7 @@ func init() {
 }
 
 func sweepDirectories(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_directory_service_directory")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -85,7 +85,7 @@ func sweepDirectories(region string) error {
 }
 
 func sweepRegions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_directory_service_region")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -33,7 +33,7 @@ func init() {
 }
 
 func sweepTables(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dynamodb_table")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -104,7 +104,7 @@ func sweepTables(region string) error {
 }
 
 func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_dynamodb_backup")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -28,6 +29,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func init() {
+	_sp.registerResourceImport("aws_dynamodb_table", &intf.ResourceImport{
+		ARNService:           "dynamodb",
+		ARNResourcePrefix:    "table/",
+		CloudControlTypeName: "AWS::DynamoDB::Table",
+	})
+}
+
 const (
 	provisionedThroughputMinValue = 1
 	ResNameTable                  = "Table"
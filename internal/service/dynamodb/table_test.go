@@ -386,6 +386,35 @@ func TestAccDynamoDBTable_disappears(t *testing.T) {
 	})
 }
 
+func TestDynamoDBTable_fakeAWS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.FakeAWSLifecycleTest(ctx, t, acctest.FakeAWSLifecycle{
+		CheckDestroy: testAccCheckTableDestroyWithProvider(ctx),
+		CheckExists: func(providerF func() *schema.Provider) resource.TestCheckFunc {
+			return testAccCheckInitialTableExistsWithProvider(ctx, resourceName, &conf, providerF)
+		},
+		Config: testAccTableConfig_billingPayPerRequest(rName),
+		Checks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "name", rName),
+			resource.TestCheckResourceAttr(resourceName, "billing_mode", dynamodb.BillingModePayPerRequest),
+		},
+		UpdateConfig: testAccTableConfig_billingProvisioned(rName),
+		UpdateChecks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "billing_mode", dynamodb.BillingModeProvisioned),
+			resource.TestCheckResourceAttr(resourceName, "read_capacity", "5"),
+			resource.TestCheckResourceAttr(resourceName, "write_capacity", "5"),
+		},
+		ReadService:   names.DynamoDB,
+		ReadOperation: "DescribeTable",
+		Resource:      tfdynamodb.ResourceTable(),
+		ResourceName:  resourceName,
+	})
+}
+
 func TestAccDynamoDBTable_Disappears_payPerRequestWithGSI(t *testing.T) {
 	ctx := acctest.Context(t)
 	var table1, table2 dynamodb.TableDescription
@@ -2153,8 +2182,12 @@ func TestAccDynamoDBTable_backup_overrideEncryption(t *testing.T) {
 }
 
 func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+	return func(s *terraform.State) error { return testAccCheckTableDestroyWithProvider(ctx)(s, acctest.Provider) }
+}
+
+func testAccCheckTableDestroyWithProvider(ctx context.Context) acctest.TestCheckWithProviderFunc {
+	return func(s *terraform.State, provider *schema.Provider) error {
+		conn := provider.Meta().(*conns.AWSClient).DynamoDBConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_dynamodb_table" {
@@ -2179,6 +2212,10 @@ func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
 }
 
 func testAccCheckInitialTableExists(ctx context.Context, n string, v *dynamodb.TableDescription) resource.TestCheckFunc {
+	return testAccCheckInitialTableExistsWithProvider(ctx, n, v, func() *schema.Provider { return acctest.Provider })
+}
+
+func testAccCheckInitialTableExistsWithProvider(ctx context.Context, n string, v *dynamodb.TableDescription, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -2189,7 +2226,7 @@ func testAccCheckInitialTableExists(ctx context.Context, n string, v *dynamodb.T
 			return fmt.Errorf("No DynamoDB Table ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+		conn := providerF().Meta().(*conns.AWSClient).DynamoDBConn()
 
 		output, err := tfdynamodb.FindTableByName(ctx, conn, rs.Primary.ID)
 
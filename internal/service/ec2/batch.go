@@ -0,0 +1,121 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Concurrent single-ID finder calls during refresh are coalesced into batched Describe calls.
+// IDs are passed as filter values rather than as an ID list so that one missing ID doesn't fail the whole batch.
+// An ID missing from a batch is then looked up on its own, so that a malformed ID is an error rather than not found.
+// Calls are only batched if their Context was returned by AWSClient.BatchingContext.
+const (
+	batchMaxFilterValues = 200
+	batchWindow          = 10 * time.Millisecond
+)
+
+// findBatched returns the item with the specified ID from a batched request.
+// The returned bool is false if the item wasn't found or the Context doesn't allow batching,
+// in which case the caller should look the item up on its own.
+func findBatched[T any](ctx context.Context, conn *ec2.EC2, operation, id string, fetch func(context.Context, []string) (map[string]T, error)) (T, bool, error) {
+	batcher, ok := conns.BatcherFor(ctx, conn, operation, func() *conns.Batcher[string, T] {
+		return conns.NewBatcher(batchWindow, batchMaxFilterValues, fetch)
+	})
+
+	if !ok {
+		var zero T
+		return zero, false, nil
+	}
+
+	return batcher.Get(ctx, id)
+}
+
+func findInstanceBatched(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Instance, bool, error) {
+	return findBatched(ctx, conn, "DescribeInstances", id, func(ctx context.Context, ids []string) (map[string]*ec2.Instance, error) {
+		input := &ec2.DescribeInstancesInput{
+			Filters: []*ec2.Filter{NewFilter("instance-id", ids)},
+		}
+
+		output, err := FindInstances(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]*ec2.Instance, len(output))
+		for _, v := range output {
+			if v.State != nil {
+				m[aws.StringValue(v.InstanceId)] = v
+			}
+		}
+
+		return m, nil
+	})
+}
+
+func findNetworkInterfaceBatched(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NetworkInterface, bool, error) {
+	return findBatched(ctx, conn, "DescribeNetworkInterfaces", id, func(ctx context.Context, ids []string) (map[string]*ec2.NetworkInterface, error) {
+		input := &ec2.DescribeNetworkInterfacesInput{
+			Filters: []*ec2.Filter{NewFilter("network-interface-id", ids)},
+		}
+
+		output, err := FindNetworkInterfaces(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]*ec2.NetworkInterface, len(output))
+		for _, v := range output {
+			m[aws.StringValue(v.NetworkInterfaceId)] = v
+		}
+
+		return m, nil
+	})
+}
+
+func findSecurityGroupBatched(ctx context.Context, conn *ec2.EC2, id string) (*ec2.SecurityGroup, bool, error) {
+	return findBatched(ctx, conn, "DescribeSecurityGroups", id, func(ctx context.Context, ids []string) (map[string]*ec2.SecurityGroup, error) {
+		input := &ec2.DescribeSecurityGroupsInput{
+			Filters: []*ec2.Filter{NewFilter("group-id", ids)},
+		}
+
+		output, err := FindSecurityGroups(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]*ec2.SecurityGroup, len(output))
+		for _, v := range output {
+			m[aws.StringValue(v.GroupId)] = v
+		}
+
+		return m, nil
+	})
+}
+
+func findSubnetBatched(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Subnet, bool, error) {
+	return findBatched(ctx, conn, "DescribeSubnets", id, func(ctx context.Context, ids []string) (map[string]*ec2.Subnet, error) {
+		input := &ec2.DescribeSubnetsInput{
+			Filters: []*ec2.Filter{NewFilter("subnet-id", ids)},
+		}
+
+		output, err := FindSubnets(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]*ec2.Subnet, len(output))
+		for _, v := range output {
+			m[aws.StringValue(v.SubnetId)] = v
+		}
+
+		return m, nil
+	})
+}
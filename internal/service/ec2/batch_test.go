@@ -0,0 +1,404 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// testDescribeServer is a fake EC2 API that describes any resource whose ID starts with its type's prefix.
+// IDs are passed either as filter values or as an ID list, in which case a malformed ID is an error.
+type testDescribeServer struct {
+	mu    sync.Mutex
+	calls map[string][][]string // Action -> filter values of each call.
+}
+
+var testDescribeItems = map[string]struct {
+	idList    string // Query parameter prefix of the ID list.
+	malformed string // Error code for a malformed ID in the ID list.
+	prefix    string
+	set       string
+	item      func(id string) string
+}{
+	"DescribeInstances": {
+		idList:    "InstanceId.",
+		malformed: "InvalidInstanceID.Malformed",
+		prefix:    "i-",
+		set:       "reservationSet",
+		item: func(id string) string {
+			return fmt.Sprintf(`<item><instancesSet><item><instanceId>%s</instanceId><instanceState><name>running</name></instanceState></item></instancesSet></item>`, id)
+		},
+	},
+	"DescribeNetworkInterfaces": {
+		idList:    "NetworkInterfaceId.",
+		malformed: "InvalidNetworkInterfaceID.Malformed",
+		prefix:    "eni-",
+		set:       "networkInterfaceSet",
+		item: func(id string) string {
+			return fmt.Sprintf(`<item><networkInterfaceId>%s</networkInterfaceId></item>`, id)
+		},
+	},
+	"DescribeSecurityGroups": {
+		idList:    "GroupId.",
+		malformed: "InvalidGroupId.Malformed",
+		prefix:    "sg-",
+		set:       "securityGroupInfo",
+		item: func(id string) string {
+			return fmt.Sprintf(`<item><groupId>%s</groupId></item>`, id)
+		},
+	},
+	"DescribeSubnets": {
+		idList:    "SubnetId.",
+		malformed: "InvalidSubnetID.Malformed",
+		prefix:    "subnet-",
+		set:       "subnetSet",
+		item: func(id string) string {
+			return fmt.Sprintf(`<item><subnetId>%s</subnetId></item>`, id)
+		},
+	},
+}
+
+func (s *testDescribeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	action := r.Form.Get("Action")
+	describe, ok := testDescribeItems[action]
+
+	if !ok {
+		http.Error(w, action, http.StatusNotImplemented)
+		return
+	}
+
+	var ids, values []string
+	for k, v := range r.Form {
+		switch {
+		case strings.HasPrefix(k, "Filter.1.Value."):
+			values = append(values, v...)
+		case strings.HasPrefix(k, describe.idList):
+			ids = append(ids, v...)
+		}
+	}
+	sort.Strings(values)
+
+	for _, v := range ids {
+		if !strings.HasPrefix(v, describe.prefix) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `<Response><Errors><Error><Code>%s</Code><Message>Invalid id: "%s"</Message></Error></Errors><RequestID>1</RequestID></Response>`, describe.malformed, v)
+			return
+		}
+	}
+	values = append(values, ids...)
+
+	s.mu.Lock()
+	s.calls[action] = append(s.calls[action], values)
+	s.mu.Unlock()
+
+	var body strings.Builder
+
+	fmt.Fprintf(&body, `<%sResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><%s>`, action, describe.set)
+	for _, v := range values {
+		if strings.HasPrefix(v, describe.prefix) {
+			body.WriteString(describe.item(v))
+		}
+	}
+	fmt.Fprintf(&body, `</%s></%sResponse>`, describe.set, action)
+
+	w.Header().Set("Content-Type", "text/xml")
+	fmt.Fprint(w, body.String())
+}
+
+func (s *testDescribeServer) Calls(action string) [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls[action]
+}
+
+func newTestDescribeServer(t *testing.T) (*testDescribeServer, string) {
+	t.Helper()
+
+	s := &testDescribeServer{calls: make(map[string][][]string)}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+
+	return s, server.URL
+}
+
+func newTestDescribeConn(t *testing.T) (*ec2.EC2, *testDescribeServer) {
+	t.Helper()
+
+	s, url := newTestDescribeServer(t)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock-access-key", "mock-secret-key", ""),
+		Endpoint:    aws.String(url),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return ec2.New(sess), s
+}
+
+var testBatchedFinders = map[string]struct {
+	action string
+	ids    []string
+	find   func(context.Context, *ec2.EC2, string) (string, error)
+}{
+	"instance": {
+		action: "DescribeInstances",
+		ids:    []string{"i-1", "i-2", "i-3"},
+		find: func(ctx context.Context, conn *ec2.EC2, id string) (string, error) {
+			v, err := tfec2.FindInstanceByID(ctx, conn, id)
+			if err != nil {
+				return "", err
+			}
+			return aws.StringValue(v.InstanceId), nil
+		},
+	},
+	"network interface": {
+		action: "DescribeNetworkInterfaces",
+		ids:    []string{"eni-1", "eni-2", "eni-3"},
+		find: func(ctx context.Context, conn *ec2.EC2, id string) (string, error) {
+			v, err := tfec2.FindNetworkInterfaceByID(ctx, conn, id)
+			if err != nil {
+				return "", err
+			}
+			return aws.StringValue(v.NetworkInterfaceId), nil
+		},
+	},
+	"security group": {
+		action: "DescribeSecurityGroups",
+		ids:    []string{"sg-1", "sg-2", "sg-3"},
+		find: func(ctx context.Context, conn *ec2.EC2, id string) (string, error) {
+			v, err := tfec2.FindSecurityGroupByID(ctx, conn, id)
+			if err != nil {
+				return "", err
+			}
+			return aws.StringValue(v.GroupId), nil
+		},
+	},
+	"subnet": {
+		action: "DescribeSubnets",
+		ids:    []string{"subnet-1", "subnet-2", "subnet-3"},
+		find: func(ctx context.Context, conn *ec2.EC2, id string) (string, error) {
+			v, err := tfec2.FindSubnetByID(ctx, conn, id)
+			if err != nil {
+				return "", err
+			}
+			return aws.StringValue(v.SubnetId), nil
+		},
+	},
+}
+
+// newTestDescribeProvider returns a provider configured to send all AWS API requests to a fake EC2 API.
+func newTestDescribeProvider(ctx context.Context, t *testing.T) (*schema.Provider, *testDescribeServer) {
+	t.Helper()
+
+	s, url := newTestDescribeServer(t)
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"access_key":              "mock-access-key",
+		"emulator":                true,
+		"endpoint_url":            url,
+		"region":                  "us-west-2", //lintignore:AWSAT003
+		"secret_key":              "mock-secret-key",
+		"skip_metadata_api_check": "true",
+	}))
+
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return p, s
+}
+
+// testBatchedResources are the resources whose Read only makes a single batched Describe call.
+var testBatchedResources = map[string]struct {
+	action string
+	ids    []string
+}{
+	"aws_network_interface": {
+		action: "DescribeNetworkInterfaces",
+		ids:    []string{"eni-1", "eni-2", "eni-3"},
+	},
+	"aws_security_group": {
+		action: "DescribeSecurityGroups",
+		ids:    []string{"sg-1", "sg-2", "sg-3"},
+	},
+	"aws_subnet": {
+		action: "DescribeSubnets",
+		ids:    []string{"subnet-1", "subnet-2", "subnet-3"},
+	},
+}
+
+// refreshConcurrently refreshes resources with the specified IDs concurrently, as Terraform does during refresh.
+func refreshConcurrently(ctx context.Context, p *schema.Provider, typeName string, ids []string) ([]*terraform.InstanceState, []error) {
+	r := p.ResourcesMap[typeName]
+	states := make([]*terraform.InstanceState, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+
+			state := &terraform.InstanceState{
+				ID:         id,
+				Attributes: map[string]string{"id": id},
+			}
+			var diags diag.Diagnostics
+			states[i], diags = r.RefreshWithoutUpgrade(ctx, state, p.Meta())
+			errs[i] = sdkdiag.DiagnosticsError(diags)
+		}(i, id)
+	}
+	wg.Wait()
+
+	return states, errs
+}
+
+func TestResourceReadBatched(t *testing.T) {
+	t.Parallel()
+
+	for typeName, testCase := range testBatchedResources {
+		typeName, testCase := typeName, testCase
+
+		t.Run(typeName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			p, server := newTestDescribeProvider(ctx, t)
+
+			// Resources are read concurrently through the provider during refresh.
+			states, errs := refreshConcurrently(ctx, p, typeName, testCase.ids)
+
+			got := make([]string, len(states))
+			for i, err := range errs {
+				if err != nil {
+					t.Errorf("%s: unexpected error: %s", testCase.ids[i], err)
+				}
+
+				if states[i] != nil {
+					got[i] = states[i].ID
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.ids); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(server.Calls(testCase.action), [][]string{testCase.ids}); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceReadBatchedMalformed(t *testing.T) {
+	t.Parallel()
+
+	for typeName := range testBatchedResources {
+		typeName := typeName
+
+		t.Run(typeName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			p, _ := newTestDescribeProvider(ctx, t)
+
+			// A malformed ID is an API error, not a resource that has been deleted.
+			states, errs := refreshConcurrently(ctx, p, typeName, []string{"malformed-id"})
+
+			if errs[0] == nil {
+				t.Fatalf("expected error, got state %v", states[0])
+			}
+		})
+	}
+}
+
+func TestFindByIDUnbatched(t *testing.T) {
+	t.Parallel()
+
+	for name, testCase := range testBatchedFinders {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, server := newTestDescribeConn(t)
+			// Other calls, e.g. by waiters or sweepers, are not batched.
+			ctx := context.Background()
+
+			for _, id := range testCase.ids {
+				got, err := testCase.find(ctx, conn, id)
+
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %s", id, err)
+				}
+
+				if got != id {
+					t.Errorf("ID = %q, want %q", got, id)
+				}
+			}
+
+			if got, want := len(server.Calls(testCase.action)), len(testCase.ids); got != want {
+				t.Errorf("calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestFindByIDMalformed(t *testing.T) {
+	t.Parallel()
+
+	for name, testCase := range testBatchedFinders {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newTestDescribeConn(t)
+
+			// A malformed ID is an API error, not a resource that isn't found.
+			_, err := testCase.find(context.Background(), conn, "malformed-id")
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if tfresource.NotFound(err) {
+				t.Errorf("expected API error, got NotFound error: %s", err)
+			}
+		})
+	}
+}
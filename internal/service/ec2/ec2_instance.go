@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -33,6 +34,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func init() {
+	_sp.registerResourceImport("aws_instance", &intf.ResourceImport{
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "instance/",
+		CloudControlTypeName: "AWS::EC2::Instance",
+	})
+}
+
 func ResourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
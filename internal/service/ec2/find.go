@@ -1063,12 +1063,20 @@ func FindInstanceByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Insta
 		InstanceIds: aws.StringSlice([]string{id}),
 	}
 
-	output, err := FindInstance(ctx, conn, input)
+	output, ok, err := findInstanceBatched(ctx, conn, id)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if !ok {
+		output, err = FindInstance(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if state := aws.StringValue(output.State.Name); state == ec2.InstanceStateNameTerminated {
 		return nil, &resource.NotFoundError{
 			Message:     state,
@@ -1556,12 +1564,20 @@ func FindNetworkInterfaceByID(ctx context.Context, conn *ec2.EC2, id string) (*e
 		NetworkInterfaceIds: aws.StringSlice([]string{id}),
 	}
 
-	output, err := FindNetworkInterface(ctx, conn, input)
+	output, ok, err := findNetworkInterfaceBatched(ctx, conn, id)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if !ok {
+		output, err = FindNetworkInterface(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Eventual consistency check.
 	if aws.StringValue(output.NetworkInterfaceId) != id {
 		return nil, &resource.NotFoundError{
@@ -1995,12 +2011,20 @@ func FindSecurityGroupByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 		GroupIds: aws.StringSlice([]string{id}),
 	}
 
-	output, err := FindSecurityGroup(ctx, conn, input)
+	output, ok, err := findSecurityGroupBatched(ctx, conn, id)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if !ok {
+		output, err = FindSecurityGroup(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Eventual consistency check.
 	if aws.StringValue(output.GroupId) != id {
 		return nil, &resource.NotFoundError{
@@ -2429,12 +2453,20 @@ func FindSubnetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Subnet,
 		SubnetIds: aws.StringSlice([]string{id}),
 	}
 
-	output, err := FindSubnet(ctx, conn, input)
+	output, ok, err := findSubnetBatched(ctx, conn, id)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if !ok {
+		output, err = FindSubnet(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Eventual consistency check.
 	if aws.StringValue(output.SubnetId) != id {
 		return nil, &resource.NotFoundError{
@@ -4,10 +4,10 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -373,12 +373,13 @@ func init() {
 }
 
 func sweepCapacityReservations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_capacity_reservation")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).EC2Conn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	resp, err := conn.DescribeCapacityReservationsWithContext(ctx, &ec2.DescribeCapacityReservationsInput{})
 
@@ -396,29 +397,30 @@ func sweepCapacityReservations(region string) error {
 		return nil
 	}
 
-	for _, r := range resp.CapacityReservations {
-		if aws.StringValue(r.State) != ec2.CapacityReservationStateCancelled && aws.StringValue(r.State) != ec2.CapacityReservationStateExpired {
-			id := aws.StringValue(r.CapacityReservationId)
+	for _, v := range resp.CapacityReservations {
+		if state := aws.StringValue(v.State); state == ec2.CapacityReservationStateCancelled || state == ec2.CapacityReservationStateExpired {
+			continue
+		}
 
-			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
+		r := ResourceCapacityReservation()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(v.CapacityReservationId))
+		tags := KeyValueTags(v.Tags).Map()
 
-			opts := &ec2.CancelCapacityReservationInput{
-				CapacityReservationId: aws.String(id),
-			}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(tags["Name"]), sweep.WithTags(tags), sweep.WithCreationTime(aws.TimeValue(v.CreateDate))))
+	}
 
-			_, err := conn.CancelCapacityReservationWithContext(ctx, opts)
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-			if err != nil {
-				log.Printf("[ERROR] Error cancelling EC2 Capacity Reservation (%s): %s", id, err)
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Capacity Reservations: %w", err)
 	}
 
 	return nil
 }
 
 func sweepCarrierGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_carrier_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -462,7 +464,7 @@ func sweepCarrierGateways(region string) error {
 }
 
 func sweepClientVPNEndpoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_client_vpn_endpoint")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -506,7 +508,7 @@ func sweepClientVPNEndpoints(region string) error {
 }
 
 func sweepClientVPNNetworkAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_client_vpn_network_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -574,7 +576,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 }
 
 func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_fleet")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -621,7 +623,7 @@ func sweepFleets(region string) error {
 }
 
 func sweepEBSVolumes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ebs_volume")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -672,7 +674,7 @@ func sweepEBSVolumes(region string) error {
 }
 
 func sweepEBSSnapshots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ebs_snapshot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -718,7 +720,7 @@ func sweepEBSSnapshots(region string) error {
 }
 
 func sweepEgressOnlyInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_egress_only_internet_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -762,7 +764,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 }
 
 func sweepEIPs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_eip")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -825,7 +827,7 @@ func sweepEIPs(region string) error {
 }
 
 func sweepFlowLogs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_flow_log")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -869,7 +871,7 @@ func sweepFlowLogs(region string) error {
 }
 
 func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_host")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -912,64 +914,8 @@ func sweepHosts(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-
-	conn := client.(*conns.AWSClient).EC2Conn()
-	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
-
-	err = conn.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		for _, reservation := range page.Reservations {
-			if reservation == nil {
-				continue
-			}
-
-			for _, instance := range reservation.Instances {
-				id := aws.StringValue(instance.InstanceId)
-
-				if instance.State != nil && aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
-					log.Printf("[INFO] Skipping terminated EC2 Instance: %s", id)
-					continue
-				}
-
-				r := ResourceInstance()
-				d := r.Data(nil)
-				d.SetId(id)
-				d.Set("disable_api_stop", false)
-
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-			}
-		}
-		return !lastPage
-	})
-
-	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing EC2 Instances for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping EC2 Instances for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping EC2 Instance sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
-}
-
 func sweepInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_internet_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1052,7 +998,7 @@ func sweepInternetGateways(region string) error {
 }
 
 func sweepKeyPairs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_key_pair")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1090,7 +1036,7 @@ func sweepKeyPairs(region string) error {
 }
 
 func sweepLaunchTemplates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_launch_template")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1134,7 +1080,7 @@ func sweepLaunchTemplates(region string) error {
 }
 
 func sweepNATGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_nat_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1178,7 +1124,7 @@ func sweepNATGateways(region string) error {
 }
 
 func sweepNetworkACLs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_network_acl")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1233,59 +1179,8 @@ func sweepNetworkACLs(region string) error {
 	return nil
 }
 
-func sweepNetworkInterfaces(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*conns.AWSClient).EC2Conn()
-	input := &ec2.DescribeNetworkInterfacesInput{}
-	sweepResources := make([]sweep.Sweepable, 0)
-
-	err = conn.DescribeNetworkInterfacesPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		for _, v := range page.NetworkInterfaces {
-			id := aws.StringValue(v.NetworkInterfaceId)
-
-			if status := aws.StringValue(v.Status); status != ec2.NetworkInterfaceStatusAvailable {
-				log.Printf("[INFO] Skipping EC2 Network Interface (%s): %s", status, id)
-				continue
-			}
-
-			r := ResourceNetworkInterface()
-			d := r.Data(nil)
-			d.SetId(id)
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
-
-		return !lastPage
-	})
-
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Network Interface sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing EC2 Network Interfaces (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Network Interfaces (%s): %w", region, err)
-	}
-
-	return nil
-}
-
 func sweepNetworkInsightsPaths(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_network_insights_path")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1325,7 +1220,7 @@ func sweepNetworkInsightsPaths(region string) error {
 }
 
 func sweepPlacementGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_placement_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1363,7 +1258,7 @@ func sweepPlacementGroups(region string) error {
 }
 
 func sweepRouteTables(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route_table")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -1371,6 +1266,7 @@ func sweepRouteTables(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).EC2Conn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	var sweeperErrs *multierror.Error
 
@@ -1386,85 +1282,32 @@ func sweepRouteTables(region string) error {
 				continue
 			}
 
+			routeTable := routeTable
 			id := aws.StringValue(routeTable.RouteTableId)
+			tags := KeyValueTags(routeTable.Tags).Map()
 			isMainRouteTableAssociation := false
 
 			for _, routeTableAssociation := range routeTable.Associations {
-				if routeTableAssociation == nil {
-					continue
-				}
-
-				if aws.BoolValue(routeTableAssociation.Main) {
+				if routeTableAssociation != nil && aws.BoolValue(routeTableAssociation.Main) {
 					isMainRouteTableAssociation = true
 					break
 				}
-
-				associationID := aws.StringValue(routeTableAssociation.RouteTableAssociationId)
-
-				input := &ec2.DisassociateRouteTableInput{
-					AssociationId: routeTableAssociation.RouteTableAssociationId,
-				}
-
-				log.Printf("[DEBUG] Deleting EC2 Route Table Association: %s", associationID)
-				_, err := conn.DisassociateRouteTableWithContext(ctx, input)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Association (%s): %w", id, associationID, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
 			}
 
-			if isMainRouteTableAssociation {
-				for _, route := range routeTable.Routes {
-					if route == nil {
-						continue
-					}
-
-					if aws.StringValue(route.GatewayId) == "local" {
-						continue
-					}
-
-					// Prevent deleting default VPC route for Internet Gateway
-					// which some testing is still reliant on operating correctly
-					if strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-") && aws.StringValue(route.DestinationCidrBlock) == "0.0.0.0/0" {
-						continue
-					}
-
-					input := &ec2.DeleteRouteInput{
-						DestinationCidrBlock:     route.DestinationCidrBlock,
-						DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
-						RouteTableId:             routeTable.RouteTableId,
-					}
-
-					log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", id)
-					_, err := conn.DeleteRouteWithContext(ctx, input)
+			var deleteRouteTable func(context.Context) error
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
+			if isMainRouteTableAssociation {
+				// The main route table is deleted with its VPC, only its routes are removed.
+				deleteRouteTable = func(ctx context.Context) error {
+					return sweepMainRouteTableRoutes(ctx, conn, routeTable)
+				}
+			} else {
+				deleteRouteTable = func(ctx context.Context) error {
+					return sweepRouteTable(ctx, conn, routeTable)
 				}
-
-				continue
-			}
-
-			input := &ec2.DeleteRouteTableInput{
-				RouteTableId: routeTable.RouteTableId,
 			}
 
-			log.Printf("[DEBUG] Deleting EC2 Route Table: %s", id)
-			_, err := conn.DeleteRouteTableWithContext(ctx, input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, deleteRouteTable, sweep.WithName(tags["Name"]), sweep.WithTags(tags)))
 		}
 
 		return !lastPage
@@ -1479,103 +1322,95 @@ func sweepRouteTables(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 Route Tables: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
-}
-
-func sweepSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EC2 Route Tables: %w", err))
 	}
-	conn := client.(*conns.AWSClient).EC2Conn()
-
-	input := &ec2.DescribeSecurityGroupsInput{}
 
-	// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
-	err = conn.DescribeSecurityGroupsPagesWithContext(ctx, input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
-		for _, sg := range page.SecurityGroups {
-			if aws.StringValue(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
-				continue
-			}
+	return sweeperErrs.ErrorOrNil()
+}
 
-			if sg.IpPermissions != nil {
-				req := &ec2.RevokeSecurityGroupIngressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissions,
-				}
+// sweepMainRouteTableRoutes deletes the routes of a VPC's main route table,
+// other than local routes and the default route to an Internet Gateway.
+func sweepMainRouteTableRoutes(ctx context.Context, conn *ec2.EC2, routeTable *ec2.RouteTable) error {
+	id := aws.StringValue(routeTable.RouteTableId)
+	var errs *multierror.Error
 
-				if _, err = conn.RevokeSecurityGroupIngressWithContext(ctx, req); err != nil {
-					log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
+	for _, route := range routeTable.Routes {
+		if route == nil {
+			continue
+		}
 
-			if sg.IpPermissionsEgress != nil {
-				req := &ec2.RevokeSecurityGroupEgressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissionsEgress,
-				}
+		if aws.StringValue(route.GatewayId) == "local" {
+			continue
+		}
 
-				if _, err = conn.RevokeSecurityGroupEgressWithContext(ctx, req); err != nil {
-					log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
+		// Prevent deleting default VPC route for Internet Gateway
+		// which some testing is still reliant on operating correctly
+		if strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-") && aws.StringValue(route.DestinationCidrBlock) == "0.0.0.0/0" {
+			continue
 		}
 
-		return !lastPage
-	})
+		input := &ec2.DeleteRouteInput{
+			DestinationCidrBlock:     route.DestinationCidrBlock,
+			DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
+			RouteTableId:             routeTable.RouteTableId,
+		}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
-		return nil
+		log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", id)
+		if _, err := conn.DeleteRouteWithContext(ctx, input); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err))
+		}
 	}
 
-	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
-	}
+	return errs.ErrorOrNil()
+}
 
-	err = conn.DescribeSecurityGroupsPagesWithContext(ctx, input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
-		for _, sg := range page.SecurityGroups {
-			if aws.StringValue(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
-				continue
-			}
+// sweepRouteTable deletes a route table's associations and then the route table.
+func sweepRouteTable(ctx context.Context, conn *ec2.EC2, routeTable *ec2.RouteTable) error {
+	id := aws.StringValue(routeTable.RouteTableId)
 
-			input := &ec2.DeleteSecurityGroupInput{
-				GroupId: sg.GroupId,
-			}
+	for _, routeTableAssociation := range routeTable.Associations {
+		if routeTableAssociation == nil {
+			continue
+		}
 
-			// Handle EC2 eventual consistency
-			err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteSecurityGroupWithContext(ctx, input)
+		associationID := aws.StringValue(routeTableAssociation.RouteTableAssociationId)
+		input := &ec2.DisassociateRouteTableInput{
+			AssociationId: routeTableAssociation.RouteTableAssociationId,
+		}
 
-				if tfawserr.ErrCodeEquals(err, "DependencyViolation") {
-					return resource.RetryableError(err)
-				}
-				if err != nil {
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
+		log.Printf("[DEBUG] Deleting EC2 Route Table Association: %s", associationID)
+		_, err := conn.DisassociateRouteTableWithContext(ctx, input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-			}
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAssociationIDNotFound) {
+			continue
 		}
 
-		return !lastPage
-	})
+		if err != nil {
+			return fmt.Errorf("error deleting EC2 Route Table (%s) Association (%s): %w", id, associationID, err)
+		}
+	}
+
+	input := &ec2.DeleteRouteTableInput{
+		RouteTableId: routeTable.RouteTableId,
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Route Table: %s", id)
+	_, err := conn.DeleteRouteTableWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+		return nil
+	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
+		return fmt.Errorf("error deleting EC2 Route Table (%s): %w", id, err)
 	}
 
 	return nil
 }
 
 func sweepSpotFleetRequests(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_spot_fleet_request")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -1627,7 +1462,7 @@ func sweepSpotFleetRequests(region string) error {
 }
 
 func sweepSpotInstanceRequests(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_spot_instance_request")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -1678,57 +1513,8 @@ func sweepSpotInstanceRequests(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSubnets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
-	conn := client.(*conns.AWSClient).EC2Conn()
-	input := &ec2.DescribeSubnetsInput{}
-	sweepResources := make([]sweep.Sweepable, 0)
-
-	err = conn.DescribeSubnetsPagesWithContext(ctx, input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		for _, v := range page.Subnets {
-			// Skip default subnets.
-			if aws.BoolValue(v.DefaultForAz) {
-				continue
-			}
-
-			r := ResourceSubnet()
-			d := r.Data(nil)
-			d.SetId(aws.StringValue(v.SubnetId))
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
-
-		return !lastPage
-	})
-
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Subnet sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing EC2 Subnets (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 Subnets (%s): %w", region, err)
-	}
-
-	return nil
-}
-
 func sweepTransitGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_transit_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1776,7 +1562,7 @@ func sweepTransitGateways(region string) error {
 }
 
 func sweepTransitGatewayConnectPeers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_transit_gateway_connect_peer")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1824,7 +1610,7 @@ func sweepTransitGatewayConnectPeers(region string) error {
 }
 
 func sweepTransitGatewayConnects(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_transit_gateway_connect")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1872,7 +1658,7 @@ func sweepTransitGatewayConnects(region string) error {
 }
 
 func sweepTransitGatewayMulticastDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_transit_gateway_multicast_domain")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -1920,7 +1706,7 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 }
 
 func sweepTransitGatewayPeeringAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_transit_gateway_peering_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -1968,7 +1754,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 }
 
 func sweepTransitGatewayVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ec2_transit_gateway_vpc_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2016,7 +1802,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 }
 
 func sweepVPCDHCPOptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpc_dhcp_options")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2087,7 +1873,7 @@ func sweepVPCDHCPOptions(region string) error {
 }
 
 func sweepVPCEndpointServices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpc_endpoint_service")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2135,7 +1921,7 @@ func sweepVPCEndpointServices(region string) error {
 }
 
 func sweepVPCEndpoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpc_endpoint")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -2183,7 +1969,7 @@ func sweepVPCEndpoints(region string) error {
 }
 
 func sweepVPCPeeringConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpc_peering_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2226,57 +2012,8 @@ func sweepVPCPeeringConnections(region string) error {
 	return nil
 }
 
-func sweepVPCs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-	conn := client.(*conns.AWSClient).EC2Conn()
-	input := &ec2.DescribeVpcsInput{}
-	sweepResources := make([]sweep.Sweepable, 0)
-
-	err = conn.DescribeVpcsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		for _, v := range page.Vpcs {
-			// Skip default VPCs.
-			if aws.BoolValue(v.IsDefault) {
-				continue
-			}
-
-			r := ResourceVPC()
-			d := r.Data(nil)
-			d.SetId(aws.StringValue(v.VpcId))
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
-
-		return !lastPage
-	})
-
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 VPC sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing EC2 VPCs (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EC2 VPCs (%s): %w", region, err)
-	}
-
-	return nil
-}
-
 func sweepVPNConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpn_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2318,7 +2055,7 @@ func sweepVPNConnections(region string) error {
 }
 
 func sweepVPNGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpn_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2368,7 +2105,7 @@ func sweepVPNGateways(region string) error {
 }
 
 func sweepCustomerGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_customer_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2410,7 +2147,7 @@ func sweepCustomerGateways(region string) error {
 }
 
 func sweepIPAMs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpc_ipam")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2455,7 +2192,7 @@ func sweepIPAMs(region string) error {
 }
 
 func sweepAMIs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ami")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2501,7 +2238,7 @@ func sweepAMIs(region string) error {
 }
 
 func sweepNetworkPerformanceMetricSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_vpc_network_performance_metric_subscription")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -2544,3 +2281,206 @@ func sweepNetworkPerformanceMetricSubscriptions(region string) error {
 
 	return nil
 }
+
+func sweepInstances(region string) error {
+	return sweepVPCResources(region, "aws_instance", "EC2 Instances", listInstanceSweepResources)
+}
+
+func sweepNetworkInterfaces(region string) error {
+	return sweepVPCResources(region, "aws_network_interface", "EC2 Network Interfaces", listNetworkInterfaceSweepResources)
+}
+
+func sweepSecurityGroups(region string) error {
+	return sweepVPCResources(region, "aws_security_group", "EC2 Security Groups", listSecurityGroupSweepResources)
+}
+
+func sweepSubnets(region string) error {
+	return sweepVPCResources(region, "aws_subnet", "EC2 Subnets", listSubnetSweepResources)
+}
+
+func sweepVPCs(region string) error {
+	return sweepVPCResources(region, "aws_vpc", "EC2 VPCs", listVPCSweepResources)
+}
+
+// sweepVPCResources sweeps the resources of a type that is contained in a VPC, or is the VPC itself.
+// Only resources of the named sweeper's own type are swept, the resources inside them are left to the sweepers it depends on.
+func sweepVPCResources(region, name, description string, list func(context.Context, *ec2.EC2, interface{}) ([]sweep.Sweepable, error)) error {
+	ctx := sweep.Context(region, name)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn()
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := list(ctx, conn, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping %s sweep for %s: %s", description, region, err)
+		return nil
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing %s (%s): %w", description, region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping %s (%s): %w", description, region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func listInstanceSweepResources(ctx context.Context, conn *ec2.EC2, client interface{}) ([]sweep.Sweepable, error) {
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, reservation := range page.Reservations {
+			if reservation == nil {
+				continue
+			}
+
+			for _, instance := range reservation.Instances {
+				id := aws.StringValue(instance.InstanceId)
+
+				if instance.State != nil && aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
+					log.Printf("[INFO] Skipping terminated EC2 Instance: %s", id)
+					continue
+				}
+
+				r := ResourceInstance()
+				d := r.Data(nil)
+				d.SetId(id)
+				d.Set("disable_api_stop", false)
+				tags := KeyValueTags(instance.Tags).Map()
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(tags["Name"]), sweep.WithTags(tags), sweep.WithCreationTime(aws.TimeValue(instance.LaunchTime))))
+			}
+		}
+
+		return !lastPage
+	})
+
+	return sweepResources, err
+}
+
+func listNetworkInterfaceSweepResources(ctx context.Context, conn *ec2.EC2, client interface{}) ([]sweep.Sweepable, error) {
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeNetworkInterfacesPagesWithContext(ctx, &ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInterfaces {
+			id := aws.StringValue(v.NetworkInterfaceId)
+
+			if status := aws.StringValue(v.Status); status != ec2.NetworkInterfaceStatusAvailable {
+				log.Printf("[INFO] Skipping EC2 Network Interface (%s): %s", status, id)
+				continue
+			}
+
+			r := ResourceNetworkInterface()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	return sweepResources, err
+}
+
+func listSecurityGroupSweepResources(ctx context.Context, conn *ec2.EC2, client interface{}) ([]sweep.Sweepable, error) {
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeSecurityGroupsPagesWithContext(ctx, &ec2.DescribeSecurityGroupsInput{}, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroups {
+			if aws.StringValue(v.GroupName) == "default" {
+				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(v.GroupId))
+				continue
+			}
+
+			r := ResourceSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.GroupId))
+			// Revoke rules, including those in other groups that reference this one, to prevent DependencyViolation errors.
+			d.Set("revoke_rules_on_delete", true)
+			tags := KeyValueTags(v.Tags).Map()
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(v.GroupName)), sweep.WithTags(tags)))
+		}
+
+		return !lastPage
+	})
+
+	return sweepResources, err
+}
+
+func listSubnetSweepResources(ctx context.Context, conn *ec2.EC2, client interface{}) ([]sweep.Sweepable, error) {
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeSubnetsPagesWithContext(ctx, &ec2.DescribeSubnetsInput{}, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Subnets {
+			// Skip default subnets.
+			if aws.BoolValue(v.DefaultForAz) {
+				continue
+			}
+
+			r := ResourceSubnet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SubnetId))
+			tags := KeyValueTags(v.Tags).Map()
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(tags["Name"]), sweep.WithTags(tags)))
+		}
+
+		return !lastPage
+	})
+
+	return sweepResources, err
+}
+
+func listVPCSweepResources(ctx context.Context, conn *ec2.EC2, client interface{}) ([]sweep.Sweepable, error) {
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err := conn.DescribeVpcsPagesWithContext(ctx, &ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Vpcs {
+			// Skip default VPCs.
+			if aws.BoolValue(v.IsDefault) {
+				continue
+			}
+
+			r := ResourceVPC()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VpcId))
+			tags := KeyValueTags(v.Tags).Map()
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(tags["Name"]), sweep.WithTags(tags)))
+		}
+
+		return !lastPage
+	})
+
+	return sweepResources, err
+}
@@ -17,11 +17,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+func init() {
+	_sp.registerResourceImport("aws_vpc", &intf.ResourceImport{
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "vpc/",
+		CloudControlTypeName: "AWS::EC2::VPC",
+	})
+}
+
 const (
 	VPCCIDRMaxIPv4 = 28
 	VPCCIDRMinIPv4 = 16
@@ -21,11 +21,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+func init() {
+	_sp.registerResourceImport("aws_security_group", &intf.ResourceImport{
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "security-group/",
+		CloudControlTypeName: "AWS::EC2::SecurityGroup",
+	})
+}
+
 func ResourceSecurityGroup() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -14,11 +14,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+func init() {
+	_sp.registerResourceImport("aws_subnet", &intf.ResourceImport{
+		ARNService:           "ec2",
+		ARNResourcePrefix:    "subnet/",
+		CloudControlTypeName: "AWS::EC2::Subnet",
+	})
+}
+
 func ResourceSubnet() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
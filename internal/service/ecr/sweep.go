@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -24,12 +23,13 @@ func init() {
 }
 
 func sweepRepositories(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ecr_repository")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ECRConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	var errors error
 	err = conn.DescribeRepositoriesPagesWithContext(ctx, &ecr.DescribeRepositoriesInput{}, func(page *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
@@ -38,23 +38,14 @@ func sweepRepositories(region string) error {
 		}
 
 		for _, repository := range page.Repositories {
-			repositoryName := aws.StringValue(repository.RepositoryName)
-			log.Printf("[INFO] Deleting ECR repository: %s", repositoryName)
+			r := ResourceRepository()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(repository.RepositoryName))
+			d.Set("registry_id", repository.RegistryId)
+			// We should probably sweep repositories even if there are images.
+			d.Set("force_delete", true)
 
-			_, err = conn.DeleteRepositoryWithContext(ctx, &ecr.DeleteRepositoryInput{
-				// We should probably sweep repositories even if there are images.
-				Force:          aws.Bool(true),
-				RegistryId:     repository.RegistryId,
-				RepositoryName: repository.RepositoryName,
-			})
-			if err != nil {
-				if !tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
-					sweeperErr := fmt.Errorf("Error deleting ECR repository (%s): %w", repositoryName, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					errors = multierror.Append(errors, sweeperErr)
-				}
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithCreationTime(aws.TimeValue(repository.CreatedAt))))
 		}
 
 		return !lastPage
@@ -67,5 +58,9 @@ func sweepRepositories(region string) error {
 		errors = multierror.Append(errors, fmt.Errorf("Error retreiving ECR repositories: %w", err))
 	}
 
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errors = multierror.Append(errors, fmt.Errorf("Error sweeping ECR repositories: %w", err))
+	}
+
 	return errors
 }
@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepRepositories(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ecrpublic_repository")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -48,7 +48,7 @@ func init() {
 }
 
 func sweepCapacityProviders(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ecs_capacity_provider")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -99,7 +99,7 @@ func sweepCapacityProviders(region string) error {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ecs_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -143,7 +143,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ecs_service")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -212,7 +212,7 @@ func sweepServices(region string) error {
 }
 
 func sweepTaskDefinitions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ecs_task_definition")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -37,7 +37,7 @@ func init() {
 }
 
 func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_efs_access_point")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -104,7 +104,7 @@ func sweepAccessPoints(region string) error {
 }
 
 func sweepFileSystems(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_efs_file_system")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -148,7 +148,7 @@ func sweepFileSystems(region string) error {
 }
 
 func sweepMountTargets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_efs_mount_target")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -50,7 +50,7 @@ func init() {
 }
 
 func sweepAddons(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_eks_addon")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -125,7 +125,7 @@ func sweepAddons(region string) error {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_eks_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -169,7 +169,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepFargateProfiles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_eks_fargate_profile")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -242,7 +242,7 @@ func sweepFargateProfiles(region string) error {
 }
 
 func sweepIdentityProvidersConfig(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_eks_identity_provider_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -316,7 +316,7 @@ func sweepIdentityProvidersConfig(region string) error {
 }
 
 func sweepNodeGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_eks_node_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -75,61 +75,62 @@ func init() {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elasticache_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn()
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &elasticache.DescribeCacheClustersInput{
 		ShowCacheClustersNotInReplicationGroups: aws.Bool(true),
 	}
 	err = conn.DescribeCacheClustersPagesWithContext(ctx, input, func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
-		if len(page.CacheClusters) == 0 {
-			log.Print("[DEBUG] No ElastiCache Replication Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, cluster := range page.CacheClusters {
-			id := aws.StringValue(cluster.CacheClusterId)
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(cluster.CacheClusterId))
 
-			log.Printf("[INFO] Deleting ElastiCache Cluster: %s", id)
-			err := DeleteCacheCluster(ctx, conn, id, "")
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Cache Cluster (%s): %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", id, err))
-			}
-			_, err = WaitCacheClusterDeleted(ctx, conn, id, CacheClusterDeletedTimeout)
-			if err != nil {
-				log.Printf("[ERROR] Failed waiting for ElastiCache Cache Cluster (%s) to be deleted: %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): waiting for completion: %w", id, err))
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(cluster.CacheClusterId)),
+				sweep.WithCreationTime(aws.TimeValue(cluster.CacheClusterCreateTime)),
+			))
 		}
+
 		return !lastPage
 	})
+
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping ElastiCache Cluster sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
 	}
+
+	if err != nil {
+		return fmt.Errorf("error listing ElastiCache Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving ElastiCache Clusters: %w", err))
+		return fmt.Errorf("error sweeping ElastiCache Clusters (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepGlobalReplicationGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elasticache_global_replication_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn()
-
-	var grgGroup multierror.Group
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &elasticache.DescribeGlobalReplicationGroupsInput{
 		ShowMemberInfo: aws.Bool(true),
@@ -141,85 +142,92 @@ func sweepGlobalReplicationGroups(region string) error {
 
 		for _, globalReplicationGroup := range page.GlobalReplicationGroups {
 			globalReplicationGroup := globalReplicationGroup
+			id := aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId)
 
-			grgGroup.Go(func() error {
-				id := aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId)
-
-				disassociationErrors := DisassociateMembers(ctx, conn, globalReplicationGroup)
-				if disassociationErrors != nil {
-					return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, disassociationErrors)
+			deleteGlobalReplicationGroupAndMembers := func(ctx context.Context) error {
+				if err := DisassociateMembers(ctx, conn, globalReplicationGroup); err != nil {
+					return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, err)
 				}
 
-				log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
-				err := deleteGlobalReplicationGroup(ctx, conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
-				if err != nil {
-					return fmt.Errorf("deleting ElastiCache Global Replication Group (%s): %w", id, err)
-				}
-				return nil
-			})
+				return deleteGlobalReplicationGroup(ctx, conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
+			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, deleteGlobalReplicationGroupAndMembers, sweep.WithName(id)))
 		}
 
 		return !lastPage
 	})
 
-	grgErrs := grgGroup.Wait()
-
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %q: %s", region, err)
-		return grgErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %s: %s", region, err)
+		return nil
 	}
 
 	if err != nil {
-		grgErrs = multierror.Append(grgErrs, fmt.Errorf("listing ElastiCache Global Replication Groups: %w", err))
+		return fmt.Errorf("error listing ElastiCache Global Replication Groups (%s): %w", region, err)
 	}
 
-	return grgErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Global Replication Groups (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elasticache_parameter_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeCacheParameterGroupsPagesWithContext(ctx, &elasticache.DescribeCacheParameterGroupsInput{}, func(page *elasticache.DescribeCacheParameterGroupsOutput, lastPage bool) bool {
-		if len(page.CacheParameterGroups) == 0 {
-			log.Print("[DEBUG] No ElastiCache Parameter Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, parameterGroup := range page.CacheParameterGroups {
-			name := aws.StringValue(parameterGroup.CacheParameterGroupName)
+		for _, v := range page.CacheParameterGroups {
+			name := aws.StringValue(v.CacheParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
-				log.Printf("[INFO] Skipping ElastiCache Cache Parameter Group: %s", name)
+				log.Printf("[INFO] Skipping ElastiCache Parameter Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting ElastiCache Parameter Group: %s", name)
-			_, err := conn.DeleteCacheParameterGroupWithContext(ctx, &elasticache.DeleteCacheParameterGroupInput{
-				CacheParameterGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Parameter Group (%s): %s", name, err)
-			}
+			r := ResourceParameterGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name)))
 		}
+
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ElastiCache Parameter Group sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ElastiCache Parameter Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving ElastiCache Parameter Group: %w", err)
+		return fmt.Errorf("error listing ElastiCache Parameter Groups (%s): %w", region, err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Parameter Groups (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepReplicationGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elasticache_replication_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -271,86 +279,102 @@ func sweepReplicationGroups(region string) error {
 }
 
 func sweepCacheSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elasticache_security_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeCacheSecurityGroupsPagesWithContext(ctx, &elasticache.DescribeCacheSecurityGroupsInput{}, func(page *elasticache.DescribeCacheSecurityGroupsOutput, lastPage bool) bool {
-		if len(page.CacheSecurityGroups) == 0 {
-			log.Print("[DEBUG] No ElastiCache Cache Security Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, securityGroup := range page.CacheSecurityGroups {
-			name := aws.StringValue(securityGroup.CacheSecurityGroupName)
+		for _, v := range page.CacheSecurityGroups {
+			name := aws.StringValue(v.CacheSecurityGroupName)
 
 			if name == "default" {
 				log.Printf("[INFO] Skipping ElastiCache Cache Security Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting ElastiCache Cache Security Group: %s", name)
-			_, err := conn.DeleteCacheSecurityGroupWithContext(ctx, &elasticache.DeleteCacheSecurityGroupInput{
-				CacheSecurityGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Cache Security Group (%s): %s", name, err)
-			}
+			r := ResourceSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name)))
 		}
+
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ElastiCache Cache Security Group sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ElastiCache Cache Security Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving ElastiCache Cache Security Groups: %s", err)
+		return fmt.Errorf("error listing ElastiCache Cache Security Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Cache Security Groups (%s): %w", region, err)
 	}
+
 	return nil
 }
 
 func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elasticache_subnet_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeCacheSubnetGroupsPagesWithContext(ctx, &elasticache.DescribeCacheSubnetGroupsInput{}, func(page *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) bool {
-		if len(page.CacheSubnetGroups) == 0 {
-			log.Print("[DEBUG] No ElastiCache Subnet Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, subnetGroup := range page.CacheSubnetGroups {
-			name := aws.StringValue(subnetGroup.CacheSubnetGroupName)
+		for _, v := range page.CacheSubnetGroups {
+			name := aws.StringValue(v.CacheSubnetGroupName)
 
 			if name == "default" {
 				log.Printf("[INFO] Skipping ElastiCache Subnet Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting ElastiCache Subnet Group: %s", name)
-			_, err := conn.DeleteCacheSubnetGroupWithContext(ctx, &elasticache.DeleteCacheSubnetGroupInput{
-				CacheSubnetGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Subnet Group (%s): %s", name, err)
-			}
+			r := ResourceSubnetGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name)))
 		}
+
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ElastiCache Subnet Group sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ElastiCache Subnet Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving ElastiCache Subnet Groups: %w", err)
+		return fmt.Errorf("error listing ElastiCache Subnet Groups (%s): %w", region, err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Subnet Groups (%s): %w", region, err)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@
 package elasticbeanstalk
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -31,7 +31,7 @@ func init() {
 }
 
 func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elastic_beanstalk_application")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -47,32 +47,41 @@ func sweepApplications(region string) error {
 		return fmt.Errorf("error retrieving beanstalk application: %w", err)
 	}
 
-	if len(resp.Applications) == 0 {
-		log.Print("[DEBUG] No aws beanstalk applications to sweep")
-		return nil
-	}
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	var errors error
 	for _, bsa := range resp.Applications {
 		applicationName := aws.StringValue(bsa.ApplicationName)
-		_, err := conn.DeleteApplicationWithContext(ctx, &elasticbeanstalk.DeleteApplicationInput{
-			ApplicationName: bsa.ApplicationName,
-		})
-		if err != nil {
+
+		deleteApplication := func(ctx context.Context) error {
+			_, err := conn.DeleteApplicationWithContext(ctx, &elasticbeanstalk.DeleteApplicationInput{
+				ApplicationName: aws.String(applicationName),
+			})
+
 			if tfawserr.ErrCodeEquals(err, "InvalidConfiguration.NotFound") || tfawserr.ErrCodeEquals(err, "ValidationError") {
 				log.Printf("[DEBUG] beanstalk application %q not found", applicationName)
-				continue
+				return nil
 			}
 
-			errors = multierror.Append(fmt.Errorf("error deleting Elastic Beanstalk Application %q: %w", applicationName, err))
+			return err
 		}
+
+		sweepResources = append(sweepResources, sweep.NewSweepFunc(applicationName, deleteApplication,
+			sweep.WithName(applicationName),
+			sweep.WithCreationTime(aws.TimeValue(bsa.DateCreated)),
+		))
 	}
 
-	return errors
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Elastic Beanstalk Applications (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepEnvironments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elastic_beanstalk_environment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -91,24 +100,26 @@ func sweepEnvironments(region string) error {
 		return fmt.Errorf("error retrieving beanstalk environment: %w", err)
 	}
 
-	if len(resp.Environments) == 0 {
-		log.Print("[DEBUG] No aws beanstalk environments to sweep")
-		return nil
-	}
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	var errors error
 	for _, bse := range resp.Environments {
-		environmentName := aws.StringValue(bse.EnvironmentName)
 		environmentID := aws.StringValue(bse.EnvironmentId)
-		log.Printf("Trying to terminate (%s) (%s)", environmentName, environmentID)
 
-		err := DeleteEnvironment(ctx, conn, environmentID, 5*time.Minute, 10*time.Second) //nolint:gomnd
-		if err != nil {
-			errors = multierror.Append(fmt.Errorf("error deleting Elastic Beanstalk Environment %q: %w", environmentID, err))
+		deleteEnvironment := func(ctx context.Context) error {
+			return DeleteEnvironment(ctx, conn, environmentID, 5*time.Minute, 10*time.Second) //nolint:gomnd
 		}
 
-		log.Printf("> Terminated (%s) (%s)", environmentName, environmentID)
+		sweepResources = append(sweepResources, sweep.NewSweepFunc(environmentID, deleteEnvironment,
+			sweep.WithName(aws.StringValue(bse.EnvironmentName)),
+			sweep.WithCreationTime(aws.TimeValue(bse.DateCreated)),
+		))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Elastic Beanstalk Environments (%s): %w", region, err)
 	}
 
-	return errors
+	return nil
 }
@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elasticsearch_domain")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -21,42 +22,49 @@ func init() {
 }
 
 func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_elb")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ELBConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeLoadBalancersPagesWithContext(ctx, &elb.DescribeLoadBalancersInput{}, func(out *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
-		if len(out.LoadBalancerDescriptions) == 0 {
-			log.Println("[INFO] No ELBs found for sweeping")
-			return false
+	err = conn.DescribeLoadBalancersPagesWithContext(ctx, &elb.DescribeLoadBalancersInput{}, func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, lb := range out.LoadBalancerDescriptions {
-			log.Printf("[INFO] Deleting ELB: %s", *lb.LoadBalancerName)
-
-			_, err := conn.DeleteLoadBalancerWithContext(ctx, &elb.DeleteLoadBalancerInput{
-				LoadBalancerName: lb.LoadBalancerName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ELB %s: %s", *lb.LoadBalancerName, err)
-				continue
-			}
-			err = CleanupNetworkInterfaces(ctx, client.(*conns.AWSClient).EC2Conn(), *lb.LoadBalancerName)
-			if err != nil {
-				log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %s", *lb.LoadBalancerName, err)
-			}
+		for _, lb := range page.LoadBalancerDescriptions {
+			name := aws.StringValue(lb.LoadBalancerName)
+
+			r := ResourceLoadBalancer()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(lb.CreatedTime)),
+			))
 		}
+
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ELB sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ELB sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving ELBs: %s", err)
+		return fmt.Errorf("listing ELBs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping ELBs (%s): %w", region, err)
 	}
+
 	return nil
 }
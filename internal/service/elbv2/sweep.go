@@ -41,84 +41,96 @@ func init() {
 }
 
 func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lb")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ELBV2Conn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	var sweeperErrs *multierror.Error
 	err = conn.DescribeLoadBalancersPagesWithContext(ctx, &elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
-		if page == nil || len(page.LoadBalancers) == 0 {
-			log.Print("[DEBUG] No LBs to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, loadBalancer := range page.LoadBalancers {
-			name := aws.StringValue(loadBalancer.LoadBalancerName)
-
-			log.Printf("[INFO] Deleting LB: %s", name)
-			_, err := conn.DeleteLoadBalancerWithContext(ctx, &elbv2.DeleteLoadBalancerInput{
-				LoadBalancerArn: loadBalancer.LoadBalancerArn,
-			})
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("failed to delete LB (%s): %w", name, err))
-				continue
-			}
+			r := ResourceLoadBalancer()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(loadBalancer.LoadBalancerArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(loadBalancer.LoadBalancerName)),
+				sweep.WithCreationTime(aws.TimeValue(loadBalancer.CreatedTime)),
+			))
 		}
+
 		return !lastPage
 	})
+
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping LB sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
 	}
+
+	if err != nil {
+		return fmt.Errorf("listing LBs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving LBs: %w", err))
+		return fmt.Errorf("sweeping LBs (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepTargetGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lb_target_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ELBV2Conn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeTargetGroupsPagesWithContext(ctx, &elbv2.DescribeTargetGroupsInput{}, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
-		if page == nil || len(page.TargetGroups) == 0 {
-			log.Print("[DEBUG] No LB Target Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, targetGroup := range page.TargetGroups {
-			name := aws.StringValue(targetGroup.TargetGroupName)
+			r := ResourceTargetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(targetGroup.TargetGroupArn))
 
-			log.Printf("[INFO] Deleting LB Target Group: %s", name)
-			_, err := conn.DeleteTargetGroupWithContext(ctx, &elbv2.DeleteTargetGroupInput{
-				TargetGroupArn: targetGroup.TargetGroupArn,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete LB Target Group (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.StringValue(targetGroup.TargetGroupName))))
 		}
+
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping LB Target Group sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping LB Target Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("retrieving LB Target Groups: %w", err)
+		return fmt.Errorf("listing LB Target Groups (%s): %w", region, err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping LB Target Groups (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lb_listener")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
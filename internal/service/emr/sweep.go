@@ -4,8 +4,10 @@
 package emr
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emr"
@@ -28,7 +30,7 @@ func init() {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_emr_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -46,21 +48,30 @@ func sweepClusters(region string) error {
 
 		for _, v := range page.Clusters {
 			id := aws.StringValue(v.Id)
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(id)
 
-			_, err := conn.SetTerminationProtectionWithContext(ctx, &emr.SetTerminationProtectionInput{
-				JobFlowIds:           aws.StringSlice([]string{id}),
-				TerminationProtected: aws.Bool(false),
-			})
+			// Termination protection must be unset before the cluster can be terminated.
+			deleteCluster := func(ctx context.Context) error {
+				_, err := conn.SetTerminationProtectionWithContext(ctx, &emr.SetTerminationProtectionInput{
+					JobFlowIds:           aws.StringSlice([]string{id}),
+					TerminationProtected: aws.Bool(false),
+				})
 
-			if err != nil {
-				log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				if err != nil {
+					log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				}
+
+				return sweep.DeleteResource(ctx, r, d, client)
 			}
 
-			r := ResourceCluster()
-			d := r.Data(nil)
-			d.SetId(id)
+			var creationTime time.Time
+			if v.Status != nil && v.Status.Timeline != nil {
+				creationTime = aws.TimeValue(v.Status.Timeline.CreationDateTime)
+			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, deleteCluster, sweep.WithName(aws.StringValue(v.Name)), sweep.WithCreationTime(creationTime)))
 		}
 
 		return !lastPage
@@ -85,7 +96,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepStudios(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_emr_studio")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -4,6 +4,7 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -68,19 +69,17 @@ func init() {
 }
 
 func sweepAPIDestination(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_event_api_destination")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn()
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &eventbridge.ListApiDestinationsInput{
 		Limit: aws.Int64(100),
 	}
-	var apiDestinations []*eventbridge.ApiDestination
 	for {
 		output, err := conn.ListApiDestinationsWithContext(ctx, input)
 
@@ -93,7 +92,18 @@ func sweepAPIDestination(region string) error {
 			return fmt.Errorf("Error retrieving EventBridge API Destinations: %w", err)
 		}
 
-		apiDestinations = append(apiDestinations, output.ApiDestinations...)
+		for _, apiDestination := range output.ApiDestinations {
+			name := aws.StringValue(apiDestination.Name)
+
+			r := ResourceAPIDestination()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(apiDestination.CreationTime)),
+			))
+		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
@@ -101,30 +111,23 @@ func sweepAPIDestination(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	for _, apiDestination := range apiDestinations {
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-		input := &eventbridge.DeleteApiDestinationInput{
-			Name: apiDestination.Name,
-		}
-		_, err := conn.DeleteApiDestinationWithContext(ctx, input)
-		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge Api Destination (%s): %w", *apiDestination.Name, err))
-			continue
-		}
+	if err != nil {
+		return fmt.Errorf("Error sweeping EventBridge API Destinations (%s): %w", region, err)
 	}
 
-	log.Printf("[INFO] Deleted %d EventBridge Api Destinations", len(apiDestinations))
-
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepArchives(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_event_archive")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &eventbridge.ListArchivesInput{}
 
@@ -140,24 +143,20 @@ func sweepArchives(region string) error {
 			return fmt.Errorf("Error retrieving EventBridge archive: %w", err)
 		}
 
-		if len(output.Archives) == 0 {
-			log.Print("[DEBUG] No EventBridge archives to sweep")
-			return nil
-		}
-
 		for _, archive := range output.Archives {
 			name := aws.StringValue(archive.ArchiveName)
 			if name == "default" {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting EventBridge archive (%s)", name)
-			_, err := conn.DeleteArchiveWithContext(ctx, &eventbridge.DeleteArchiveInput{
-				ArchiveName: aws.String(name),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting EventBridge archive (%s): %w", name, err)
-			}
+			r := ResourceArchive()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(archive.CreationTime)),
+			))
 		}
 
 		if output.NextToken == nil {
@@ -166,11 +165,17 @@ func sweepArchives(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("Error sweeping EventBridge archives (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepBuses(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_event_bus")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -217,19 +222,17 @@ func sweepBuses(region string) error {
 }
 
 func sweepConnection(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_event_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn()
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &eventbridge.ListConnectionsInput{
 		Limit: aws.Int64(100),
 	}
-	var connections []*eventbridge.Connection
 	for {
 		output, err := conn.ListConnectionsWithContext(ctx, input)
 
@@ -242,30 +245,36 @@ func sweepConnection(region string) error {
 			return fmt.Errorf("Error retrieving EventBridge Connections: %w", err)
 		}
 
+		for _, connection := range output.Connections {
+			name := aws.StringValue(connection.Name)
+
+			r := ResourceConnection()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(connection.CreationTime)),
+			))
+		}
+
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
 		input.NextToken = output.NextToken
 	}
 
-	for _, connection := range connections {
-		input := &eventbridge.DeleteConnectionInput{
-			Name: connection.Name,
-		}
-		_, err := conn.DeleteConnectionWithContext(ctx, input)
-		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge Connection (%s): %w", *connection.Name, err))
-			continue
-		}
-	}
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-	log.Printf("[INFO] Deleted %d EventBridge Connections", len(connections))
+	if err != nil {
+		return fmt.Errorf("Error sweeping EventBridge Connections (%s): %w", region, err)
+	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepPermissions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_event_permission")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -294,29 +303,34 @@ func sweepPermissions(region string) error {
 		return fmt.Errorf("Parsing EventBridge Permissions policy %q failed: %w", policy, err)
 	}
 
+	sweepResources := make([]sweep.Sweepable, 0)
+
 	for _, statement := range policyDoc.Statements {
-		sid := statement.Sid
+		r := ResourcePermission()
+		d := r.Data(nil)
+		d.SetId(PermissionCreateResourceID(DefaultEventBusName, statement.Sid))
 
-		log.Printf("[INFO] Deleting EventBridge Permission %s", sid)
-		_, err := conn.RemovePermissionWithContext(ctx, &eventbridge.RemovePermissionInput{
-			StatementId: aws.String(sid),
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting EventBridge Permission %s: %w", sid, err)
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(statement.Sid)))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("Error sweeping EventBridge Permissions (%s): %w", region, err)
 	}
 
 	return nil
 }
 
 func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_event_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn()
 	input := &eventbridge.ListEventBusesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	err = listEventBusesPages(ctx, conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
@@ -339,17 +353,17 @@ func sweepRules(region string) error {
 				for _, rule := range page.Rules {
 					ruleName := aws.StringValue(rule.Name)
 
-					log.Printf("[DEBUG] Deleting EventBridge Rule: %s/%s", eventBusName, ruleName)
-					_, err := conn.DeleteRuleWithContext(ctx, &eventbridge.DeleteRuleInput{
-						EventBusName: aws.String(eventBusName),
-						Force:        aws.Bool(true),
-						Name:         aws.String(ruleName),
-					})
+					deleteRule := func(ctx context.Context) error {
+						_, err := conn.DeleteRuleWithContext(ctx, &eventbridge.DeleteRuleInput{
+							EventBusName: aws.String(eventBusName),
+							Force:        aws.Bool(true),
+							Name:         aws.String(ruleName),
+						})
 
-					if err != nil {
-						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting EventBridge Rule (%s/%s): %w", eventBusName, ruleName, err))
-						continue
+						return err
 					}
+
+					sweepResources = append(sweepResources, sweep.NewSweepFunc(RuleCreateResourceID(eventBusName, ruleName), deleteRule, sweep.WithName(ruleName)))
 				}
 
 				return !lastPage
@@ -376,17 +390,22 @@ func sweepRules(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge event buses (%s): %w", region, err))
 	}
 
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EventBridge Rules (%s): %w", region, err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepTargets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_event_target")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn()
 	input := &eventbridge.ListEventBusesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	err = listEventBusesPages(ctx, conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
@@ -422,18 +441,18 @@ func sweepTargets(region string) error {
 						for _, target := range page.Targets {
 							targetID := aws.StringValue(target.Id)
 
-							log.Printf("[DEBUG] Deleting EventBridge Target: %s/%s/%s", eventBusName, ruleName, targetID)
-							_, err := conn.RemoveTargetsWithContext(ctx, &eventbridge.RemoveTargetsInput{
-								EventBusName: aws.String(eventBusName),
-								Force:        aws.Bool(true),
-								Ids:          aws.StringSlice([]string{targetID}),
-								Rule:         aws.String(ruleName),
-							})
-
-							if err != nil {
-								sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting EventBridge Target (%s/%s/%s): %w", eventBusName, ruleName, targetID, err))
-								continue
+							deleteTarget := func(ctx context.Context) error {
+								_, err := conn.RemoveTargetsWithContext(ctx, &eventbridge.RemoveTargetsInput{
+									EventBusName: aws.String(eventBusName),
+									Force:        aws.Bool(true),
+									Ids:          aws.StringSlice([]string{targetID}),
+									Rule:         aws.String(ruleName),
+								})
+
+								return err
 							}
+
+							sweepResources = append(sweepResources, sweep.NewSweepFunc(TargetCreateResourceID(eventBusName, ruleName, targetID), deleteTarget, sweep.WithName(targetID)))
 						}
 
 						return !lastPage
@@ -472,5 +491,9 @@ func sweepTargets(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge event buses (%s): %w", region, err))
 	}
 
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EventBridge Targets (%s): %w", region, err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
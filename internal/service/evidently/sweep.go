@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepProject(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_evidently_project")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
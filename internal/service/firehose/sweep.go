@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepDeliveryStreams(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_kinesis_firehose_delivery_stream")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
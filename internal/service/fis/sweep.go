@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepExperimentTemplates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fis_experiment_template")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
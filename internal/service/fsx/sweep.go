@@ -63,7 +63,7 @@ func init() {
 }
 
 func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_backup")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -108,7 +108,7 @@ func sweepBackups(region string) error {
 }
 
 func sweepLustreFileSystems(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_lustre_file_system")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -157,7 +157,7 @@ func sweepLustreFileSystems(region string) error {
 }
 
 func sweepOntapFileSystems(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_ontap_file_system")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -206,7 +206,7 @@ func sweepOntapFileSystems(region string) error {
 }
 
 func sweepOntapStorageVirtualMachine(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_ontap_storage_virtual_machine")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -251,7 +251,7 @@ func sweepOntapStorageVirtualMachine(region string) error {
 }
 
 func sweepOntapVolume(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_ontap_volume")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -303,7 +303,7 @@ func sweepOntapVolume(region string) error {
 }
 
 func sweepOpenZFSFileSystems(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_openzfs_file_system")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -352,7 +352,7 @@ func sweepOpenZFSFileSystems(region string) error {
 }
 
 func sweepOpenZFSVolume(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_openzfs_volume")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -404,7 +404,7 @@ func sweepOpenZFSVolume(region string) error {
 }
 
 func sweepWindowsFileSystems(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_fsx_windows_file_system")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
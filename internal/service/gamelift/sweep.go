@@ -55,30 +55,24 @@ func init() {
 }
 
 func sweepAliases(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_gamelift_alias")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GameLiftConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = listAliases(ctx, &gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
-		if len(resp.Aliases) == 0 {
-			log.Print("[DEBUG] No GameLift Aliases to sweep")
-			return nil
-		}
-
-		log.Printf("[INFO] Found %d GameLift Aliases", len(resp.Aliases))
-
 		for _, alias := range resp.Aliases {
-			log.Printf("[INFO] Deleting GameLift Alias %q", *alias.AliasId)
-			_, err := conn.DeleteAliasWithContext(ctx, &gamelift.DeleteAliasInput{
-				AliasId: alias.AliasId,
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting GameLift Alias (%s): %s",
-					*alias.AliasId, err)
-			}
+			r := ResourceAlias()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(alias.AliasId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(alias.Name)),
+				sweep.WithCreationTime(aws.TimeValue(alias.CreationTime)),
+			))
 		}
 		return nil
 	})
@@ -90,11 +84,17 @@ func sweepAliases(region string) error {
 		return fmt.Errorf("Error listing GameLift Aliases: %s", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Aliases (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepBuilds(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_gamelift_build")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -104,35 +104,36 @@ func sweepBuilds(region string) error {
 	resp, err := conn.ListBuildsWithContext(ctx, &gamelift.ListBuildsInput{})
 	if err != nil {
 		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Gamelife Build sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Gamelife Builds sweep for %s: %s", region, err)
 			return nil
 		}
-		return fmt.Errorf("Error listing GameLift Builds: %s", err)
+		return fmt.Errorf("Error listing GameLift : %s", err)
 	}
 
-	if len(resp.Builds) == 0 {
-		log.Print("[DEBUG] No GameLift Builds to sweep")
-		return nil
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for _, v := range resp.Builds {
+		r := ResourceBuild()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(v.BuildId))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+			sweep.WithName(aws.StringValue(v.Name)),
+			sweep.WithCreationTime(aws.TimeValue(v.CreationTime)),
+		))
 	}
 
-	log.Printf("[INFO] Found %d GameLift Builds", len(resp.Builds))
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-	for _, build := range resp.Builds {
-		log.Printf("[INFO] Deleting GameLift Build %q", *build.BuildId)
-		_, err := conn.DeleteBuildWithContext(ctx, &gamelift.DeleteBuildInput{
-			BuildId: build.BuildId,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting GameLift Build (%s): %s",
-				*build.BuildId, err)
-		}
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift  (%s): %w", region, err)
 	}
 
 	return nil
 }
 
 func sweepScripts(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_gamelift_script")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -142,35 +143,36 @@ func sweepScripts(region string) error {
 	resp, err := conn.ListScriptsWithContext(ctx, &gamelift.ListScriptsInput{})
 	if err != nil {
 		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Gamelife Script sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Gamelife Scripts sweep for %s: %s", region, err)
 			return nil
 		}
-		return fmt.Errorf("Error listing GameLift Scripts: %s", err)
+		return fmt.Errorf("Error listing GameLift : %s", err)
 	}
 
-	if len(resp.Scripts) == 0 {
-		log.Print("[DEBUG] No GameLift Scripts to sweep")
-		return nil
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for _, v := range resp.Scripts {
+		r := ResourceScript()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(v.ScriptId))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+			sweep.WithName(aws.StringValue(v.Name)),
+			sweep.WithCreationTime(aws.TimeValue(v.CreationTime)),
+		))
 	}
 
-	log.Printf("[INFO] Found %d GameLift Scripts", len(resp.Scripts))
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-	for _, build := range resp.Scripts {
-		log.Printf("[INFO] Deleting GameLift Script %q", *build.ScriptId)
-		_, err := conn.DeleteScriptWithContext(ctx, &gamelift.DeleteScriptInput{
-			ScriptId: build.ScriptId,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting GameLift Script (%s): %s",
-				*build.ScriptId, err)
-		}
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift  (%s): %w", region, err)
 	}
 
 	return nil
 }
 
 func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_gamelift_fleet")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -225,7 +227,7 @@ func sweepFleets(region string) error {
 }
 
 func sweepGameServerGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_gamelift_game_server_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -280,7 +282,7 @@ func sweepGameServerGroups(region string) error {
 }
 
 func sweepGameSessionQueue(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_gamelift_game_session_queue")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -298,22 +300,22 @@ func sweepGameSessionQueue(region string) error {
 		return fmt.Errorf("listing GameLift Session Queue: %s", err)
 	}
 
-	if len(out.GameSessionQueues) == 0 {
-		log.Print("[DEBUG] No GameLift Session Queue to sweep")
-		return nil
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for _, queue := range out.GameSessionQueues {
+		name := aws.StringValue(queue.Name)
+
+		r := ResourceGameSessionQueue()
+		d := r.Data(nil)
+		d.SetId(name)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name)))
 	}
 
-	log.Printf("[INFO] Found %d GameLift Session Queue", len(out.GameSessionQueues))
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-	for _, queue := range out.GameSessionQueues {
-		log.Printf("[INFO] Deleting GameLift Session Queue %q", *queue.Name)
-		_, err := conn.DeleteGameSessionQueueWithContext(ctx, &gamelift.DeleteGameSessionQueueInput{
-			Name: aws.String(*queue.Name),
-		})
-		if err != nil {
-			return fmt.Errorf("deleting GameLift Session Queue (%s): %s",
-				*queue.Name, err)
-		}
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Session Queues (%s): %w", region, err)
 	}
 
 	return nil
@@ -39,7 +39,7 @@ func init() {
 }
 
 func sweepAccelerators(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_globalaccelerator_accelerator")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -83,7 +83,7 @@ func sweepAccelerators(region string) error {
 }
 
 func sweepEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_globalaccelerator_endpoint_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -172,7 +172,7 @@ func sweepEndpointGroups(region string) error {
 }
 
 func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_globalaccelerator_listener")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
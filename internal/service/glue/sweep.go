@@ -80,7 +80,7 @@ func init() {
 }
 
 func sweepCatalogDatabases(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_catalog_database")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -127,7 +127,7 @@ func sweepCatalogDatabases(region string) error {
 }
 
 func sweepClassifiers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_classifier")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -184,7 +184,7 @@ func sweepClassifiers(region string) error {
 }
 
 func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -231,7 +231,7 @@ func sweepConnections(region string) error {
 }
 
 func sweepCrawlers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_crawler")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -274,7 +274,7 @@ func sweepCrawlers(region string) error {
 }
 
 func sweepDevEndpoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_dev_endpoint")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -324,7 +324,7 @@ func sweepDevEndpoints(region string) error {
 }
 
 func sweepJobs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_job")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -368,7 +368,7 @@ func sweepJobs(region string) error {
 }
 
 func sweepMLTransforms(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_ml_transform")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -412,7 +412,7 @@ func sweepMLTransforms(region string) error {
 }
 
 func sweepRegistry(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_registry")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -448,7 +448,7 @@ func sweepRegistry(region string) error {
 }
 
 func sweepSchema(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_schema")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -484,12 +484,13 @@ func sweepSchema(region string) error {
 }
 
 func sweepSecurityConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_security_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &glue.GetSecurityConfigurationsInput{}
 
@@ -508,11 +509,14 @@ func sweepSecurityConfigurations(region string) error {
 		for _, securityConfiguration := range output.SecurityConfigurations {
 			name := aws.StringValue(securityConfiguration.Name)
 
-			log.Printf("[INFO] Deleting Glue Security Configuration: %s", name)
-			err := DeleteSecurityConfiguration(ctx, conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Security Configuration %s: %s", name, err)
-			}
+			r := ResourceSecurityConfiguration()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(securityConfiguration.CreatedTimeStamp)),
+			))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -522,11 +526,17 @@ func sweepSecurityConfigurations(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Glue Security Configurations (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepTriggers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_trigger")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -570,7 +580,7 @@ func sweepTriggers(region string) error {
 }
 
 func sweepWorkflow(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_glue_workflow")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -586,11 +596,24 @@ func sweepWorkflow(region string) error {
 		}
 		return fmt.Errorf("Error retrieving Glue Workflow: %s", err)
 	}
+
+	sweepResources := make([]sweep.Sweepable, 0)
+
 	for _, workflowName := range listOutput.Workflows {
-		err := DeleteWorkflow(ctx, conn, *workflowName)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Glue Workflow %s: %s", *workflowName, err)
-		}
+		name := aws.StringValue(workflowName)
+
+		r := ResourceWorkflow()
+		d := r.Data(nil)
+		d.SetId(name)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name)))
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Glue Workflows (%s): %w", region, err)
+	}
+
 	return nil
 }
@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepWorkSpaces(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_grafana_workspace")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -4,6 +4,7 @@
 package guardduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -30,7 +31,7 @@ func init() {
 }
 
 func sweepDetectors(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_guardduty_detector")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -39,26 +40,30 @@ func sweepDetectors(region string) error {
 
 	conn := client.(*conns.AWSClient).GuardDutyConn()
 	input := &guardduty.ListDetectorsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListDetectorsPagesWithContext(ctx, input, func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, detectorID := range page.DetectorIds {
 			id := aws.StringValue(detectorID)
-			input := &guardduty.DeleteDetectorInput{
-				DetectorId: detectorID,
-			}
 
-			log.Printf("[INFO] Deleting GuardDuty Detector: %s", id)
-			_, err := conn.DeleteDetectorWithContext(ctx, input)
-			if tfawserr.ErrCodeContains(err, "AccessDenied") {
-				log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", id, err)
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting GuardDuty Detector (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			deleteDetector := func(ctx context.Context) error {
+				_, err := conn.DeleteDetectorWithContext(ctx, &guardduty.DeleteDetectorInput{
+					DetectorId: aws.String(id),
+				})
+
+				if tfawserr.ErrCodeContains(err, "AccessDenied") {
+					log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", id, err)
+					return nil
+				}
+
+				return err
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, deleteDetector))
 		}
 
 		return !lastPage
@@ -73,11 +78,17 @@ func sweepDetectors(region string) error {
 		return fmt.Errorf("error retrieving GuardDuty Detectors: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Detectors (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepPublishingDestinations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_guardduty_publishing_destination")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -85,51 +96,54 @@ func sweepPublishingDestinations(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).GuardDutyConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	detect_input := &guardduty.ListDetectorsInput{}
 
 	err = conn.ListDetectorsPagesWithContext(ctx, detect_input, func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, detectorID := range page.DetectorIds {
 			list_input := &guardduty.ListPublishingDestinationsInput{
 				DetectorId: detectorID,
 			}
 
-			err = conn.ListPublishingDestinationsPagesWithContext(ctx, list_input, func(page *guardduty.ListPublishingDestinationsOutput, lastPage bool) bool {
+			err := conn.ListPublishingDestinationsPagesWithContext(ctx, list_input, func(page *guardduty.ListPublishingDestinationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
 				for _, destination_element := range page.Destinations {
-					input := &guardduty.DeletePublishingDestinationInput{
-						DestinationId: destination_element.DestinationId,
-						DetectorId:    detectorID,
-					}
-
-					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
-					_, err := conn.DeletePublishingDestinationWithContext(ctx, input)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %w", *destination_element.DestinationId, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
+					r := ResourcePublishingDestination()
+					d := r.Data(nil)
+					d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(detectorID), aws.StringValue(destination_element.DestinationId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 				return !lastPage
 			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing GuardDuty Publishing Destinations (%s): %w", aws.StringValue(detectorID), err))
+			}
 		}
 		return !lastPage
 	})
 
-	if err != nil {
-		sweeperErr := fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-	}
-
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving GuardDuty Publishing Destinations: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping GuardDuty Publishing Destinations (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
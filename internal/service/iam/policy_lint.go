@@ -0,0 +1,498 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	policyLintFindingTypeError           = "ERROR"
+	policyLintFindingTypeSecurityWarning = "SECURITY_WARNING"
+	policyLintFindingTypeSuggestion      = "SUGGESTION"
+	policyLintFindingTypeWarning         = "WARNING"
+)
+
+const (
+	policyLintPolicyTypeIdentity       = "IDENTITY_POLICY"
+	policyLintPolicyTypeResource       = "RESOURCE_POLICY"
+	policyLintPolicyTypeServiceControl = "SERVICE_CONTROL_POLICY"
+)
+
+func policyLintPolicyType_Values() []string {
+	return []string{
+		policyLintPolicyTypeIdentity,
+		policyLintPolicyTypeResource,
+		policyLintPolicyTypeServiceControl,
+	}
+}
+
+const (
+	// Managed policy size limit, excluding whitespace.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+	policyLintIdentityPolicyMaxSize = 6144
+	// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html
+	policyLintServiceControlPolicyMaxSize = 5120
+)
+
+// policyLintStatementIndexPolicy is the statement index of findings that apply to the whole policy document.
+const policyLintStatementIndexPolicy = -1
+
+type policyLintFinding struct {
+	FindingType    string
+	IssueCode      string
+	Message        string
+	Sid            string
+	StatementIndex int
+}
+
+type policyLinter struct {
+	findings   []policyLintFinding
+	policyType string
+}
+
+var (
+	policyLintConditionOperators = []string{
+		"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals", "DateGreaterThan", "DateGreaterThanEquals", "DateLessThan", "DateLessThanEquals", "DateNotEquals",
+		"IpAddress", "NotIpAddress",
+		"Null",
+		"NumericEquals", "NumericGreaterThan", "NumericGreaterThanEquals", "NumericLessThan", "NumericLessThanEquals", "NumericNotEquals",
+		"StringEquals", "StringEqualsIgnoreCase", "StringLike", "StringNotEquals", "StringNotEqualsIgnoreCase", "StringNotLike",
+	}
+	policyLintPolicyElements    = []string{"Id", "Statement", "Version"}
+	policyLintStatementElements = []string{"Action", "Condition", "Effect", "NotAction", "NotPrincipal", "NotResource", "Principal", "Resource", "Sid"}
+	policyLintActionRegexp      = regexp.MustCompile(`(?i)^[a-z0-9-]+:[a-z0-9*?]+$`)
+)
+
+// lintPolicy statically checks an IAM policy document without calling AWS.
+// Findings are returned in document order; findings that apply to the whole document come first.
+func lintPolicy(policy, policyType string) []policyLintFinding {
+	l := &policyLinter{
+		policyType: policyType,
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		l.add(policyLintStatementIndexPolicy, "", policyLintFindingTypeError, "JSON_SYNTAX_ERROR", "Policy document must be a JSON object: %s", err)
+		return l.findings
+	}
+
+	l.lintSize(policy)
+
+	for _, k := range policyLintSortedKeys(doc) {
+		if !policyLintContains(policyLintPolicyElements, k) {
+			l.add(policyLintStatementIndexPolicy, "", policyLintFindingTypeError, "INVALID_ELEMENT", "%q is not a valid policy element", k)
+		}
+	}
+
+	switch v := doc["Version"]; v {
+	case nil:
+		l.add(policyLintStatementIndexPolicy, "", policyLintFindingTypeWarning, "MISSING_VERSION", "Add a Version element with the value \"2012-10-17\"; without it policy variables are not supported")
+	case "2012-10-17":
+	case "2008-10-17":
+		l.add(policyLintStatementIndexPolicy, "", policyLintFindingTypeSuggestion, "OLD_VERSION", "Version \"2008-10-17\" does not support policy variables; use \"2012-10-17\"")
+	default:
+		l.add(policyLintStatementIndexPolicy, "", policyLintFindingTypeError, "INVALID_VERSION", "Version %v is not valid; use \"2012-10-17\"", v)
+	}
+
+	var statements []interface{}
+	switch v := doc["Statement"].(type) {
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	case []interface{}:
+		statements = v
+	}
+
+	if len(statements) == 0 {
+		l.add(policyLintStatementIndexPolicy, "", policyLintFindingTypeError, "MISSING_STATEMENT", "Policy document must contain at least one statement")
+		return l.findings
+	}
+
+	sids := make(map[string]int)
+	for i, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok {
+			l.add(i, "", policyLintFindingTypeError, "INVALID_STATEMENT", "Statement must be a JSON object")
+			continue
+		}
+
+		sid, _ := statement["Sid"].(string)
+
+		if sid != "" {
+			if j, ok := sids[sid]; ok {
+				l.add(i, sid, policyLintFindingTypeError, "DUPLICATE_SID", "Sid %q is also used by statement %d", sid, j)
+			} else {
+				sids[sid] = i
+			}
+		}
+
+		l.lintStatement(i, sid, statement)
+	}
+
+	return l.findings
+}
+
+func (l *policyLinter) add(index int, sid, findingType, issueCode, format string, a ...interface{}) {
+	l.findings = append(l.findings, policyLintFinding{
+		FindingType:    findingType,
+		IssueCode:      issueCode,
+		Message:        fmt.Sprintf(format, a...),
+		Sid:            sid,
+		StatementIndex: index,
+	})
+}
+
+func (l *policyLinter) lintSize(policy string) {
+	var limit int
+	switch l.policyType {
+	case policyLintPolicyTypeIdentity:
+		limit = policyLintIdentityPolicyMaxSize
+	case policyLintPolicyTypeServiceControl:
+		limit = policyLintServiceControlPolicyMaxSize
+	default:
+		// Resource policy size limits vary by service.
+		return
+	}
+
+	size := 0
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			size++
+		}
+	}
+
+	if size > limit {
+		l.add(policyLintStatementIndexPolicy, "", policyLintFindingTypeError, "POLICY_SIZE_EXCEEDED", "Policy document is %d characters (excluding whitespace), which exceeds the %s limit of %d", size, l.policyType, limit)
+	}
+}
+
+func (l *policyLinter) lintStatement(i int, sid string, statement map[string]interface{}) {
+	for _, k := range policyLintSortedKeys(statement) {
+		if !policyLintContains(policyLintStatementElements, k) {
+			l.add(i, sid, policyLintFindingTypeError, "INVALID_ELEMENT", "%q is not a valid statement element", k)
+		}
+	}
+
+	effect, _ := statement["Effect"].(string)
+	allow := effect == "Allow"
+
+	if effect != "Allow" && effect != "Deny" {
+		l.add(i, sid, policyLintFindingTypeError, "INVALID_EFFECT", "Effect must be \"Allow\" or \"Deny\", got %v", statement["Effect"])
+	}
+
+	actions, hasAction := policyLintStrings(statement["Action"])
+	notActions, hasNotAction := policyLintStrings(statement["NotAction"])
+
+	switch {
+	case hasAction && hasNotAction:
+		l.add(i, sid, policyLintFindingTypeError, "ACTION_AND_NOT_ACTION", "Statement cannot contain both Action and NotAction")
+	case !hasAction && !hasNotAction:
+		l.add(i, sid, policyLintFindingTypeError, "MISSING_ACTION", "Statement must contain Action or NotAction")
+	}
+
+	for _, v := range append(actions, notActions...) {
+		l.lintAction(i, sid, v)
+	}
+
+	if allow && hasNotAction {
+		l.add(i, sid, policyLintFindingTypeSecurityWarning, "ALLOW_WITH_NOT_ACTION", "Using NotAction with Allow grants every action not listed, including actions of services added in the future; list the allowed actions instead")
+	}
+
+	resources, hasResource := policyLintStrings(statement["Resource"])
+	notResources, hasNotResource := policyLintStrings(statement["NotResource"])
+
+	switch {
+	case hasResource && hasNotResource:
+		l.add(i, sid, policyLintFindingTypeError, "RESOURCE_AND_NOT_RESOURCE", "Statement cannot contain both Resource and NotResource")
+	case !hasResource && !hasNotResource && l.policyType != policyLintPolicyTypeResource:
+		l.add(i, sid, policyLintFindingTypeError, "MISSING_RESOURCE", "Statement must contain Resource or NotResource")
+	}
+
+	for _, v := range append(resources, notResources...) {
+		if v != "*" && !strings.HasPrefix(v, "arn:") {
+			l.add(i, sid, policyLintFindingTypeError, "INVALID_RESOURCE", "Resource %q must be \"*\" or an ARN", v)
+		}
+	}
+
+	if allow && hasNotResource {
+		l.add(i, sid, policyLintFindingTypeWarning, "ALLOW_WITH_NOT_RESOURCE", "Using NotResource with Allow grants access to every resource not listed; list the allowed resources instead")
+	}
+
+	if allow && policyLintContains(resources, "*") {
+		for _, action := range actions {
+			if matches := policyLintSensitiveMatches(action); len(matches) > 0 {
+				l.add(i, sid, policyLintFindingTypeSecurityWarning, "SENSITIVE_ACTION_ON_ALL_RESOURCES", "Action %q allows sensitive actions (%s) on all resources; restrict Resource to specific ARNs", action, strings.Join(matches, ", "))
+			}
+		}
+	}
+
+	_, hasPrincipal := statement["Principal"]
+	_, hasNotPrincipal := statement["NotPrincipal"]
+	_, hasCondition := statement["Condition"]
+
+	switch {
+	case l.policyType != policyLintPolicyTypeResource:
+		if hasPrincipal || hasNotPrincipal {
+			l.add(i, sid, policyLintFindingTypeError, "PRINCIPAL_NOT_SUPPORTED", "Principal and NotPrincipal are not supported in a %s", l.policyType)
+		}
+	case hasPrincipal && hasNotPrincipal:
+		l.add(i, sid, policyLintFindingTypeError, "PRINCIPAL_AND_NOT_PRINCIPAL", "Statement cannot contain both Principal and NotPrincipal")
+	case !hasPrincipal && !hasNotPrincipal:
+		l.add(i, sid, policyLintFindingTypeError, "MISSING_PRINCIPAL", "Statement in a resource policy must contain Principal or NotPrincipal")
+	case hasNotPrincipal && allow:
+		l.add(i, sid, policyLintFindingTypeSecurityWarning, "ALLOW_WITH_NOT_PRINCIPAL", "Using NotPrincipal with Allow grants access to every principal not listed, including anonymous users")
+	case hasNotPrincipal:
+		l.add(i, sid, policyLintFindingTypeWarning, "DENY_WITH_NOT_PRINCIPAL", "Using NotPrincipal with Deny denies every principal not listed; the ARNs of the account, role and assumed-role session must all be listed to avoid locking out the listed principal")
+	case allow && !hasCondition && policyLintIsPublicPrincipal(statement["Principal"]):
+		l.add(i, sid, policyLintFindingTypeSecurityWarning, "PUBLIC_ACCESS", "Principal \"*\" without a Condition grants access to anyone")
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		l.lintCondition(i, sid, v)
+	}
+}
+
+func (l *policyLinter) lintAction(i int, sid, action string) {
+	if action == "*" {
+		return
+	}
+
+	if !policyLintActionRegexp.MatchString(action) {
+		l.add(i, sid, policyLintFindingTypeError, "INVALID_ACTION", "Action %q must be \"*\" or of the form \"service:action\"", action)
+		return
+	}
+
+	service, name, _ := strings.Cut(action, ":")
+	service = strings.ToLower(service)
+	catalog, ok := policyLintServiceActions[service]
+
+	// The catalog may lag behind new services, so an unknown prefix isn't necessarily an error.
+	if !ok {
+		l.add(i, sid, policyLintFindingTypeWarning, "UNKNOWN_SERVICE", "Action %q uses service prefix %q, which is not in the bundled catalog; check that it is correct", action, service)
+		return
+	}
+
+	if catalog == nil {
+		return
+	}
+
+	for _, v := range catalog {
+		if policyLintWildcardMatch(name, v) {
+			return
+		}
+	}
+
+	l.add(i, sid, policyLintFindingTypeError, "UNKNOWN_ACTION", "Action %q does not match any %s action", action, service)
+}
+
+func (l *policyLinter) lintCondition(i int, sid string, v interface{}) {
+	conditions, ok := v.(map[string]interface{})
+
+	if !ok {
+		l.add(i, sid, policyLintFindingTypeError, "INVALID_CONDITION", "Condition must be a JSON object")
+		return
+	}
+
+	for _, operator := range policyLintSortedKeys(conditions) {
+		base, ok := policyLintConditionOperatorBase(operator)
+
+		if !ok {
+			l.add(i, sid, policyLintFindingTypeError, "INVALID_CONDITION_OPERATOR", "Condition operator %q is not valid", operator)
+			continue
+		}
+
+		keys, ok := conditions[operator].(map[string]interface{})
+
+		if !ok {
+			l.add(i, sid, policyLintFindingTypeError, "INVALID_CONDITION", "Condition operator %q must map condition keys to values", operator)
+			continue
+		}
+
+		for _, key := range policyLintSortedKeys(keys) {
+			values, ok := policyLintConditionValues(keys[key])
+
+			if !ok {
+				l.add(i, sid, policyLintFindingTypeError, "INVALID_CONDITION_VALUE", "Condition %s %q values must be strings, numbers or booleans", operator, key)
+				continue
+			}
+
+			for _, value := range values {
+				if err := policyLintValidateConditionValue(base, value); err != nil {
+					l.add(i, sid, policyLintFindingTypeError, "INVALID_CONDITION_VALUE", "Condition %s %q value %q %s", operator, key, value, err)
+				}
+			}
+		}
+	}
+}
+
+// policyLintConditionOperatorBase returns the condition operator without any set qualifier (ForAllValues:, ForAnyValue:) or IfExists suffix.
+func policyLintConditionOperatorBase(operator string) (string, bool) {
+	base := operator
+	base = strings.TrimPrefix(base, "ForAllValues:")
+	base = strings.TrimPrefix(base, "ForAnyValue:")
+
+	if v := strings.TrimSuffix(base, "IfExists"); v != base {
+		if v == "Null" {
+			return "", false
+		}
+		base = v
+	}
+
+	return base, policyLintContains(policyLintConditionOperators, base)
+}
+
+func policyLintValidateConditionValue(operator, value string) error {
+	switch {
+	case operator == "Bool" || operator == "Null":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("must be \"true\" or \"false\"")
+		}
+	case strings.HasPrefix(operator, "Numeric"):
+		if policyLintHasVariable(value) {
+			return nil
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("must be a number")
+		}
+	case strings.HasPrefix(operator, "Date"):
+		if policyLintHasVariable(value) {
+			return nil
+		}
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nil
+		}
+		if _, err := time.Parse(time.RFC3339, value); err == nil {
+			return nil
+		}
+		if _, err := time.Parse("2006-01-02", value); err == nil {
+			return nil
+		}
+		return fmt.Errorf("must be an ISO 8601 date or epoch time")
+	case operator == "IpAddress" || operator == "NotIpAddress":
+		if policyLintHasVariable(value) || net.ParseIP(value) != nil {
+			return nil
+		}
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return fmt.Errorf("must be an IP address or CIDR block")
+		}
+	}
+
+	return nil
+}
+
+func policyLintHasVariable(s string) bool {
+	return strings.Contains(s, "${")
+}
+
+// policyLintStrings returns a policy element's values, which may be a single string or an array of strings.
+func policyLintStrings(v interface{}) ([]string, bool) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true
+	case []interface{}:
+		var out []string
+		for _, v := range v {
+			if s, ok := v.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out, true
+	}
+
+	return nil, false
+}
+
+// policyLintConditionValues returns condition values, which may be a single value or an array of values.
+func policyLintConditionValues(v interface{}) ([]string, bool) {
+	var in []interface{}
+	switch v := v.(type) {
+	case []interface{}:
+		in = v
+	default:
+		in = []interface{}{v}
+	}
+
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		switch v := v.(type) {
+		case string:
+			out = append(out, v)
+		case bool:
+			out = append(out, strconv.FormatBool(v))
+		case float64:
+			out = append(out, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			return nil, false
+		}
+	}
+
+	return out, true
+}
+
+func policyLintIsPublicPrincipal(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return v == "*"
+	case map[string]interface{}:
+		values, _ := policyLintStrings(v["AWS"])
+		return policyLintContains(values, "*")
+	}
+
+	return false
+}
+
+// policyLintSensitiveMatches returns the sensitive actions matched by an action pattern.
+func policyLintSensitiveMatches(action string) []string {
+	if action == "*" {
+		return []string{"all actions"}
+	}
+
+	var matches []string
+	for _, v := range policyLintSensitiveActions {
+		if policyLintWildcardMatch(action, v) {
+			matches = append(matches, v)
+		}
+	}
+
+	return matches
+}
+
+// policyLintWildcardMatch returns whether s matches pattern, which may contain the * and ? wildcards.
+// Matching is case-insensitive.
+func policyLintWildcardMatch(pattern, s string) bool {
+	expr := regexp.QuoteMeta(strings.ToLower(pattern))
+	expr = strings.ReplaceAll(expr, `\*`, `.*`)
+	expr = strings.ReplaceAll(expr, `\?`, `.`)
+
+	return regexp.MustCompile(`^` + expr + `$`).MatchString(strings.ToLower(s))
+}
+
+func policyLintContains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyLintSortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,528 @@
+package iam
+
+// policyLintServiceActions is the bundled service-action catalog used by the IAM policy linter.
+// Each key is a service prefix as used in policy Action elements.
+// A nil value means the service prefix is known but its actions are not catalogued, so only the prefix is checked.
+// Actions are catalogued for dynamodb, kms, secretsmanager, sns, sqs and sts; keep the list in website/docs/d/iam_policy_lint.html.markdown in sync.
+// Action names are matched case-insensitively.
+var policyLintServiceActions = map[string][]string{
+	"access-analyzer":           nil,
+	"account":                   nil,
+	"acm":                       nil,
+	"acm-pca":                   nil,
+	"airflow":                   nil,
+	"amplify":                   nil,
+	"amplifyuibuilder":          nil,
+	"aoss":                      nil,
+	"apigateway":                nil,
+	"app-integrations":          nil,
+	"appconfig":                 nil,
+	"appflow":                   nil,
+	"application-autoscaling":   nil,
+	"application-cost-profiler": nil,
+	"applicationinsights":       nil,
+	"appmesh":                   nil,
+	"apprunner":                 nil,
+	"appstream":                 nil,
+	"appsync":                   nil,
+	"aps":                       nil,
+	"arc-zonal-shift":           nil,
+	"artifact":                  nil,
+	"athena":                    nil,
+	"auditmanager":              nil,
+	"autoscaling":               nil,
+	"aws-marketplace":           nil,
+	"aws-portal":                nil,
+	"backup":                    nil,
+	"batch":                     nil,
+	"bedrock":                   nil,
+	"billingconductor":          nil,
+	"braket":                    nil,
+	"budgets":                   nil,
+	"cassandra":                 nil,
+	"ce":                        nil,
+	"chatbot":                   nil,
+	"chime":                     nil,
+	"cleanrooms":                nil,
+	"cloud9":                    nil,
+	"cloudcontrolapi":           nil,
+	"cloudformation":            nil,
+	"cloudfront":                nil,
+	"cloudhsm":                  nil,
+	"cloudsearch":               nil,
+	"cloudshell":                nil,
+	"cloudtrail":                nil,
+	"cloudwatch":                nil,
+	"codeartifact":              nil,
+	"codebuild":                 nil,
+	"codecommit":                nil,
+	"codedeploy":                nil,
+	"codeguru-profiler":         nil,
+	"codeguru-reviewer":         nil,
+	"codepipeline":              nil,
+	"codestar":                  nil,
+	"codestar-connections":      nil,
+	"codestar-notifications":    nil,
+	"codewhisperer":             nil,
+	"cognito-identity":          nil,
+	"cognito-idp":               nil,
+	"cognito-sync":              nil,
+	"comprehend":                nil,
+	"compute-optimizer":         nil,
+	"config":                    nil,
+	"connect":                   nil,
+	"controltower":              nil,
+	"cur":                       nil,
+	"databrew":                  nil,
+	"dataexchange":              nil,
+	"datapipeline":              nil,
+	"datasync":                  nil,
+	"datazone":                  nil,
+	"dax":                       nil,
+	"deepracer":                 nil,
+	"detective":                 nil,
+	"devicefarm":                nil,
+	"devops-guru":               nil,
+	"directconnect":             nil,
+	"discovery":                 nil,
+	"dlm":                       nil,
+	"dms":                       nil,
+	"drs":                       nil,
+	"ds":                        nil,
+	"dynamodb": {
+		"BatchGetItem",
+		"BatchWriteItem",
+		"ConditionCheckItem",
+		"CreateBackup",
+		"CreateGlobalTable",
+		"CreateTable",
+		"CreateTableReplica",
+		"DeleteBackup",
+		"DeleteItem",
+		"DeleteTable",
+		"DeleteTableReplica",
+		"DescribeBackup",
+		"DescribeContinuousBackups",
+		"DescribeContributorInsights",
+		"DescribeEndpoints",
+		"DescribeExport",
+		"DescribeGlobalTable",
+		"DescribeGlobalTableSettings",
+		"DescribeImport",
+		"DescribeKinesisStreamingDestination",
+		"DescribeLimits",
+		"DescribeReservedCapacity",
+		"DescribeReservedCapacityOfferings",
+		"DescribeStream",
+		"DescribeTable",
+		"DescribeTableReplicaAutoScaling",
+		"DescribeTimeToLive",
+		"DisableKinesisStreamingDestination",
+		"EnableKinesisStreamingDestination",
+		"ExportTableToPointInTime",
+		"GetItem",
+		"GetRecords",
+		"GetShardIterator",
+		"ImportTable",
+		"ListBackups",
+		"ListContributorInsights",
+		"ListExports",
+		"ListGlobalTables",
+		"ListImports",
+		"ListStreams",
+		"ListTables",
+		"ListTagsOfResource",
+		"PartiQLDelete",
+		"PartiQLInsert",
+		"PartiQLSelect",
+		"PartiQLUpdate",
+		"PurchaseReservedCapacityOfferings",
+		"PutItem",
+		"Query",
+		"RestoreTableFromAwsBackup",
+		"RestoreTableFromBackup",
+		"RestoreTableToPointInTime",
+		"Scan",
+		"TagResource",
+		"UntagResource",
+		"UpdateContinuousBackups",
+		"UpdateContributorInsights",
+		"UpdateGlobalTable",
+		"UpdateGlobalTableSettings",
+		"UpdateGlobalTableVersion",
+		"UpdateItem",
+		"UpdateTable",
+		"UpdateTableReplicaAutoScaling",
+		"UpdateTimeToLive",
+	},
+	"ebs":                   nil,
+	"ec2":                   nil,
+	"ec2-instance-connect":  nil,
+	"ec2messages":           nil,
+	"ecr":                   nil,
+	"ecr-public":            nil,
+	"ecs":                   nil,
+	"eks":                   nil,
+	"elasticache":           nil,
+	"elasticbeanstalk":      nil,
+	"elasticfilesystem":     nil,
+	"elasticloadbalancing":  nil,
+	"elasticmapreduce":      nil,
+	"elastictranscoder":     nil,
+	"elemental-activations": nil,
+	"emr-containers":        nil,
+	"emr-serverless":        nil,
+	"es":                    nil,
+	"events":                nil,
+	"evidently":             nil,
+	"execute-api":           nil,
+	"firehose":              nil,
+	"fms":                   nil,
+	"forecast":              nil,
+	"frauddetector":         nil,
+	"freertos":              nil,
+	"fsx":                   nil,
+	"gamelift":              nil,
+	"geo":                   nil,
+	"glacier":               nil,
+	"globalaccelerator":     nil,
+	"glue":                  nil,
+	"grafana":               nil,
+	"greengrass":            nil,
+	"groundstation":         nil,
+	"guardduty":             nil,
+	"health":                nil,
+	"healthlake":            nil,
+	"iam":                   nil,
+	"identity-sync":         nil,
+	"identitystore":         nil,
+	"imagebuilder":          nil,
+	"inspector":             nil,
+	"inspector2":            nil,
+	"internetmonitor":       nil,
+	"iot":                   nil,
+	"iotanalytics":          nil,
+	"iotevents":             nil,
+	"iotsitewise":           nil,
+	"iottwinmaker":          nil,
+	"iotwireless":           nil,
+	"ivs":                   nil,
+	"ivschat":               nil,
+	"kafka":                 nil,
+	"kafka-cluster":         nil,
+	"kafkaconnect":          nil,
+	"kendra":                nil,
+	"kinesis":               nil,
+	"kinesisanalytics":      nil,
+	"kinesisvideo":          nil,
+	"kms": {
+		"CancelKeyDeletion",
+		"ConnectCustomKeyStore",
+		"CreateAlias",
+		"CreateCustomKeyStore",
+		"CreateGrant",
+		"CreateKey",
+		"Decrypt",
+		"DeleteAlias",
+		"DeleteCustomKeyStore",
+		"DeleteImportedKeyMaterial",
+		"DescribeCustomKeyStores",
+		"DescribeKey",
+		"DisableKey",
+		"DisableKeyRotation",
+		"DisconnectCustomKeyStore",
+		"EnableKey",
+		"EnableKeyRotation",
+		"Encrypt",
+		"GenerateDataKey",
+		"GenerateDataKeyPair",
+		"GenerateDataKeyPairWithoutPlaintext",
+		"GenerateDataKeyWithoutPlaintext",
+		"GenerateMac",
+		"GenerateRandom",
+		"GetKeyPolicy",
+		"GetKeyRotationStatus",
+		"GetParametersForImport",
+		"GetPublicKey",
+		"ImportKeyMaterial",
+		"ListAliases",
+		"ListGrants",
+		"ListKeyPolicies",
+		"ListKeys",
+		"ListResourceTags",
+		"ListRetirableGrants",
+		"PutKeyPolicy",
+		"ReEncryptFrom",
+		"ReEncryptTo",
+		"ReplicateKey",
+		"RetireGrant",
+		"RevokeGrant",
+		"ScheduleKeyDeletion",
+		"Sign",
+		"SynchronizeMultiRegionKey",
+		"TagResource",
+		"UntagResource",
+		"UpdateAlias",
+		"UpdateCustomKeyStore",
+		"UpdateKeyDescription",
+		"UpdatePrimaryRegion",
+		"Verify",
+		"VerifyMac",
+	},
+	"lakeformation":                   nil,
+	"lambda":                          nil,
+	"lex":                             nil,
+	"license-manager":                 nil,
+	"lightsail":                       nil,
+	"logs":                            nil,
+	"lookoutequipment":                nil,
+	"lookoutmetrics":                  nil,
+	"lookoutvision":                   nil,
+	"m2":                              nil,
+	"macie2":                          nil,
+	"managedblockchain":               nil,
+	"mediaconnect":                    nil,
+	"mediaconvert":                    nil,
+	"medialive":                       nil,
+	"mediapackage":                    nil,
+	"mediapackagev2":                  nil,
+	"mediastore":                      nil,
+	"mediatailor":                     nil,
+	"memorydb":                        nil,
+	"mgn":                             nil,
+	"mobiletargeting":                 nil,
+	"mq":                              nil,
+	"neptune-db":                      nil,
+	"neptune-graph":                   nil,
+	"network-firewall":                nil,
+	"networkmanager":                  nil,
+	"notifications":                   nil,
+	"oam":                             nil,
+	"omics":                           nil,
+	"opensearch":                      nil,
+	"organizations":                   nil,
+	"osis":                            nil,
+	"outposts":                        nil,
+	"personalize":                     nil,
+	"pi":                              nil,
+	"pipes":                           nil,
+	"polly":                           nil,
+	"pricing":                         nil,
+	"profile":                         nil,
+	"proton":                          nil,
+	"q":                               nil,
+	"qbusiness":                       nil,
+	"qldb":                            nil,
+	"quicksight":                      nil,
+	"ram":                             nil,
+	"rbin":                            nil,
+	"rds":                             nil,
+	"rds-data":                        nil,
+	"rds-db":                          nil,
+	"redshift":                        nil,
+	"redshift-data":                   nil,
+	"refactor-spaces":                 nil,
+	"rekognition":                     nil,
+	"resiliencehub":                   nil,
+	"resource-explorer-2":             nil,
+	"resource-groups":                 nil,
+	"rolesanywhere":                   nil,
+	"route53":                         nil,
+	"route53-recovery-cluster":        nil,
+	"route53-recovery-control-config": nil,
+	"route53-recovery-readiness":      nil,
+	"route53domains":                  nil,
+	"route53resolver":                 nil,
+	"rum":                             nil,
+	"s3":                              nil,
+	"s3-object-lambda":                nil,
+	"s3-outposts":                     nil,
+	"s3express":                       nil,
+	"sagemaker":                       nil,
+	"savingsplans":                    nil,
+	"scheduler":                       nil,
+	"schemas":                         nil,
+	"sdb":                             nil,
+	"secretsmanager": {
+		"BatchGetSecretValue",
+		"CancelRotateSecret",
+		"CreateSecret",
+		"DeleteResourcePolicy",
+		"DeleteSecret",
+		"DescribeSecret",
+		"GetRandomPassword",
+		"GetResourcePolicy",
+		"GetSecretValue",
+		"ListSecretVersionIds",
+		"ListSecrets",
+		"PutResourcePolicy",
+		"PutSecretValue",
+		"RemoveRegionsFromReplication",
+		"ReplicateSecretToRegions",
+		"RestoreSecret",
+		"RotateSecret",
+		"StopReplicationToReplica",
+		"TagResource",
+		"UntagResource",
+		"UpdateSecret",
+		"UpdateSecretVersionStage",
+		"ValidateResourcePolicy",
+	},
+	"securityhub":      nil,
+	"securitylake":     nil,
+	"serverlessrepo":   nil,
+	"servicecatalog":   nil,
+	"servicediscovery": nil,
+	"servicequotas":    nil,
+	"ses":              nil,
+	"shield":           nil,
+	"signer":           nil,
+	"simspaceweaver":   nil,
+	"sms":              nil,
+	"sms-voice":        nil,
+	"snowball":         nil,
+	"sns": {
+		"AddPermission",
+		"CheckIfPhoneNumberIsOptedOut",
+		"ConfirmSubscription",
+		"CreatePlatformApplication",
+		"CreatePlatformEndpoint",
+		"CreateSMSSandboxPhoneNumber",
+		"CreateTopic",
+		"DeleteEndpoint",
+		"DeletePlatformApplication",
+		"DeleteSMSSandboxPhoneNumber",
+		"DeleteTopic",
+		"GetDataProtectionPolicy",
+		"GetEndpointAttributes",
+		"GetPlatformApplicationAttributes",
+		"GetSMSAttributes",
+		"GetSMSSandboxAccountStatus",
+		"GetSubscriptionAttributes",
+		"GetTopicAttributes",
+		"ListEndpointsByPlatformApplication",
+		"ListOriginationNumbers",
+		"ListPhoneNumbersOptedOut",
+		"ListPlatformApplications",
+		"ListSMSSandboxPhoneNumbers",
+		"ListSubscriptions",
+		"ListSubscriptionsByTopic",
+		"ListTagsForResource",
+		"ListTopics",
+		"OptInPhoneNumber",
+		"Publish",
+		"PutDataProtectionPolicy",
+		"RemovePermission",
+		"SetEndpointAttributes",
+		"SetPlatformApplicationAttributes",
+		"SetSMSAttributes",
+		"SetSubscriptionAttributes",
+		"SetTopicAttributes",
+		"Subscribe",
+		"TagResource",
+		"Unsubscribe",
+		"UntagResource",
+		"VerifySMSSandboxPhoneNumber",
+	},
+	"sqlworkbench": nil,
+	"sqs": {
+		"AddPermission",
+		"CancelMessageMoveTask",
+		"ChangeMessageVisibility",
+		"CreateQueue",
+		"DeleteMessage",
+		"DeleteQueue",
+		"GetQueueAttributes",
+		"GetQueueUrl",
+		"ListDeadLetterSourceQueues",
+		"ListMessageMoveTasks",
+		"ListQueueTags",
+		"ListQueues",
+		"PurgeQueue",
+		"ReceiveMessage",
+		"RemovePermission",
+		"SendMessage",
+		"SetQueueAttributes",
+		"StartMessageMoveTask",
+		"TagQueue",
+		"UntagQueue",
+	},
+	"ssm":            nil,
+	"ssm-contacts":   nil,
+	"ssm-guiconnect": nil,
+	"ssm-incidents":  nil,
+	"ssm-sap":        nil,
+	"ssmmessages":    nil,
+	"sso":            nil,
+	"sso-directory":  nil,
+	"sso-oauth":      nil,
+	"states":         nil,
+	"storagegateway": nil,
+	"sts": {
+		"AssumeRole",
+		"AssumeRoleWithSAML",
+		"AssumeRoleWithWebIdentity",
+		"DecodeAuthorizationMessage",
+		"GetAccessKeyInfo",
+		"GetCallerIdentity",
+		"GetFederationToken",
+		"GetServiceBearerToken",
+		"GetSessionToken",
+		"SetSourceIdentity",
+		"TagSession",
+	},
+	"support":             nil,
+	"sustainability":      nil,
+	"swf":                 nil,
+	"synthetics":          nil,
+	"tag":                 nil,
+	"tax":                 nil,
+	"textract":            nil,
+	"timestream":          nil,
+	"tnb":                 nil,
+	"transcribe":          nil,
+	"transfer":            nil,
+	"translate":           nil,
+	"trustedadvisor":      nil,
+	"verifiedpermissions": nil,
+	"vpc-lattice":         nil,
+	"waf":                 nil,
+	"waf-regional":        nil,
+	"wafv2":               nil,
+	"wellarchitected":     nil,
+	"workmail":            nil,
+	"workspaces":          nil,
+	"xray":                nil,
+}
+
+// policyLintSensitiveActions are actions that grant privilege escalation, credential or data access
+// and should not be allowed on all resources ("*").
+var policyLintSensitiveActions = []string{
+	"iam:AddUserToGroup",
+	"iam:AttachGroupPolicy",
+	"iam:AttachRolePolicy",
+	"iam:AttachUserPolicy",
+	"iam:CreateAccessKey",
+	"iam:CreateLoginProfile",
+	"iam:CreatePolicyVersion",
+	"iam:PassRole",
+	"iam:PutGroupPolicy",
+	"iam:PutRolePolicy",
+	"iam:PutUserPolicy",
+	"iam:SetDefaultPolicyVersion",
+	"iam:UpdateAssumeRolePolicy",
+	"iam:UpdateLoginProfile",
+	"kms:Decrypt",
+	"kms:PutKeyPolicy",
+	"kms:ScheduleKeyDeletion",
+	"lambda:UpdateFunctionCode",
+	"s3:DeleteBucket",
+	"s3:DeleteBucketPolicy",
+	"s3:PutBucketAcl",
+	"s3:PutBucketPolicy",
+	"secretsmanager:GetSecretValue",
+	"ssm:GetParameter",
+	"ssm:GetParameters",
+	"ssm:GetParametersByPath",
+	"sts:AssumeRole",
+}
@@ -0,0 +1,103 @@
+package iam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourcePolicyLint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyLintRead,
+
+		Schema: map[string]*schema.Schema{
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      policyLintPolicyTypeIdentity,
+				ValidateFunc: validation.StringInSlice(policyLintPolicyType_Values(), false),
+			},
+			"security_warning_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyLintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy := d.Get("policy").(string)
+	policyType := d.Get("policy_type").(string)
+	findings := lintPolicy(policy, policyType)
+
+	var errorCount, securityWarningCount int
+	tfList := make([]interface{}, 0, len(findings))
+	for _, v := range findings {
+		switch v.FindingType {
+		case policyLintFindingTypeError:
+			errorCount++
+		case policyLintFindingTypeSecurityWarning:
+			securityWarningCount++
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"finding_type":    v.FindingType,
+			"issue_code":      v.IssueCode,
+			"message":         v.Message,
+			"sid":             v.Sid,
+			"statement_index": v.StatementIndex,
+		})
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policyType + policy)))
+	d.Set("error_count", errorCount)
+	if err := d.Set("findings", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("security_warning_count", securityWarningCount)
+
+	return diags
+}
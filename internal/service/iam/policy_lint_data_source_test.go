@@ -0,0 +1,93 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyLintDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "error_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warning_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.finding_type", "ERROR"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.issue_code", "UNKNOWN_ACTION"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.sid", "Secrets"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.statement_index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.finding_type", "SECURITY_WARNING"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.issue_code", "SENSITIVE_ACTION_ON_ALL_RESOURCES"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyLintDataSource_resourcePolicy(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig_resourcePolicy,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "error_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warning_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.issue_code", "ALLOW_WITH_NOT_PRINCIPAL"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyLintDataSourceConfig_basic = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "Secrets"
+    actions   = ["secretsmanager:GetSecretValue", "secretsmanager:GetSecretValues"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_lint" "test" {
+  policy = data.aws_iam_policy_document.test.json
+}
+`
+
+const testAccPolicyLintDataSourceConfig_resourcePolicy = `
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["sqs:SendMessage"]
+    resources = ["arn:${data.aws_partition.current.partition}:sqs:*:${data.aws_caller_identity.current.account_id}:example"]
+
+    not_principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+    }
+  }
+}
+
+data "aws_iam_policy_lint" "test" {
+  policy      = data.aws_iam_policy_document.test.json
+  policy_type = "RESOURCE_POLICY"
+}
+`
@@ -0,0 +1,131 @@
+package iam
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLintPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy     string
+		policyType string
+		want       []string
+	}{
+		"valid identity policy": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["sqs:ReceiveMessage","sqs:Get*"],"Resource":"arn:aws:sqs:*:*:example"}]}`,
+			policyType: policyLintPolicyTypeIdentity,
+		},
+		"invalid JSON": {
+			policy:     `{"Version":`,
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"JSON_SYNTAX_ERROR"},
+		},
+		"missing version and statement": {
+			policy:     `{}`,
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"MISSING_VERSION", "MISSING_STATEMENT"},
+		},
+		"unknown service and action": {
+			policy:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s4:GetObject","sts:AssumeRol","kms:Describe*","s3:AnyAction","Invalid"],"Resource":"arn:aws:s3:::example/*"}}`,
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"UNKNOWN_SERVICE", "UNKNOWN_ACTION", "INVALID_ACTION"},
+		},
+		"recent service prefixes": {
+			policy:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["geo:SearchPlaceIndexForText","aoss:APIAccessAll","vpc-lattice:GetService","oam:ListSinks","rum:PutRumEvents","ssm-incidents:ListResponsePlans","q:StartConversation"],"Resource":"arn:aws:s3:::example/*"}}`,
+			policyType: policyLintPolicyTypeIdentity,
+		},
+		"sensitive actions on all resources": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["iam:Pass*","sqs:SendMessage"],"Resource":"*"},{"Effect":"Deny","Action":"iam:PassRole","Resource":"*"}]}`,
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"SENSITIVE_ACTION_ON_ALL_RESOURCES"},
+		},
+		"not action and not resource": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","NotResource":"arn:aws:s3:::example"}]}`,
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"ALLOW_WITH_NOT_ACTION", "ALLOW_WITH_NOT_RESOURCE"},
+		},
+		"principal in identity policy": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sqs:SendMessage","Resource":"arn:aws:sqs:*:*:example"}]}`, //lintignore:AWSAT005
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"PRINCIPAL_NOT_SUPPORTED"},
+		},
+		"not principal in resource policy": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotPrincipal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sqs:SendMessage"},{"Effect":"Deny","NotPrincipal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sqs:SendMessage"}]}`, //lintignore:AWSAT005
+			policyType: policyLintPolicyTypeResource,
+			want:       []string{"ALLOW_WITH_NOT_PRINCIPAL", "DENY_WITH_NOT_PRINCIPAL"},
+		},
+		"public resource policy": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage"},{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"sqs:SendMessage","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			policyType: policyLintPolicyTypeResource,
+			want:       []string{"PUBLIC_ACCESS"},
+		},
+		"condition operators and values": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Condition":{"StringEqualz":{"aws:PrincipalTag/team":"a"},"ForAnyValue:StringLikeIfExists":{"aws:TagKeys":["a*"]},"NullIfExists":{"aws:TokenIssueTime":"true"},"Bool":{"aws:SecureTransport":"yes"},"NumericLessThan":{"s3:max-keys":"ten"},"DateGreaterThan":{"aws:CurrentTime":"2023-01-02T15:04:05Z"},"IpAddress":{"aws:SourceIp":["10.0.0.0/8","invalid"]}}}]}`,
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"INVALID_CONDITION_VALUE", "INVALID_CONDITION_VALUE", "INVALID_CONDITION_OPERATOR", "INVALID_CONDITION_VALUE", "INVALID_CONDITION_OPERATOR"},
+		},
+		"statement errors": {
+			policy:     `{"Version":"2012-10-18","Statement":[{"Sid":"A","Effect":"Permit","Action":"sqs:SendMessage","NotAction":"sqs:ReceiveMessage"},{"Sid":"A","Effect":"Deny","Action":"sqs:SendMessage","Resource":"example","Extra":true}]}`,
+			policyType: policyLintPolicyTypeIdentity,
+			want:       []string{"INVALID_VERSION", "INVALID_EFFECT", "ACTION_AND_NOT_ACTION", "MISSING_RESOURCE", "DUPLICATE_SID", "INVALID_ELEMENT", "INVALID_RESOURCE"},
+		},
+		"size exceeded": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"arn:aws:sqs:*:*:` + strings.Repeat("a", policyLintServiceControlPolicyMaxSize) + `"}]}`,
+			policyType: policyLintPolicyTypeServiceControl,
+			want:       []string{"POLICY_SIZE_EXCEEDED"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range lintPolicy(testCase.policy, testCase.policyType) {
+				got = append(got, v.IssueCode)
+			}
+
+			if strings.Join(got, ",") != strings.Join(testCase.want, ",") {
+				t.Errorf("got findings %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestLintPolicyUnknownServiceIsWarning(t *testing.T) {
+	t.Parallel()
+
+	findings := lintPolicy(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s4:GetObject","Resource":"*"}}`, policyLintPolicyTypeIdentity)
+
+	if len(findings) != 1 {
+		t.Fatalf("got findings %v, want 1", findings)
+	}
+
+	if got, want := findings[0].FindingType, policyLintFindingTypeWarning; got != want {
+		t.Errorf("FindingType = %q, want %q", got, want)
+	}
+}
+
+func TestPolicyLintServiceActionsCatalogued(t *testing.T) {
+	t.Parallel()
+
+	// Must match the services listed in the package and website documentation.
+	want := []string{"dynamodb", "kms", "secretsmanager", "sns", "sqs", "sts"}
+
+	var got []string
+	for k, v := range policyLintServiceActions {
+		if v != nil {
+			got = append(got, k)
+		}
+	}
+	sort.Strings(got)
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("catalogued services = %v, want %v", got, want)
+	}
+}
@@ -11,11 +11,13 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAccIAMRole_basic(t *testing.T) {
@@ -409,6 +411,36 @@ func TestAccIAMRole_disappears(t *testing.T) {
 	})
 }
 
+func TestIAMRole_fakeAWS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role iam.Role
+	resourceName := "aws_iam_role.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.FakeAWSLifecycleTest(ctx, t, acctest.FakeAWSLifecycle{
+		CheckDestroy: testAccCheckRoleDestroyWithProvider(ctx),
+		CheckExists: func(providerF func() *schema.Provider) resource.TestCheckFunc {
+			return testAccCheckRoleExistsWithProvider(ctx, resourceName, &role, providerF)
+		},
+		Config: testAccRoleConfig_tags(rName),
+		Checks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "name", rName),
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+			resource.TestCheckResourceAttr(resourceName, "tags.tag1", "test-value1"),
+			resource.TestCheckResourceAttr(resourceName, "tags.tag2", "test-value2"),
+		},
+		UpdateConfig: testAccRoleConfig_tagsUpdate(rName),
+		UpdateChecks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+			resource.TestCheckResourceAttr(resourceName, "tags.tag2", "test-value"),
+		},
+		ReadService:   names.IAM,
+		ReadOperation: "GetRole",
+		Resource:      tfiam.ResourceRole(),
+		ResourceName:  resourceName,
+	})
+}
+
 func TestAccIAMRole_policiesForceDetach(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf iam.Role
@@ -1036,8 +1068,12 @@ func TestAccIAMRole_ManagedPolicy_outOfBandAdditionRemovedEmpty(t *testing.T) {
 }
 
 func testAccCheckRoleDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+	return func(s *terraform.State) error { return testAccCheckRoleDestroyWithProvider(ctx)(s, acctest.Provider) }
+}
+
+func testAccCheckRoleDestroyWithProvider(ctx context.Context) acctest.TestCheckWithProviderFunc {
+	return func(s *terraform.State, provider *schema.Provider) error {
+		conn := provider.Meta().(*conns.AWSClient).IAMConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_iam_role" {
@@ -1062,6 +1098,10 @@ func testAccCheckRoleDestroy(ctx context.Context) resource.TestCheckFunc {
 }
 
 func testAccCheckRoleExists(ctx context.Context, n string, v *iam.Role) resource.TestCheckFunc {
+	return testAccCheckRoleExistsWithProvider(ctx, n, v, func() *schema.Provider { return acctest.Provider })
+}
+
+func testAccCheckRoleExistsWithProvider(ctx context.Context, n string, v *iam.Role, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -1072,7 +1112,7 @@ func testAccCheckRoleExists(ctx context.Context, n string, v *iam.Role) resource
 			return fmt.Errorf("No IAM Role ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
+		conn := providerF().Meta().(*conns.AWSClient).IAMConn()
 
 		output, err := tfiam.FindRoleByName(ctx, conn, rs.Primary.ID)
 
@@ -4,6 +4,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -120,7 +121,7 @@ func init() {
 }
 
 func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -129,7 +130,7 @@ func sweepGroups(region string) error {
 
 	conn := client.(*conns.AWSClient).IAMConn()
 	input := &iam.ListGroupsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListGroupsPagesWithContext(ctx, input, func(page *iam.ListGroupsOutput, lastPage bool) bool {
 		if page == nil {
@@ -143,106 +144,92 @@ func sweepGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting IAM Group: %s", name)
-
-			getGroupInput := &iam.GetGroupInput{
-				GroupName: group.GroupName,
-			}
-
-			getGroupOutput, err := conn.GetGroupWithContext(ctx, getGroupInput)
-
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(name, func(ctx context.Context) error { return sweepGroup(ctx, conn, name) },
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(group.CreateDate)),
+			))
+		}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		return !lastPage
+	})
 
-			if getGroupOutput != nil {
-				for _, user := range getGroupOutput.Users {
-					username := aws.StringValue(user.UserName)
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
+		return nil
+	}
 
-					log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+	if err != nil {
+		return fmt.Errorf("error retrieving IAM Groups: %w", err)
+	}
 
-					input := &iam.RemoveUserFromGroupInput{
-						UserName:  user.UserName,
-						GroupName: group.GroupName,
-					}
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-					_, err := conn.RemoveUserFromGroupWithContext(ctx, input)
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Groups (%s): %w", region, err)
+	}
 
-					if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-						continue
-					}
+	return nil
+}
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
-				}
-			}
+// sweepGroup removes all users and policies from the named IAM Group and then deletes it.
+func sweepGroup(ctx context.Context, conn *iam.IAM, name string) error {
+	getGroupOutput, err := conn.GetGroupWithContext(ctx, &iam.GetGroupInput{
+		GroupName: aws.String(name),
+	})
 
-			input := &iam.DeleteGroupInput{
-				GroupName: group.GroupName,
-			}
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
 
-			if err := DeleteGroupPolicyAttachments(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+	if err != nil {
+		return fmt.Errorf("error reading IAM Group (%s): %w", name, err)
+	}
 
-			if err := DeleteGroupPolicies(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+	for _, user := range getGroupOutput.Users {
+		username := aws.StringValue(user.UserName)
 
-			_, err = conn.DeleteGroupWithContext(ctx, input)
+		log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+		_, err := conn.RemoveUserFromGroupWithContext(ctx, &iam.RemoveUserFromGroupInput{
+			UserName:  user.UserName,
+			GroupName: aws.String(name),
+		})
 
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		if err != nil {
+			return fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
 		}
+	}
 
-		return !lastPage
-	})
+	if err := DeleteGroupPolicyAttachments(ctx, conn, name); err != nil {
+		return fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
+	}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+	if err := DeleteGroupPolicies(ctx, conn, name); err != nil {
+		return fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Groups: %w", err))
+	_, err = conn.DeleteGroupWithContext(ctx, &iam.DeleteGroupInput{
+		GroupName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return err
 }
 
 func sweepInstanceProfile(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_instance_profile")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
-
+	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListInstanceProfilesPagesWithContext(ctx, &iam.ListInstanceProfilesInput{}, func(page *iam.ListInstanceProfilesOutput, lastPage bool) bool {
@@ -265,17 +252,15 @@ func sweepInstanceProfile(region string) error {
 			roles := instanceProfile.Roles
 			if r := len(roles); r > 1 {
 				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("unexpected number of roles for IAM Instance Profile (%s): %d", name, r))
+				continue
 			} else if r == 1 {
 				d.Set("role", roles[0].RoleName)
 			}
 
-			log.Printf("[INFO] Sweeping IAM Instance Profile %q", name)
-			err := sweep.DeleteResource(ctx, r, d, client)
-
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting IAM Instance Profile (%s): %w", name, err))
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(instanceProfile.CreateDate)),
+			))
 		}
 
 		return !lastPage
@@ -290,118 +275,108 @@ func sweepInstanceProfile(region string) error {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IAM Instance Profiles: %w", err))
 	}
 
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping IAM Instance Profiles (%s): %w", region, err))
+	}
+
 	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepOpenIDConnectProvider(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_openid_connect_provider")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
 
-	var sweeperErrs *multierror.Error
-
 	out, err := conn.ListOpenIDConnectProvidersWithContext(ctx, &iam.ListOpenIDConnectProvidersInput{})
 
-	for _, oidcProvider := range out.OpenIDConnectProviderList {
-		arn := aws.StringValue(oidcProvider.Arn)
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM OIDC Provider sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing IAM OIDC Providers: %w", err)
+	}
+
+	sweepResources := make([]sweep.Sweepable, 0)
 
+	for _, oidcProvider := range out.OpenIDConnectProviderList {
 		r := ResourceOpenIDConnectProvider()
 		d := r.Data(nil)
-		d.SetId(arn)
-		err := sweep.DeleteResource(ctx, r, d, client)
+		d.SetId(aws.StringValue(oidcProvider.Arn))
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM OIDC Provider (%s): %w", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM OIDC Provider sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM OIDC Providers: %w", err))
+		return fmt.Errorf("error sweeping IAM OIDC Providers (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepServiceSpecificCredentials(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_service_specific_credential")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
 
-	var sweeperErrs *multierror.Error
+	users, err := sweepableUsers(ctx, conn)
 
-	prefixes := []string{
-		"test-user",
-		"test_user",
-		"tf-acc",
-		"tf_acc",
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Service Specific Credential sweep for %s: %s", region, err)
+		return nil
 	}
 
-	users := make([]*iam.User, 0)
-
-	err = conn.ListUsersPagesWithContext(ctx, &iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
-		for _, user := range page.Users {
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(user.UserName), prefix) {
-					users = append(users, user)
-					break
-				}
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error retrieving IAM Users: %w", err)
+	}
 
-		return !lastPage
-	})
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
 
 	for _, user := range users {
 		out, err := conn.ListServiceSpecificCredentialsWithContext(ctx, &iam.ListServiceSpecificCredentialsInput{
 			UserName: user.UserName,
 		})
 
-		for _, cred := range out.ServiceSpecificCredentials {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IAM Service Specific Credential sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
 
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM Service Specific Credentials: %w", err))
+			continue
+		}
+
+		for _, cred := range out.ServiceSpecificCredentials {
 			id := fmt.Sprintf("%s:%s:%s", aws.StringValue(cred.ServiceName), aws.StringValue(cred.UserName), aws.StringValue(cred.ServiceSpecificCredentialId))
 
 			r := ResourceServiceSpecificCredential()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := sweep.DeleteResource(ctx, r, d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Service Specific Credential (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Service Specific Credential sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithCreationTime(aws.TimeValue(cred.CreateDate))))
 		}
+	}
 
-		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM Service Specific Credentials: %w", err))
-		}
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping IAM Service Specific Credentials (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -412,7 +387,7 @@ func sweepPolicies(region string) error {
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListPoliciesPagesWithContext(ctx, input, func(page *iam.ListPoliciesOutput, lastPage bool) bool {
 		if page == nil {
@@ -421,41 +396,11 @@ func sweepPolicies(region string) error {
 
 		for _, policy := range page.Policies {
 			arn := aws.StringValue(policy.Arn)
-			input := &iam.DeletePolicyInput{
-				PolicyArn: policy.Arn,
-			}
-
-			log.Printf("[INFO] Deleting IAM Policy: %s", arn)
-			if err := policyDeleteNonDefaultVersions(ctx, arn, conn); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s) non-default versions: %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
 
-			_, err := conn.DeletePolicyWithContext(ctx, input)
-
-			// Treat this sweeper as best effort for now. There are a lot of edge cases
-			// with lingering aws_iam_role resources in the HashiCorp testing accounts.
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
-				log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", arn, err)
-				continue
-			}
-
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
-
-			if tfawserr.ErrMessageContains(err, "AccessDenied", "with an explicit deny") {
-				continue
-			}
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(arn, func(ctx context.Context) error { return sweepPolicy(ctx, conn, arn) },
+				sweep.WithName(aws.StringValue(policy.PolicyName)),
+				sweep.WithCreationTime(aws.TimeValue(policy.CreateDate)),
+			))
 		}
 
 		return !lastPage
@@ -463,33 +408,91 @@ func sweepPolicies(region string) error {
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Policy sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Policies: %w", err))
+		return fmt.Errorf("error retrieving IAM Policies: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+// sweepPolicy deletes the non-default versions of the specified IAM Policy and then deletes it.
+func sweepPolicy(ctx context.Context, conn *iam.IAM, arn string) error {
+	if err := policyDeleteNonDefaultVersions(ctx, arn, conn); err != nil {
+		return fmt.Errorf("error deleting IAM Policy (%s) non-default versions: %w", arn, err)
+	}
+
+	_, err := conn.DeletePolicyWithContext(ctx, &iam.DeletePolicyInput{
+		PolicyArn: aws.String(arn),
+	})
+
+	// Treat this sweeper as best effort for now. There are a lot of edge cases
+	// with lingering aws_iam_role resources in the HashiCorp testing accounts.
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
+		log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", arn, err)
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, "AccessDenied", "with an explicit deny") {
+		return nil
+	}
+
+	return err
 }
 
 func sweepRoles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_role")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	roles := make([]string, 0)
 	err = conn.ListRolesPagesWithContext(ctx, &iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, role := range page.Roles {
 			roleName := aws.StringValue(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
+
+			if !roleNameFilter(roleName) {
 				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
+				continue
 			}
+
+			deleteRole := func(ctx context.Context) error {
+				err := DeleteRole(ctx, conn, roleName, true, true, true)
+
+				if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+					return nil
+				}
+
+				if tfawserr.ErrCodeContains(err, "AccessDenied") {
+					log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
+					return nil
+				}
+
+				return err
+			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(roleName, deleteRole,
+				sweep.WithName(roleName),
+				sweep.WithCreationTime(aws.TimeValue(role.CreateDate)),
+			))
 		}
 
 		return !lastPage
@@ -504,117 +507,110 @@ func sweepRoles(region string) error {
 		return fmt.Errorf("Error retrieving IAM Roles: %w", err)
 	}
 
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
-	}
-
-	var sweeperErrs *multierror.Error
-
-	for _, roleName := range roles {
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-		err := DeleteRole(ctx, conn, roleName, true, true, true)
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if tfawserr.ErrCodeContains(err, "AccessDenied") {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", roleName, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Roles (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepSAMLProvider(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_saml_provider")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
 
-	var sweeperErrs *multierror.Error
-
 	out, err := conn.ListSAMLProvidersWithContext(ctx, &iam.ListSAMLProvidersInput{})
 
-	for _, sampProvider := range out.SAMLProviderList {
-		arn := aws.StringValue(sampProvider.Arn)
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM SAML Provider sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing IAM SAML Providers: %w", err)
+	}
+
+	sweepResources := make([]sweep.Sweepable, 0)
 
+	for _, samlProvider := range out.SAMLProviderList {
 		r := ResourceSAMLProvider()
 		d := r.Data(nil)
-		d.SetId(arn)
-		err := sweep.DeleteResource(ctx, r, d, client)
+		d.SetId(aws.StringValue(samlProvider.Arn))
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM SAML Provider (%s): %w", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithCreationTime(aws.TimeValue(samlProvider.CreateDate))))
 	}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM SAML Provider sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM SAML Providers: %w", err))
+		return fmt.Errorf("error sweeping IAM SAML Providers (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_server_certificate")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListServerCertificatesPagesWithContext(ctx, &iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
-		for _, sc := range out.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
+	err = conn.ListServerCertificatesPagesWithContext(ctx, &iam.ListServerCertificatesInput{}, func(page *iam.ListServerCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			_, err := conn.DeleteServerCertificateWithContext(ctx, &iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					*sc.ServerCertificateName, err)
-				continue
-			}
+		for _, sc := range page.ServerCertificateMetadataList {
+			name := aws.StringValue(sc.ServerCertificateName)
+
+			r := ResourceServerCertificate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(sc.ServerCertificateId))
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(sc.UploadDate)),
+			))
 		}
+
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
-			return nil
-		}
 		return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Server Certificates (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepServiceLinkedRoles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_service_linked_role")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String("/aws-service-role/"),
 	}
@@ -624,10 +620,10 @@ func sweepServiceLinkedRoles(region string) error {
 	// TestAccIAMServiceLinkedRole_CustomSuffix_diffSuppressFunc
 	customSuffixRegex := regexp.MustCompile(`_?(tf-acc-test-\d+|ServiceRoleFor(ApplicationAutoScaling_CustomResource|ElasticBeanstalk))$`)
 	err = conn.ListRolesPagesWithContext(ctx, input, func(page *iam.ListRolesOutput, lastPage bool) bool {
-		if len(page.Roles) == 0 {
-			log.Printf("[INFO] No IAM Service Roles to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, role := range page.Roles {
 			roleName := aws.StringValue(role.RoleName)
 
@@ -639,36 +635,75 @@ func sweepServiceLinkedRoles(region string) error {
 			r := ResourceServiceLinkedRole()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(role.Arn))
-			err := sweep.DeleteResource(ctx, r, d, client)
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Service Linked Role (%s): %w", roleName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(roleName),
+				sweep.WithCreationTime(aws.TimeValue(role.CreateDate)),
+			))
 		}
+
 		return !lastPage
 	})
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Service Role sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM Service Roles: %w", err))
+		return fmt.Errorf("error describing IAM Service Roles: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Service Roles (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_user")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
+
+	users, err := sweepableUsers(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM User sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error retrieving IAM Users: %s", err)
+	}
+
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for _, user := range users {
+		username := aws.StringValue(user.UserName)
+
+		sweepResources = append(sweepResources, sweep.NewSweepFunc(username, func(ctx context.Context) error { return sweepUser(ctx, conn, username) },
+			sweep.WithName(username),
+			sweep.WithCreationTime(aws.TimeValue(user.CreateDate)),
+		))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Users (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+// sweepableUsers returns the IAM Users whose names match a test user name prefix.
+func sweepableUsers(ctx context.Context, conn *iam.IAM) ([]*iam.User, error) {
 	prefixes := []string{
 		"test-user",
 		"test_user",
@@ -677,7 +712,11 @@ func sweepUsers(region string) error {
 	}
 	users := make([]*iam.User, 0)
 
-	err = conn.ListUsersPagesWithContext(ctx, &iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
+	err := conn.ListUsersPagesWithContext(ctx, &iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, user := range page.Users {
 			for _, prefix := range prefixes {
 				if strings.HasPrefix(aws.StringValue(user.UserName), prefix) {
@@ -690,147 +729,94 @@ func sweepUsers(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM User sweep for %s: %s", region, err)
-		return nil
-	}
+	return users, err
+}
 
-	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Users: %s", err)
-	}
+// sweepUser removes the named IAM User's policies, group memberships, keys, MFA devices
+// and login profile and then deletes it.
+func sweepUser(ctx context.Context, conn *iam.IAM, username string) error {
+	listUserPoliciesOutput, err := conn.ListUserPoliciesWithContext(ctx, &iam.ListUserPoliciesInput{
+		UserName: aws.String(username),
+	})
 
-	if len(users) == 0 {
-		log.Print("[DEBUG] No IAM Users to sweep")
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		return nil
 	}
 
-	var sweeperErrs *multierror.Error
-	for _, user := range users {
-		username := aws.StringValue(user.UserName)
-		log.Printf("[DEBUG] Deleting IAM User: %s", username)
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) inline policies: %s", username, err)
+	}
 
-		listUserPoliciesInput := &iam.ListUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listUserPoliciesOutput, err := conn.ListUserPoliciesWithContext(ctx, listUserPoliciesInput)
+	for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, aws.StringValue(inlinePolicyName))
+		_, err := conn.DeleteUserPolicyWithContext(ctx, &iam.DeleteUserPolicyInput{
+			PolicyName: inlinePolicyName,
+			UserName:   aws.String(username),
+		})
 
 		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 			continue
 		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) inline policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
-			log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, *inlinePolicyName)
-
-			input := &iam.DeleteUserPolicyInput{
-				PolicyName: inlinePolicyName,
-				UserName:   user.UserName,
-			}
-
-			if _, err := conn.DeleteUserPolicyWithContext(ctx, input); err != nil {
-				if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-					continue
-				}
-				sweeperErr := fmt.Errorf("error deleting IAM User (%s) inline policy %q: %s", username, *inlinePolicyName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
-
-		listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPoliciesWithContext(ctx, listAttachedUserPoliciesInput)
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
 		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+			return fmt.Errorf("error deleting IAM User (%s) inline policy %q: %s", username, aws.StringValue(inlinePolicyName), err)
 		}
+	}
 
-		for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
-			policyARN := aws.StringValue(attachedPolicy.PolicyArn)
+	listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPoliciesWithContext(ctx, &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(username),
+	})
 
-			log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
 
-			if err := DetachPolicyFromUser(ctx, conn, username, policyARN); err != nil {
-				sweeperErr := fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
+	}
 
-		if err := DeleteUserGroupMemberships(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
+		policyARN := aws.StringValue(attachedPolicy.PolicyArn)
 
-		if err := DeleteUserAccessKeys(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+		log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
+		if err := DetachPolicyFromUser(ctx, conn, username, policyARN); err != nil {
+			return fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
 		}
+	}
 
-		if err := DeleteUserSSHKeys(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserGroupMemberships(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
+	}
 
-		if err := DeleteUserVirtualMFADevices(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) virtual MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserAccessKeys(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
+	}
 
-		if err := DeactivateUserMFADevices(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserSSHKeys(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
+	}
 
-		if err := DeleteUserLoginProfile(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserVirtualMFADevices(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) virtual MFA devices: %s", username, err)
+	}
 
-		input := &iam.DeleteUserInput{
-			UserName: aws.String(username),
-		}
+	if err := DeactivateUserMFADevices(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
+	}
 
-		_, err = conn.DeleteUserWithContext(ctx, input)
+	if err := DeleteUserLoginProfile(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
+	}
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM User (%s): %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	_, err = conn.DeleteUserWithContext(ctx, &iam.DeleteUserInput{
+		UserName: aws.String(username),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return err
 }
 
 func roleNameFilter(name string) bool {
@@ -901,20 +887,20 @@ func roleNameFilter(name string) bool {
 }
 
 func sweepVirtualMFADevice(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_virtual_mfa_device")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 	input := &iam.ListVirtualMFADevicesInput{}
 
 	err = conn.ListVirtualMFADevicesPagesWithContext(ctx, input, func(page *iam.ListVirtualMFADevicesOutput, lastPage bool) bool {
-		if len(page.VirtualMFADevices) == 0 {
-			log.Printf("[INFO] No IAM Virtual MFA Devices to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, device := range page.VirtualMFADevices {
 			serialNum := aws.StringValue(device.SerialNumber)
 
@@ -926,91 +912,81 @@ func sweepVirtualMFADevice(region string) error {
 			r := ResourceVirtualMFADevice()
 			d := r.Data(nil)
 			d.SetId(serialNum)
-			err := sweep.DeleteResource(ctx, r, d, client)
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Virtual MFA Device (%s): %w", device, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Virtual MFA Device sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM Virtual MFA Devices: %w", err))
+		return fmt.Errorf("error describing IAM Virtual MFA Devices: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Virtual MFA Devices (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepSigningCertificates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iam_signing_certificate")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn()
 
-	var sweeperErrs *multierror.Error
+	users, err := sweepableUsers(ctx, conn)
 
-	prefixes := []string{
-		"test-user",
-		"test_user",
-		"tf-acc",
-		"tf_acc",
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Signing Certificate sweep for %s: %s", region, err)
+		return nil
 	}
 
-	users := make([]*iam.User, 0)
-
-	err = conn.ListUsersPagesWithContext(ctx, &iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
-		for _, user := range page.Users {
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(user.UserName), prefix) {
-					users = append(users, user)
-					break
-				}
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error retrieving IAM Users: %w", err)
+	}
 
-		return !lastPage
-	})
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
 
 	for _, user := range users {
 		out, err := conn.ListSigningCertificatesWithContext(ctx, &iam.ListSigningCertificatesInput{
 			UserName: user.UserName,
 		})
 
-		for _, cert := range out.Certificates {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IAM Signing Certificate sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM Signing Certificates: %w", err))
+			continue
+		}
 
+		for _, cert := range out.Certificates {
 			id := fmt.Sprintf("%s:%s", aws.StringValue(cert.CertificateId), aws.StringValue(cert.UserName))
 
 			r := ResourceSigningCertificate()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := sweep.DeleteResource(ctx, r, d, client)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Signing Certificate (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
-
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Signing Certificate sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithCreationTime(aws.TimeValue(cert.UploadDate))))
 		}
+	}
 
-		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM Signing Certificates: %w", err))
-		}
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping IAM Signing Certificates (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
@@ -53,7 +53,7 @@ func init() {
 }
 
 func sweepComponents(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_imagebuilder_component")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -130,7 +130,7 @@ func sweepComponents(region string) error {
 }
 
 func sweepDistributionConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_imagebuilder_distribution_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -179,7 +179,7 @@ func sweepDistributionConfigurations(region string) error {
 }
 
 func sweepImagePipelines(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_imagebuilder_image_pipeline")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -228,7 +228,7 @@ func sweepImagePipelines(region string) error {
 }
 
 func sweepImageRecipes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_imagebuilder_image_recipe")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -279,7 +279,7 @@ func sweepImageRecipes(region string) error {
 }
 
 func sweepContainerRecipes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_imagebuilder_container_recipe")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -330,7 +330,7 @@ func sweepContainerRecipes(region string) error {
 }
 
 func sweepImages(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_imagebuilder_image")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -411,7 +411,7 @@ func sweepImages(region string) error {
 }
 
 func sweepInfrastructureConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_imagebuilder_infrastructure_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
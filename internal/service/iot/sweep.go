@@ -4,6 +4,7 @@
 package iot
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -79,7 +80,7 @@ func init() {
 }
 
 func sweepCertifcates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_certificate")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -126,7 +127,7 @@ func sweepCertifcates(region string) error {
 }
 
 func sweepPolicyAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_policy_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -193,7 +194,7 @@ func sweepPolicyAttachments(region string) error {
 }
 
 func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -240,7 +241,7 @@ func sweepPolicies(region string) error {
 }
 
 func sweepRoleAliases(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_role_alias")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -287,7 +288,7 @@ func sweepRoleAliases(region string) error {
 }
 
 func sweepThingPrincipalAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_thing_principal_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -354,7 +355,7 @@ func sweepThingPrincipalAttachments(region string) error {
 }
 
 func sweepThings(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_thing")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -401,7 +402,7 @@ func sweepThings(region string) error {
 }
 
 func sweepThingTypes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_thing_type")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -448,42 +449,44 @@ func sweepThingTypes(region string) error {
 }
 
 func sweepTopicRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_topic_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IoTConn()
 	input := &iot.ListTopicRulesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.ListTopicRulesWithContext(ctx, input)
 		if sweep.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping IoT Topic Rules sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IoT Topic Rules: %w", err))
-			return sweeperErrs
+			return fmt.Errorf("error retrieving IoT Topic Rules: %w", err)
 		}
 
 		for _, rule := range output.Rules {
 			name := aws.StringValue(rule.RuleName)
 
-			log.Printf("[INFO] Deleting IoT Topic Rule: %s", name)
-			_, err := conn.DeleteTopicRuleWithContext(ctx, &iot.DeleteTopicRuleInput{
-				RuleName: aws.String(name),
-			})
-			if tfawserr.ErrCodeEquals(err, iot.ErrCodeUnauthorizedException) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IoT Topic Rule (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			deleteTopicRule := func(ctx context.Context) error {
+				_, err := conn.DeleteTopicRuleWithContext(ctx, &iot.DeleteTopicRuleInput{
+					RuleName: aws.String(name),
+				})
+
+				if tfawserr.ErrCodeEquals(err, iot.ErrCodeUnauthorizedException) {
+					return nil
+				}
+
+				return err
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(name, deleteTopicRule,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(rule.CreatedAt)),
+			))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -492,11 +495,17 @@ func sweepTopicRules(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Topic Rules (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepThingGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_thing_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -540,7 +549,7 @@ func sweepThingGroups(region string) error {
 }
 
 func sweepTopicRuleDestinations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_iot_topic_rule_destination")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
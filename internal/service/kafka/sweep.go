@@ -33,7 +33,7 @@ func init() {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_msk_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -77,7 +77,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_msk_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -30,7 +30,7 @@ func init() {
 }
 
 func sweepConnectors(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_mskconnect_connector")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -74,7 +74,7 @@ func sweepConnectors(region string) error {
 }
 
 func sweepCustomPlugins(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_mskconnect_custom_plugin")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
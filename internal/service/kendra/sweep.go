@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepIndex(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_kendra_index")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-name
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_keyspaces_keyspace")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
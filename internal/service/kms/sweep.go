@@ -24,7 +24,7 @@ func init() {
 }
 
 func sweepKeys(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_kms_key")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
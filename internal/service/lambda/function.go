@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -27,6 +28,14 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 )
 
+func init() {
+	_sp.registerResourceImport("aws_lambda_function", &intf.ResourceImport{
+		ARNService:           "lambda",
+		ARNResourcePrefix:    "function:",
+		CloudControlTypeName: "AWS::Lambda::Function",
+	})
+}
+
 const (
 	FunctionVersionLatest = "$LATEST"
 	mutexKey              = `aws_lambda_function`
@@ -32,7 +32,7 @@ func init() {
 }
 
 func sweepFunctions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lambda_function")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -77,7 +77,7 @@ func sweepFunctions(region string) error {
 }
 
 func sweepLayerVersions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lambda_layer")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
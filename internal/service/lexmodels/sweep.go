@@ -41,7 +41,7 @@ func init() {
 }
 
 func sweepBotAliases(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lex_bot_alias")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -115,7 +115,7 @@ func sweepBotAliases(region string) error {
 }
 
 func sweepBots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lex_bot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -162,7 +162,7 @@ func sweepBots(region string) error {
 }
 
 func sweepIntents(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lex_intent")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -209,7 +209,7 @@ func sweepIntents(region string) error {
 }
 
 func sweepSlotTypes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lex_slot_type")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
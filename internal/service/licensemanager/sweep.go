@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepLicenseConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_licensemanager_license_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -33,7 +33,7 @@ func init() {
 }
 
 func sweepContainerServices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lightsail_container_service")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -84,7 +84,7 @@ func sweepContainerServices(region string) error {
 }
 
 func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lightsail_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -92,7 +92,7 @@ func sweepInstances(region string) error {
 	conn := client.(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetInstancesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.GetInstancesWithContext(ctx, input)
@@ -108,18 +108,16 @@ func sweepInstances(region string) error {
 
 		for _, instance := range output.Instances {
 			name := aws.StringValue(instance.Name)
-			input := &lightsail.DeleteInstanceInput{
-				InstanceName: instance.Name,
-			}
 
-			log.Printf("[INFO] Deleting Lightsail Instance: %s", name)
-			_, err := conn.DeleteInstanceWithContext(ctx, input)
+			r := ResourceInstance()
+			d := r.Data(nil)
+			d.SetId(name)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Lightsail Instance (%s): %s", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithTags(KeyValueTags(instance.Tags).Map()),
+				sweep.WithCreationTime(aws.TimeValue(instance.CreatedAt)),
+			))
 		}
 
 		if aws.StringValue(output.NextPageToken) == "" {
@@ -129,11 +127,17 @@ func sweepInstances(region string) error {
 		input.PageToken = output.NextPageToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Lightsail Instances (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepStaticIPs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_lightsail_static_ip")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -141,6 +145,7 @@ func sweepStaticIPs(region string) error {
 	conn := client.(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetStaticIpsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.GetStaticIpsWithContext(ctx, input)
@@ -152,21 +157,18 @@ func sweepStaticIPs(region string) error {
 			return fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
 		}
 
-		if len(output.StaticIps) == 0 {
-			log.Print("[DEBUG] No Lightsail Static IPs to sweep")
-			return nil
-		}
-
 		for _, staticIp := range output.StaticIps {
 			name := aws.StringValue(staticIp.Name)
 
-			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
-			_, err := conn.ReleaseStaticIpWithContext(ctx, &lightsail.ReleaseStaticIpInput{
-				StaticIpName: aws.String(name),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err)
-			}
+			r := ResourceStaticIP()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(staticIp.CreatedAt)),
+			))
 		}
 
 		if output.NextPageToken == nil {
@@ -175,5 +177,11 @@ func sweepStaticIPs(region string) error {
 		input.PageToken = output.NextPageToken
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Lightsail Static IPs (%s): %w", region, err)
+	}
+
 	return nil
 }
@@ -48,7 +48,7 @@ func init() {
 }
 
 func sweepGeofenceCollections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_location_geofence_collection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -96,7 +96,7 @@ func sweepGeofenceCollections(region string) error {
 }
 
 func sweepMaps(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_location_map")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -144,7 +144,7 @@ func sweepMaps(region string) error {
 }
 
 func sweepPlaceIndexes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_location_place_index")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -192,7 +192,7 @@ func sweepPlaceIndexes(region string) error {
 }
 
 func sweepRouteCalculators(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_location_route_calculator")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -240,7 +240,7 @@ func sweepRouteCalculators(region string) error {
 }
 
 func sweepTrackers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_location_tracker")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -288,7 +288,7 @@ func sweepTrackers(region string) error {
 }
 
 func sweepTrackerAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_location_tracker_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
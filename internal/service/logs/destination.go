@@ -20,6 +20,7 @@ import (
 
 func init() {
 	_sp.registerSDKResourceFactory("aws_cloudwatch_log_destination", resourceDestination)
+	_sp.registerResourceTags("aws_cloudwatch_log_destination", "arn")
 }
 
 func resourceDestination() *schema.Resource {
@@ -60,8 +61,6 @@ func resourceDestination() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 		},
-
-		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
@@ -71,9 +70,8 @@ const (
 
 func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LogsConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	tags := tftags.GetTagsIn(ctx)
 	name := d.Get("name").(string)
 	input := &cloudwatchlogs.PutDestinationInput{
 		DestinationName: aws.String(name),
@@ -105,8 +103,6 @@ func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LogsConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	destination, err := FindDestinationByName(ctx, conn, d.Id())
 
@@ -125,23 +121,6 @@ func resourceDestinationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("role_arn", destination.RoleArn)
 	d.Set("target_arn", destination.TargetArn)
 
-	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
-
-	if err != nil {
-		return diag.Errorf("listing tags for CloudWatch Logs Destination (%s): %s", d.Id(), err)
-	}
-
-	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
-
-	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return diag.Errorf("setting tags: %s", err)
-	}
-
-	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return diag.Errorf("setting tags_all: %s", err)
-	}
-
 	return nil
 }
 
@@ -164,14 +143,6 @@ func resourceDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange("tags_all") {
-		o, n := d.GetChange("tags_all")
-
-		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
-			log.Printf("[WARN] failed updating tags for CloudWatch Logs Destination (%s): %s", d.Id(), err)
-		}
-	}
-
 	return resourceDestinationRead(ctx, d, meta)
 }
 
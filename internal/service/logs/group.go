@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -19,6 +20,11 @@ import (
 
 func init() {
 	_sp.registerSDKResourceFactory("aws_cloudwatch_log_group", resourceGroup)
+	_sp.registerResourceImport("aws_cloudwatch_log_group", &intf.ResourceImport{
+		ARNService:           "logs",
+		ARNResourcePrefix:    "log-group:",
+		CloudControlTypeName: "AWS::Logs::LogGroup",
+	})
 }
 
 func resourceGroup() *schema.Resource {
@@ -54,7 +54,7 @@ func init() {
 }
 
 func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_log_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -98,7 +98,7 @@ func sweepGroups(region string) error {
 }
 
 func sweeplogQueryDefinitions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_query_definition")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -142,7 +142,7 @@ func sweeplogQueryDefinitions(region string) error {
 }
 
 func sweepResourcePolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_cloudwatch_log_resource_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
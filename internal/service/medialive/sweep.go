@@ -41,7 +41,7 @@ func init() {
 }
 
 func sweepChannels(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_medialive_channel")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -91,7 +91,7 @@ func sweepChannels(region string) error {
 }
 
 func sweepInputs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_medialive_input")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -141,7 +141,7 @@ func sweepInputs(region string) error {
 }
 
 func sweepInputSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_medialive_input_security_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -191,7 +191,7 @@ func sweepInputSecurityGroups(region string) error {
 }
 
 func sweepMultiplexes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_medialive_multiplex")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
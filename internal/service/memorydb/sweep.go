@@ -63,7 +63,7 @@ func init() {
 }
 
 func sweepACLs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_memorydb_acl")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -113,7 +113,7 @@ func sweepACLs(region string) error {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_memorydb_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -157,7 +157,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_memorydb_parameter_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -207,7 +207,7 @@ func sweepParameterGroups(region string) error {
 }
 
 func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_memorydb_snapshot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -251,7 +251,7 @@ func sweepSnapshots(region string) error {
 }
 
 func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_memorydb_subnet_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -301,7 +301,7 @@ func sweepSubnetGroups(region string) error {
 }
 
 func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_memorydb_user")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -24,7 +24,7 @@ func init() {
 }
 
 func sweepEnvironment(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_mwaa_environment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
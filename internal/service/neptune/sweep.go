@@ -9,8 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -24,13 +22,13 @@ func init() {
 }
 
 func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_neptune_event_subscription")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).NeptuneConn()
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeEventSubscriptionsPagesWithContext(ctx, &neptune.DescribeEventSubscriptionsInput{}, func(page *neptune.DescribeEventSubscriptionsOutput, lastPage bool) bool {
 		if page == nil {
@@ -40,41 +38,30 @@ func sweepEventSubscriptions(region string) error {
 		for _, eventSubscription := range page.EventSubscriptionsList {
 			name := aws.StringValue(eventSubscription.CustSubscriptionId)
 
-			log.Printf("[INFO] Deleting Neptune Event Subscription: %s", name)
-			_, err = conn.DeleteEventSubscriptionWithContext(ctx, &neptune.DeleteEventSubscriptionInput{
-				SubscriptionName: aws.String(name),
-			})
-			if tfawserr.ErrCodeEquals(err, neptune.ErrCodeSubscriptionNotFoundFault) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting Neptune Event Subscription (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceEventSubscription()
+			d := r.Data(nil)
+			d.SetId(name)
 
-			_, err = WaitEventSubscriptionDeleted(ctx, conn, name)
-			if tfawserr.ErrCodeEquals(err, neptune.ErrCodeSubscriptionNotFoundFault) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("waiting for Neptune Event Subscription (%s) deletion: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name)))
 		}
 
 		return !lastPage
 	})
+
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping Neptune Event Subscriptions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("retrieving Neptune Event Subscriptions: %w", err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving Neptune Event Subscriptions: %w", err))
+		return fmt.Errorf("sweeping Neptune Event Subscriptions (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
@@ -46,7 +46,7 @@ func init() {
 }
 
 func sweepFirewallPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkfirewall_firewall_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -90,7 +90,7 @@ func sweepFirewallPolicies(region string) error {
 }
 
 func sweepFirewalls(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkfirewall_firewall")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -134,7 +134,7 @@ func sweepFirewalls(region string) error {
 }
 
 func sweepLoggingConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkfirewall_logging_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -178,7 +178,7 @@ func sweepLoggingConfigurations(region string) error {
 }
 
 func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkfirewall_rule_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -104,7 +104,7 @@ func init() {
 }
 
 func sweepGlobalNetworks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_global_network")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -148,7 +148,7 @@ func sweepGlobalNetworks(region string) error {
 }
 
 func sweepCoreNetworks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_core_network")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -192,7 +192,7 @@ func sweepCoreNetworks(region string) error {
 }
 
 func sweepConnectAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_connect_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -238,7 +238,7 @@ func sweepConnectAttachments(region string) error {
 }
 
 func sweepSiteToSiteVPNAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_site_to_site_vpn_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -284,7 +284,7 @@ func sweepSiteToSiteVPNAttachments(region string) error {
 }
 
 func sweepTransitGatewayPeerings(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_transit_gateway_peering")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -330,7 +330,7 @@ func sweepTransitGatewayPeerings(region string) error {
 }
 
 func sweepTransitGatewayRouteTableAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_transit_gateway_route_table_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -376,7 +376,7 @@ func sweepTransitGatewayRouteTableAttachments(region string) error {
 }
 
 func sweepVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_vpc_attachment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -422,7 +422,7 @@ func sweepVPCAttachments(region string) error {
 }
 
 func sweepSites(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_site")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -490,7 +490,7 @@ func sweepSites(region string) error {
 }
 
 func sweepDevices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_device")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -558,7 +558,7 @@ func sweepDevices(region string) error {
 }
 
 func sweepLinks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_link")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -626,7 +626,7 @@ func sweepLinks(region string) error {
 }
 
 func sweepLinkAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_link_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -693,7 +693,7 @@ func sweepLinkAssociations(region string) error {
 }
 
 func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_networkmanager_connection")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
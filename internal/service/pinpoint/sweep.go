@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_pinpoint_app")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -30,6 +30,7 @@ func sweepApps(region string) error {
 	conn := client.(*conns.AWSClient).PinpointConn()
 
 	input := &pinpoint.GetAppsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.GetAppsWithContext(ctx, input)
@@ -41,21 +42,15 @@ func sweepApps(region string) error {
 			return fmt.Errorf("Error retrieving Pinpoint apps: %s", err)
 		}
 
-		if len(output.ApplicationsResponse.Item) == 0 {
-			log.Print("[DEBUG] No Pinpoint apps to sweep")
-			return nil
-		}
-
 		for _, item := range output.ApplicationsResponse.Item {
-			name := aws.StringValue(item.Name)
+			r := ResourceApp()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(item.Id))
 
-			log.Printf("[INFO] Deleting Pinpoint app %s", name)
-			_, err := conn.DeleteAppWithContext(ctx, &pinpoint.DeleteAppInput{
-				ApplicationId: item.Id,
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Pinpoint app %s: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(item.Name)),
+				sweep.WithTags(aws.StringValueMap(item.Tags)),
+			))
 		}
 
 		if output.ApplicationsResponse.NextToken == nil {
@@ -64,5 +59,11 @@ func sweepApps(region string) error {
 		input.Token = output.ApplicationsResponse.NextToken
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Pinpoint apps (%s): %w", region, err)
+	}
+
 	return nil
 }
@@ -32,7 +32,7 @@ func init() {
 }
 
 func sweepLedgers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_qldb_ledger")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -76,7 +76,7 @@ func sweepLedgers(region string) error {
 }
 
 func sweepStreams(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_qldb_stream")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
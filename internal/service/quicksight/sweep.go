@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepsDataSource(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_quicksight_data_source")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
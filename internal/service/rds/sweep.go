@@ -4,6 +4,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -107,7 +108,7 @@ func init() {
 }
 
 func sweepClusterParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_rds_cluster_parameter_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -157,7 +158,7 @@ func sweepClusterParameterGroups(region string) error {
 }
 
 func sweepClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_cluster_snapshot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -207,7 +208,7 @@ func sweepClusterSnapshots(region string) error {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_rds_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -269,7 +270,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_event_subscription")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -313,7 +314,7 @@ func sweepEventSubscriptions(region string) error {
 }
 
 func sweepGlobalClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_rds_global_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -357,7 +358,7 @@ func sweepGlobalClusters(region string) error {
 }
 
 func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -405,7 +406,7 @@ func sweepInstances(region string) error {
 }
 
 func sweepOptionGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_option_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -455,7 +456,7 @@ func sweepOptionGroups(region string) error {
 }
 
 func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_parameter_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -505,7 +506,7 @@ func sweepParameterGroups(region string) error {
 }
 
 func sweepProxies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_proxy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -549,7 +550,7 @@ func sweepProxies(region string) error {
 }
 
 func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_snapshot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -599,7 +600,7 @@ func sweepSnapshots(region string) error {
 }
 
 func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_subnet_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -643,7 +644,7 @@ func sweepSubnetGroups(region string) error {
 }
 
 func sweepInstanceAutomatedBackups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_db_instance_automated_backups_replication")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -687,20 +688,32 @@ func sweepInstanceAutomatedBackups(region string) error {
 		return fmt.Errorf("error sweeping RDS Instance Automated Backups (%s): %w", region, err)
 	}
 
-	// Since there is no resource for automated backups themselves, they are swept here.
+	// Since there is no resource for automated backups themselves, they are swept here
+	// once their replications have been removed.
+	sweepResources = make([]sweep.Sweepable, 0, len(backupARNs))
+
 	for _, v := range backupARNs {
-		log.Printf("[DEBUG] Deleting RDS Instance Automated Backup: %s", v)
-		_, err = conn.DeleteDBInstanceAutomatedBackupWithContext(ctx, &rds.DeleteDBInstanceAutomatedBackupInput{
-			DBInstanceAutomatedBackupsArn: aws.String(v),
-		})
+		arn := v
 
-		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceAutomatedBackupNotFoundFault) {
-			continue
-		}
+		deleteAutomatedBackup := func(ctx context.Context) error {
+			_, err := conn.DeleteDBInstanceAutomatedBackupWithContext(ctx, &rds.DeleteDBInstanceAutomatedBackupInput{
+				DBInstanceAutomatedBackupsArn: aws.String(arn),
+			})
+
+			if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceAutomatedBackupNotFoundFault) {
+				return nil
+			}
 
-		if err != nil {
-			log.Printf("[ERROR] deleting RDS Instance Automated Backup (%s): %s", v, err)
+			return err
 		}
+
+		sweepResources = append(sweepResources, sweep.NewSweepFunc(arn, deleteAutomatedBackup))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping RDS Instance Automated Backups (%s): %w", region, err)
 	}
 
 	return nil
@@ -4,6 +4,7 @@
 package redshift
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -70,20 +71,20 @@ func init() {
 }
 
 func sweepClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_cluster_snapshot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).RedshiftConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeClusterSnapshotsPagesWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{}, func(resp *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
-		if len(resp.Snapshots) == 0 {
-			log.Print("[DEBUG] No Redshift cluster snapshots to sweep")
-			return false
+	err = conn.DescribeClusterSnapshotsPagesWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{}, func(page *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, s := range resp.Snapshots {
+		for _, s := range page.Snapshots {
 			id := aws.StringValue(s.SnapshotIdentifier)
 
 			if !strings.EqualFold(aws.StringValue(s.SnapshotType), "manual") || !strings.EqualFold(aws.StringValue(s.Status), "available") {
@@ -91,28 +92,44 @@ func sweepClusterSnapshots(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Redshift cluster snapshot: %s", id)
-			_, err := conn.DeleteClusterSnapshotWithContext(ctx, &redshift.DeleteClusterSnapshotInput{
-				SnapshotIdentifier: s.SnapshotIdentifier,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Redshift cluster snapshot (%s): %s", id, err)
+			deleteClusterSnapshot := func(ctx context.Context) error {
+				_, err := conn.DeleteClusterSnapshotWithContext(ctx, &redshift.DeleteClusterSnapshotInput{
+					SnapshotIdentifier: aws.String(id),
+				})
+
+				return err
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, deleteClusterSnapshot,
+				sweep.WithName(id),
+				sweep.WithTags(KeyValueTags(s.Tags).Map()),
+				sweep.WithCreationTime(aws.TimeValue(s.SnapshotCreateTime)),
+			))
 		}
+
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Redshift Cluster Snapshot sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Redshift Cluster Snapshot sweep for %s: %s", region, err)
-			return nil
-		}
 		return fmt.Errorf("Error retrieving Redshift cluster snapshots: %w", err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Redshift cluster snapshots (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -159,7 +176,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_event_subscription")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -203,7 +220,7 @@ func sweepEventSubscriptions(region string) error {
 }
 
 func sweepScheduledActions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_scheduled_action")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -247,7 +264,7 @@ func sweepScheduledActions(region string) error {
 }
 
 func sweepSnapshotSchedules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_snapshot_schedule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -302,7 +319,7 @@ func sweepSnapshotSchedules(region string) error {
 }
 
 func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_subnet_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -358,7 +375,7 @@ func sweepSubnetGroups(region string) error {
 }
 
 func sweepHSMClientCertificates(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_hsm_client_certificate")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -404,7 +421,7 @@ func sweepHSMClientCertificates(region string) error {
 }
 
 func sweepHSMConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_hsm_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -450,7 +467,7 @@ func sweepHSMConfigurations(region string) error {
 }
 
 func sweepAuthenticationProfiles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshift_authentication_profile")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
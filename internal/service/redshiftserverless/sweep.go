@@ -36,7 +36,7 @@ func init() {
 }
 
 func sweepNamespaces(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshiftserverless_namespace")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -80,7 +80,7 @@ func sweepNamespaces(region string) error {
 }
 
 func sweepWorkgroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshiftserverless_workgroup")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -124,7 +124,7 @@ func sweepWorkgroups(region string) error {
 }
 
 func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_redshiftserverless_snapshot")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
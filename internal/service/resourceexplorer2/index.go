@@ -29,6 +29,7 @@ import (
 
 func init() {
 	_sp.registerFrameworkResourceFactory(newResourceIndex)
+	_sp.registerResourceTags("aws_resourceexplorer2_index", "id")
 }
 
 func newResourceIndex(context.Context) (resource.ResourceWithConfigure, error) {
@@ -89,7 +90,7 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 
 	conn := r.Meta().ResourceExplorer2Client()
 
-	tags := r.ExpandTags(ctx, data.Tags)
+	tags := tftags.GetTagsIn(ctx)
 	input := &resourceexplorer2.CreateIndexInput{
 		ClientToken: aws.String(sdkresource.UniqueId()),
 	}
@@ -139,7 +140,6 @@ func (r *resourceIndex) Create(ctx context.Context, request resource.CreateReque
 
 	// Set values for unknowns.
 	data.ARN = types.StringValue(arn)
-	data.TagsAll = r.FlattenTagsAll(ctx, tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -173,9 +173,7 @@ func (r *resourceIndex) Read(ctx context.Context, request resource.ReadRequest,
 	data.ARN = flex.StringToFramework(ctx, output.Arn)
 	data.Type = flex.StringValueToFramework(ctx, output.Type)
 
-	apiTags := KeyValueTags(output.Tags)
-	data.Tags = r.FlattenTags(ctx, apiTags)
-	data.TagsAll = r.FlattenTagsAll(ctx, apiTags)
+	tftags.SetTagsOut(ctx, KeyValueTags(output.Tags))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -219,14 +217,6 @@ func (r *resourceIndex) Update(ctx context.Context, request resource.UpdateReque
 		}
 	}
 
-	if !new.TagsAll.Equal(old.TagsAll) {
-		if err := UpdateTags(ctx, conn, new.ID.ValueString(), old.TagsAll, new.TagsAll); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s) tags", new.ID.ValueString()), err.Error())
-
-			return
-		}
-	}
-
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -266,10 +256,6 @@ func (r *resourceIndex) ImportState(ctx context.Context, request resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
-func (r *resourceIndex) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	r.SetTagsAll(ctx, request, response)
-}
-
 type resourceIndexData struct {
 	ARN      types.String   `tfsdk:"arn"`
 	ID       types.String   `tfsdk:"id"`
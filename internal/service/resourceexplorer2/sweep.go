@@ -22,7 +22,7 @@ func init() {
 }
 
 func sweepIndexes(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_resourceexplorer2_index")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
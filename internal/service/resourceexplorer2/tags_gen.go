@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -39,6 +40,18 @@ func KeyValueTags(tags map[string]string) tftags.KeyValueTags {
 	return tftags.New(tags)
 }
 
+// ListTags lists resourceexplorer2 service tags.
+// It is called by the provider's transparent tagging layer for resources that declare their tags.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) (tftags.KeyValueTags, error) {
+	return ListTags(ctx, meta.(*conns.AWSClient).ResourceExplorer2Client(), identifier)
+}
+
+// UpdateTags updates resourceexplorer2 service tags.
+// It is called by the provider's transparent tagging layer for resources that declare their tags.
+func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
+	return UpdateTags(ctx, meta.(*conns.AWSClient).ResourceExplorer2Client(), identifier, oldTags, newTags)
+}
+
 // UpdateTags updates resourceexplorer2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -34,6 +34,7 @@ import (
 
 func init() {
 	_sp.registerFrameworkResourceFactory(newResourceView)
+	_sp.registerResourceTags("aws_resourceexplorer2_view", "id")
 }
 
 func newResourceView(context.Context) (resource.ResourceWithConfigure, error) {
@@ -121,7 +122,7 @@ func (r *resourceView) Create(ctx context.Context, request resource.CreateReques
 
 	conn := r.Meta().ResourceExplorer2Client()
 
-	tags := r.ExpandTags(ctx, data.Tags)
+	tags := tftags.GetTagsIn(ctx)
 	input := &resourceexplorer2.CreateViewInput{
 		ClientToken:        aws.String(sdkresource.UniqueId()),
 		Filters:            r.expandSearchFilter(ctx, data.Filters),
@@ -160,7 +161,6 @@ func (r *resourceView) Create(ctx context.Context, request resource.CreateReques
 	// Set values for unknowns.
 	data.ARN = types.StringValue(arn)
 	data.ID = types.StringValue(arn)
-	data.TagsAll = r.FlattenTagsAll(ctx, tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -225,9 +225,7 @@ func (r *resourceView) Read(ctx context.Context, request resource.ReadRequest, r
 	name := parts[1]
 	data.Name = types.StringValue(name)
 
-	apiTags := KeyValueTags(output.Tags)
-	data.Tags = r.FlattenTags(ctx, apiTags)
-	data.TagsAll = r.FlattenTagsAll(ctx, apiTags)
+	tftags.SetTagsOut(ctx, KeyValueTags(output.Tags))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -265,14 +263,6 @@ func (r *resourceView) Update(ctx context.Context, request resource.UpdateReques
 		}
 	}
 
-	if !new.TagsAll.Equal(old.TagsAll) {
-		if err := UpdateTags(ctx, conn, new.ID.ValueString(), old.TagsAll, new.TagsAll); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer View (%s) tags", new.ID.ValueString()), err.Error())
-
-			return
-		}
-	}
-
 	if !new.DefaultView.Equal(old.DefaultView) {
 		if new.DefaultView.ValueBool() {
 			input := &resourceexplorer2.AssociateDefaultViewInput{
@@ -331,10 +321,6 @@ func (r *resourceView) ImportState(ctx context.Context, request resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
-func (r *resourceView) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	r.SetTagsAll(ctx, request, response)
-}
-
 func (r *resourceView) expandSearchFilter(ctx context.Context, tfList types.List) *awstypes.SearchFilter {
 	if tfList.IsNull() || tfList.IsUnknown() {
 		return nil
@@ -63,7 +63,7 @@ func init() {
 }
 
 func sweepHealthChecks(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_health_check")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -115,7 +115,7 @@ func sweepHealthChecks(region string) error {
 }
 
 func sweepKeySigningKeys(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_key_signing_key")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -195,7 +195,7 @@ func sweepKeySigningKeys(region string) error {
 }
 
 func sweepQueryLogs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_query_log")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -240,7 +240,7 @@ func sweepQueryLogs(region string) error {
 }
 
 func sweepTrafficPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_traffic_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -284,7 +284,7 @@ func sweepTrafficPolicies(region string) error {
 }
 
 func sweepTrafficPolicyInstances(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_traffic_policy_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -328,7 +328,7 @@ func sweepTrafficPolicyInstances(region string) error {
 }
 
 func sweepZones(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_zone")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
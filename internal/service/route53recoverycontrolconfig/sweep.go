@@ -45,7 +45,7 @@ func init() {
 }
 
 func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53recoverycontrolconfig_cluster")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -89,7 +89,7 @@ func sweepClusters(region string) error {
 }
 
 func sweepControlPanels(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53recoverycontrolconfig_control_panel")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -160,7 +160,7 @@ func sweepControlPanels(region string) error {
 }
 
 func sweepRoutingControls(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53recoverycontrolconfig_routing_control")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -249,7 +249,7 @@ func sweepRoutingControls(region string) error {
 }
 
 func sweepSafetyRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53recoverycontrolconfig_safety_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -92,7 +92,7 @@ func init() {
 }
 
 func sweepDNSSECConfig(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_dnssec_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -137,7 +137,7 @@ func sweepDNSSECConfig(region string) error {
 }
 
 func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_endpoint")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -181,7 +181,7 @@ func sweepEndpoints(region string) error {
 }
 
 func sweepFirewallConfigs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_firewall_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -226,7 +226,7 @@ func sweepFirewallConfigs(region string) error {
 }
 
 func sweepFirewallDomainLists(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_firewall_domain_list")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -270,7 +270,7 @@ func sweepFirewallDomainLists(region string) error {
 }
 
 func sweepFirewallRuleGroupAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_firewall_rule_group_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -314,7 +314,7 @@ func sweepFirewallRuleGroupAssociations(region string) error {
 }
 
 func sweepFirewallRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_firewall_rule_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -358,7 +358,7 @@ func sweepFirewallRuleGroups(region string) error {
 }
 
 func sweepFirewallRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_firewall_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -425,7 +425,7 @@ func sweepFirewallRules(region string) error {
 }
 
 func sweepQueryLogAssociationsConfigs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_query_log_config_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -471,7 +471,7 @@ func sweepQueryLogAssociationsConfigs(region string) error {
 }
 
 func sweepQueryLogsConfig(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_query_log_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -515,7 +515,7 @@ func sweepQueryLogsConfig(region string) error {
 }
 
 func sweepRuleAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_rule_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -561,7 +561,7 @@ func sweepRuleAssociations(region string) error {
 }
 
 func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_route53_resolver_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
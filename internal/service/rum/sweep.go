@@ -23,7 +23,7 @@ func init() {
 }
 
 func sweepAppMonitors(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_rum_app_monitor")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func init() {
@@ -368,6 +369,35 @@ func TestAccS3Bucket_disappears(t *testing.T) {
 	})
 }
 
+func TestS3Bucket_fakeAWS(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	resourceName := "aws_s3_bucket.test"
+
+	acctest.FakeAWSLifecycleTest(ctx, t, acctest.FakeAWSLifecycle{
+		CheckDestroy: testAccCheckBucketDestroyWithProvider(ctx),
+		CheckExists: func(providerF func() *schema.Provider) resource.TestCheckFunc {
+			return testAccCheckBucketExistsWithProvider(ctx, resourceName, providerF)
+		},
+		Config: testAccBucketConfig_tags1(bucketName, "key1", "value1"),
+		Checks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+		},
+		ImportStateVerifyIgnore: []string{"force_destroy"},
+		UpdateConfig:            testAccBucketConfig_tags1(bucketName, "key1", "value1updated"),
+		UpdateChecks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+		},
+		ReadService:   names.S3,
+		ReadOperation: "HeadBucket",
+		Resource:      tfs3.ResourceBucket(),
+		ResourceName:  resourceName,
+	})
+}
+
 func TestAccS3Bucket_Duplicate_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
@@ -3068,6 +3098,18 @@ resource "aws_s3_bucket" "test" {
 `, bucketName)
 }
 
+func testAccBucketConfig_tags1(bucketName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, bucketName, tagKey1, tagValue1)
+}
+
 func testAccBucketConfig_acceleration(bucketName, acceleration string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -41,7 +41,7 @@ func init() {
 }
 
 func sweepObjects(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_s3_object")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -116,7 +116,7 @@ func (os objectSweeper) Delete(ctx context.Context, timeout time.Duration, optFn
 }
 
 func sweepBuckets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_s3_bucket")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -42,7 +42,7 @@ func init() {
 }
 
 func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_s3_access_point")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -95,7 +95,7 @@ func sweepAccessPoints(region string) error {
 }
 
 func sweepMultiRegionAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_s3control_multi_region_access_point")
 	if region != endpoints.UsWest2RegionID {
 		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
 		return nil
@@ -147,7 +147,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 }
 
 func sweepObjectLambdaAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_s3control_object_lambda_access_point")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -194,7 +194,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 }
 
 func sweepStorageLensConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_s3control_storage_lens_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -118,7 +118,7 @@ func init() {
 
 	resource.AddTestSweepers("aws_sagemaker_space", &resource.Sweeper{
 		Name: "aws_sagemaker_space",
-		F:    sweepUserProfiles,
+		F:    sweepSpaces,
 		Dependencies: []string{
 			"aws_sagemaker_app",
 		},
@@ -152,7 +152,7 @@ func init() {
 }
 
 func sweepAppImagesConfig(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_app_image_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -201,7 +201,7 @@ func sweepAppImagesConfig(region string) error {
 }
 
 func sweepSpaces(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_space")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -242,7 +242,7 @@ func sweepSpaces(region string) error {
 }
 
 func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_app")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -290,7 +290,7 @@ func sweepApps(region string) error {
 }
 
 func sweepCodeRepositories(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_code_repository")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -328,7 +328,7 @@ func sweepCodeRepositories(region string) error {
 }
 
 func sweepDeviceFleets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_device_fleet")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -368,7 +368,7 @@ func sweepDeviceFleets(region string) error {
 }
 
 func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_domain")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -408,7 +408,7 @@ func sweepDomains(region string) error {
 }
 
 func sweepEndpointConfigurations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_endpoint_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -449,7 +449,7 @@ func sweepEndpointConfigurations(region string) error {
 }
 
 func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_endpoint")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -460,29 +460,42 @@ func sweepEndpoints(region string) error {
 		NameContains: aws.String(sweep.ResourcePrefix),
 	}
 	resp, err := conn.ListEndpointsWithContext(ctx, req)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Endpoint sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("listing endpoints: %s", err)
 	}
 
-	if len(resp.Endpoints) == 0 {
-		log.Print("[DEBUG] No SageMaker Endpoint to sweep")
-		return nil
-	}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for _, endpoint := range resp.Endpoints {
-		_, err := conn.DeleteEndpointWithContext(ctx, &sagemaker.DeleteEndpointInput{
-			EndpointName: endpoint.EndpointName,
-		})
-		if err != nil {
-			return fmt.Errorf("deleting SageMaker Endpoint (%s): %s", aws.StringValue(endpoint.EndpointName), err)
-		}
+		name := aws.StringValue(endpoint.EndpointName)
+
+		r := ResourceEndpoint()
+		d := r.Data(nil)
+		d.SetId(name)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+			sweep.WithName(name),
+			sweep.WithCreationTime(aws.TimeValue(endpoint.CreationTime)),
+		))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SageMaker Endpoints (%s): %w", region, err)
 	}
 
 	return nil
 }
 
 func sweepFeatureGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_feature_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -520,7 +533,7 @@ func sweepFeatureGroups(region string) error {
 }
 
 func sweepFlowDefinitions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_flow_definition")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -559,7 +572,7 @@ func sweepFlowDefinitions(region string) error {
 }
 
 func sweepHumanTaskUIs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_human_task_ui")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -598,7 +611,7 @@ func sweepHumanTaskUIs(region string) error {
 }
 
 func sweepImages(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_image")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -636,7 +649,7 @@ func sweepImages(region string) error {
 }
 
 func sweepModelPackageGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_model_package_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -674,7 +687,7 @@ func sweepModelPackageGroups(region string) error {
 }
 
 func sweepModels(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_model")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -712,7 +725,7 @@ func sweepModels(region string) error {
 }
 
 func sweepNotebookInstanceLifecycleConfiguration(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_notebook_instance_lifecycle_configuration")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -755,7 +768,7 @@ func sweepNotebookInstanceLifecycleConfiguration(region string) error {
 }
 
 func sweepNotebookInstances(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_notebook_instance")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -795,7 +808,7 @@ func sweepNotebookInstances(region string) error {
 }
 
 func sweepStudioLifecyclesConfig(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_studio_lifecycle_config")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -834,7 +847,7 @@ func sweepStudioLifecyclesConfig(region string) error {
 }
 
 func sweepUserProfiles(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_user_profile")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -875,7 +888,7 @@ func sweepUserProfiles(region string) error {
 }
 
 func sweepWorkforces(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_workforce")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -914,7 +927,7 @@ func sweepWorkforces(region string) error {
 }
 
 func sweepWorkteams(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_workteam")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -953,7 +966,7 @@ func sweepWorkteams(region string) error {
 }
 
 func sweepProjects(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sagemaker_project")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -31,7 +31,7 @@ func init() {
 }
 
 func sweepScheduleGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_scheduler_schedule_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -81,7 +81,7 @@ func sweepScheduleGroups(region string) error {
 }
 
 func sweepSchedules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_scheduler_schedule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
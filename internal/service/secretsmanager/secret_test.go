@@ -9,11 +9,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAccSecretsManagerSecret_basic(t *testing.T) {
@@ -55,6 +57,35 @@ func TestAccSecretsManagerSecret_basic(t *testing.T) {
 	})
 }
 
+func TestSecretsManagerSecret_fakeAWS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var secret secretsmanager.DescribeSecretOutput
+	resourceName := "aws_secretsmanager_secret.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.FakeAWSLifecycleTest(ctx, t, acctest.FakeAWSLifecycle{
+		CheckDestroy: testAccCheckSecretDestroyWithProvider(ctx),
+		CheckExists: func(providerF func() *schema.Provider) resource.TestCheckFunc {
+			return testAccCheckSecretExistsWithProvider(ctx, resourceName, &secret, providerF)
+		},
+		// With no recovery window, the secret is deleted immediately rather than scheduled for deletion.
+		Config: testAccSecretConfig_recoveryWindowInDays(rName, 0),
+		Checks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "name", rName),
+			resource.TestCheckResourceAttr(resourceName, "description", ""),
+		},
+		ImportStateVerifyIgnore: []string{"recovery_window_in_days", "force_overwrite_replica_secret"},
+		UpdateConfig:            testAccSecretConfig_recoveryWindowInDaysDescription(rName, 0, "description"),
+		UpdateChecks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "description", "description"),
+		},
+		ReadService:   names.SecretsManager,
+		ReadOperation: "DescribeSecret",
+		Resource:      tfsecretsmanager.ResourceSecret(),
+		ResourceName:  resourceName,
+	})
+}
+
 func TestAccSecretsManagerSecret_withNamePrefix(t *testing.T) {
 	ctx := acctest.Context(t)
 	var secret secretsmanager.DescribeSecretOutput
@@ -460,8 +491,12 @@ func TestAccSecretsManagerSecret_policy(t *testing.T) {
 }
 
 func testAccCheckSecretDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn()
+	return func(s *terraform.State) error { return testAccCheckSecretDestroyWithProvider(ctx)(s, acctest.Provider) }
+}
+
+func testAccCheckSecretDestroyWithProvider(ctx context.Context) acctest.TestCheckWithProviderFunc {
+	return func(s *terraform.State, provider *schema.Provider) error {
+		conn := provider.Meta().(*conns.AWSClient).SecretsManagerConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_secretsmanager_secret" {
@@ -486,6 +521,10 @@ func testAccCheckSecretDestroy(ctx context.Context) resource.TestCheckFunc {
 }
 
 func testAccCheckSecretExists(ctx context.Context, n string, v *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
+	return testAccCheckSecretExistsWithProvider(ctx, n, v, func() *schema.Provider { return acctest.Provider })
+}
+
+func testAccCheckSecretExistsWithProvider(ctx context.Context, n string, v *secretsmanager.DescribeSecretOutput, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -496,7 +535,7 @@ func testAccCheckSecretExists(ctx context.Context, n string, v *secretsmanager.D
 			return fmt.Errorf("No Secrets Manager Secret ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn()
+		conn := providerF().Meta().(*conns.AWSClient).SecretsManagerConn()
 
 		output, err := tfsecretsmanager.FindSecretByID(ctx, conn, rs.Primary.ID)
 
@@ -672,6 +711,16 @@ resource "aws_secretsmanager_secret" "test" {
 `, rName, recoveryWindowInDays)
 }
 
+func testAccSecretConfig_recoveryWindowInDaysDescription(rName string, recoveryWindowInDays int, description string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = %[2]d
+  description             = %[3]q
+}
+`, rName, recoveryWindowInDays, description)
+}
+
 func testAccSecretConfig_rotationLambdaARN(rName string) string {
 	return acctest.ConfigLambdaBase(rName, rName, rName) + fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
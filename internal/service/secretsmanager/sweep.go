@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -28,88 +27,96 @@ func init() {
 }
 
 func sweepSecretPolicies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_secretsmanager_secret_policy")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).SecretsManagerConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListSecretsPagesWithContext(ctx, &secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
-		if len(page.SecretList) == 0 {
-			log.Print("[DEBUG] No Secrets Manager Secrets to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, secret := range page.SecretList {
-			name := aws.StringValue(secret.Name)
-
-			log.Printf("[INFO] Deleting Secrets Manager Secret Policy: %s", name)
-			input := &secretsmanager.DeleteResourcePolicyInput{
-				SecretId: aws.String(name),
-			}
-
-			_, err := conn.DeleteResourcePolicyWithContext(ctx, input)
-			if err != nil {
-				if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
-					continue
-				}
-				log.Printf("[ERROR] Failed to delete Secrets Manager Secret Policy (%s): %s", name, err)
-			}
+			r := ResourceSecretPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(secret.ARN))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(secret.Name)),
+				sweep.WithTags(KeyValueTags(secret.Tags).Map()),
+				sweep.WithCreationTime(aws.TimeValue(secret.CreatedDate)),
+			))
 		}
 
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, err)
-			return nil
-		}
 		return fmt.Errorf("Error retrieving Secrets Manager Secrets: %w", err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Secrets Manager Secret Policies (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepSecrets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_secretsmanager_secret")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).SecretsManagerConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListSecretsPagesWithContext(ctx, &secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
-		if len(page.SecretList) == 0 {
-			log.Print("[DEBUG] No Secrets Manager Secrets to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, secret := range page.SecretList {
-			name := aws.StringValue(secret.Name)
-
-			log.Printf("[INFO] Deleting Secrets Manager Secret: %s", name)
-			input := &secretsmanager.DeleteSecretInput{
-				ForceDeleteWithoutRecovery: aws.Bool(true),
-				SecretId:                   aws.String(name),
-			}
-
-			_, err := conn.DeleteSecretWithContext(ctx, input)
-			if err != nil {
-				if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
-					continue
-				}
-				log.Printf("[ERROR] Failed to delete Secrets Manager Secret (%s): %s", name, err)
-			}
+			r := ResourceSecret()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(secret.ARN))
+			d.Set("recovery_window_in_days", 0)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(secret.Name)),
+				sweep.WithTags(KeyValueTags(secret.Tags).Map()),
+				sweep.WithCreationTime(aws.TimeValue(secret.CreatedDate)),
+			))
 		}
 
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, err)
-			return nil
-		}
 		return fmt.Errorf("Error retrieving Secrets Manager Secrets: %s", err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Secrets Manager Secrets (%s): %w", region, err)
+	}
+
 	return nil
 }
@@ -83,7 +83,7 @@ func init() {
 }
 
 func sweepBudgetResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_budget_resource_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -195,7 +195,7 @@ func sweepBudgetResourceAssociations(region string) error {
 }
 
 func sweepConstraints(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_constraint")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -265,7 +265,7 @@ func sweepConstraints(region string) error {
 }
 
 func sweepPrincipalPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_principal_portfolio_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -338,7 +338,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 }
 
 func sweepProductPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_product_portfolio_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -431,7 +431,7 @@ func sweepProductPortfolioAssociations(region string) error {
 }
 
 func sweepProducts(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_product")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -483,7 +483,7 @@ func sweepProducts(region string) error {
 }
 
 func sweepProvisionedProducts(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_provisioned_product")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -538,7 +538,7 @@ func sweepProvisionedProducts(region string) error {
 }
 
 func sweepProvisioningArtifacts(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_provisioning_artifact")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -620,7 +620,7 @@ func sweepProvisioningArtifacts(region string) error {
 }
 
 func sweepServiceActions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_service_action")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -672,7 +672,7 @@ func sweepServiceActions(region string) error {
 }
 
 func sweepTagOptionResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_tag_option_resource_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -744,7 +744,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 }
 
 func sweepTagOptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_servicecatalog_tag_option")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
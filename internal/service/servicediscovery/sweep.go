@@ -46,7 +46,7 @@ func init() {
 }
 
 func sweepHTTPNamespaces(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_service_discovery_http_namespace")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -83,7 +83,7 @@ func sweepHTTPNamespaces(region string) error {
 }
 
 func sweepPrivateDNSNamespaces(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_service_discovery_private_dns_namespace")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -120,7 +120,7 @@ func sweepPrivateDNSNamespaces(region string) error {
 }
 
 func sweepPublicDNSNamespaces(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_service_discovery_public_dns_namespace")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -157,7 +157,7 @@ func sweepPublicDNSNamespaces(region string) error {
 }
 
 func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_service_discovery_service")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -4,13 +4,13 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -24,12 +24,16 @@ func init() {
 
 	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
-		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeDomain) },
+		F: func(region string) error {
+			return sweepIdentities(region, "aws_ses_domain_identity", ses.IdentityTypeDomain)
+		},
 	})
 
 	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
-		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeEmailAddress) },
+		F: func(region string) error {
+			return sweepIdentities(region, "aws_ses_email_identity", ses.IdentityTypeEmailAddress)
+		},
 	})
 
 	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
@@ -39,42 +43,33 @@ func init() {
 }
 
 func sweepConfigurationSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ses_configuration_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).SESConn()
 	input := &ses.ListConfigurationSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.ListConfigurationSetsWithContext(ctx, input)
 		if sweep.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Configuration Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Configuration Sets: %w", err))
-			return sweeperErrs
+			return fmt.Errorf("retrieving SES Configuration Sets: %w", err)
 		}
 
 		for _, configurationSet := range output.ConfigurationSets {
 			name := aws.StringValue(configurationSet.Name)
 
-			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
-			_, err := conn.DeleteConfigurationSetWithContext(ctx, &ses.DeleteConfigurationSetInput{
-				ConfigurationSetName: aws.String(name),
-			})
-			if tfawserr.ErrCodeEquals(err, ses.ErrCodeConfigurationSetDoesNotExistException) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Configuration Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceConfigurationSet()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -83,11 +78,17 @@ func sweepConfigurationSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SES Configuration Sets (%s): %w", region, err)
+	}
+
+	return nil
 }
 
-func sweepIdentities(region, identityType string) error {
-	ctx := sweep.Context(region)
+func sweepIdentities(region, name, identityType string) error {
+	ctx := sweep.Context(region, name)
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -96,7 +97,7 @@ func sweepIdentities(region, identityType string) error {
 	input := &ses.ListIdentitiesInput{
 		IdentityType: aws.String(identityType),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.ListIdentitiesPagesWithContext(ctx, input, func(page *ses.ListIdentitiesOutput, lastPage bool) bool {
 		if page == nil {
@@ -106,81 +107,99 @@ func sweepIdentities(region, identityType string) error {
 		for _, identity := range page.Identities {
 			identity := aws.StringValue(identity)
 
-			log.Printf("[INFO] Deleting SES Identity: %s", identity)
-			_, err = conn.DeleteIdentityWithContext(ctx, &ses.DeleteIdentityInput{
-				Identity: aws.String(identity),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Identity (%s): %w", identity, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			deleteIdentity := func(ctx context.Context) error {
+				_, err := conn.DeleteIdentityWithContext(ctx, &ses.DeleteIdentityInput{
+					Identity: aws.String(identity),
+				})
+
+				return err
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(identity, deleteIdentity, sweep.WithName(identity)))
 		}
 
 		return !lastPage
 	})
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("retrieving SES Identities: %w", err)
 	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Identities: %w", err))
+		return fmt.Errorf("sweeping SES Identities (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepReceiptRuleSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ses_receipt_rule_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).SESConn()
 
-	// You cannot delete the receipt rule set that is currently active.
-	// Setting the name of the receipt rule set to make active to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSetWithContext(ctx, &ses.SetActiveReceiptRuleSetInput{})
+	active, err := conn.DescribeActiveReceiptRuleSetWithContext(ctx, &ses.DescribeActiveReceiptRuleSetInput{})
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("disabling any currently active SES Receipt Rule Set: %w", err)
+		return fmt.Errorf("describing active SES Receipt Rule Set: %w", err)
+	}
+
+	var activeName string
+	if active.Metadata != nil {
+		activeName = aws.StringValue(active.Metadata.Name)
 	}
 
 	input := &ses.ListReceiptRuleSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.ListReceiptRuleSetsWithContext(ctx, input)
 		if sweep.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return nil
 		}
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SES Receipt Rule Sets: %w", err))
-			return sweeperErrs
+			return fmt.Errorf("retrieving SES Receipt Rule Sets: %w", err)
 		}
 
 		for _, ruleSet := range output.RuleSets {
 			name := aws.StringValue(ruleSet.Name)
 
-			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
-			_, err := conn.DeleteReceiptRuleSetWithContext(ctx, &ses.DeleteReceiptRuleSetInput{
-				RuleSetName: aws.String(name),
-			})
-			if tfawserr.ErrCodeEquals(err, ses.ErrCodeRuleSetDoesNotExistException) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SES Receipt Rule Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			deleteReceiptRuleSet := func(ctx context.Context) error {
+				// You cannot delete the receipt rule set that is currently active.
+				// Setting the name of the receipt rule set to make active to null disables all email receiving.
+				if name == activeName {
+					log.Printf("[INFO] Disabling active SES Receipt Rule Set: %s", name)
+					if _, err := conn.SetActiveReceiptRuleSetWithContext(ctx, &ses.SetActiveReceiptRuleSetInput{}); err != nil {
+						return fmt.Errorf("disabling active SES Receipt Rule Set (%s): %w", name, err)
+					}
+				}
+
+				_, err := conn.DeleteReceiptRuleSetWithContext(ctx, &ses.DeleteReceiptRuleSetInput{
+					RuleSetName: aws.String(name),
+				})
+
+				if tfawserr.ErrCodeEquals(err, ses.ErrCodeRuleSetDoesNotExistException) {
+					return nil
+				}
+
+				return err
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(name, deleteReceiptRuleSet,
+				sweep.WithName(name),
+				sweep.WithCreationTime(aws.TimeValue(ruleSet.CreatedTimestamp)),
+			))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -189,5 +208,11 @@ func sweepReceiptRuleSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SES Receipt Rule Sets (%s): %w", region, err)
+	}
+
+	return nil
 }
@@ -27,7 +27,7 @@ func init() {
 }
 
 func sweepActivities(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sfn_activity")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -71,7 +71,7 @@ func sweepActivities(region string) error {
 }
 
 func sweepStateMachines(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sfn_state_machine")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -52,7 +52,7 @@ func init() {
 }
 
 func sweepPlatformApplications(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sns_platform_application")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -96,7 +96,7 @@ func sweepPlatformApplications(region string) error {
 }
 
 func sweepTopics(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sns_topic")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -140,7 +140,7 @@ func sweepTopics(region string) error {
 }
 
 func sweepTopicSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_sns_topic_subscription")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -19,11 +19,20 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+func init() {
+	_sp.registerResourceImport("aws_sns_topic", &intf.ResourceImport{
+		ARNService:           "sns",
+		CloudControlTypeName: "AWS::SNS::Topic",
+		ImportIDIsARN:        true,
+	})
+}
+
 var (
 	topicSchema = map[string]*schema.Schema{
 		"application_failure_feedback_role_arn": {
@@ -10,6 +10,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -104,6 +105,34 @@ func TestAccSNSTopic_disappears(t *testing.T) {
 	})
 }
 
+func TestSNSTopic_fakeAWS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.FakeAWSLifecycleTest(ctx, t, acctest.FakeAWSLifecycle{
+		CheckDestroy: testAccCheckTopicDestroyWithProvider(ctx),
+		CheckExists: func(providerF func() *schema.Provider) resource.TestCheckFunc {
+			return testAccCheckTopicExistsWithProvider(ctx, resourceName, &attributes, providerF)
+		},
+		Config: testAccTopicConfig_tags1(rName, "key1", "value1"),
+		Checks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "name", rName),
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+		},
+		UpdateConfig: testAccTopicConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+		UpdateChecks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+		},
+		Resource:     tfsns.ResourceTopic(),
+		ResourceName: resourceName,
+	})
+}
+
 func TestAccSNSTopic_name(t *testing.T) {
 	ctx := acctest.Context(t)
 	var attributes map[string]string
@@ -610,8 +639,12 @@ func testAccCheckTopicHasDeliveryPolicy(ctx context.Context, n string, expectedP
 }
 
 func testAccCheckTopicDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
+	return func(s *terraform.State) error { return testAccCheckTopicDestroyWithProvider(ctx)(s, acctest.Provider) }
+}
+
+func testAccCheckTopicDestroyWithProvider(ctx context.Context) acctest.TestCheckWithProviderFunc {
+	return func(s *terraform.State, provider *schema.Provider) error {
+		conn := provider.Meta().(*conns.AWSClient).SNSConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_sns_topic" {
@@ -636,6 +669,10 @@ func testAccCheckTopicDestroy(ctx context.Context) resource.TestCheckFunc {
 }
 
 func testAccCheckTopicExists(ctx context.Context, n string, v *map[string]string) resource.TestCheckFunc {
+	return testAccCheckTopicExistsWithProvider(ctx, n, v, func() *schema.Provider { return acctest.Provider })
+}
+
+func testAccCheckTopicExistsWithProvider(ctx context.Context, n string, v *map[string]string, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -646,7 +683,7 @@ func testAccCheckTopicExists(ctx context.Context, n string, v *map[string]string
 			return fmt.Errorf("No SNS Topic ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
+		conn := providerF().Meta().(*conns.AWSClient).SNSConn()
 
 		output, err := tfsns.FindTopicAttributesByARN(ctx, conn, rs.Primary.ID)
 
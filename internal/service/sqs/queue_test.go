@@ -11,11 +11,13 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func init() {
@@ -98,6 +100,36 @@ func TestAccSQSQueue_disappears(t *testing.T) {
 	})
 }
 
+func TestSQSQueue_fakeAWS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.FakeAWSLifecycleTest(ctx, t, acctest.FakeAWSLifecycle{
+		CheckDestroy: testAccCheckQueueDestroyWithProvider(ctx),
+		CheckExists: func(providerF func() *schema.Provider) resource.TestCheckFunc {
+			return testAccCheckQueueExistsWithProvider(ctx, resourceName, &queueAttributes, providerF)
+		},
+		Config: testAccQueueConfig_tags1(rName, "key1", "value1"),
+		Checks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "name", rName),
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+		},
+		UpdateConfig: testAccQueueConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+		UpdateChecks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+			resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+		},
+		ReadService:   names.SQS,
+		ReadOperation: "GetQueueAttributes",
+		Resource:      tfsqs.ResourceQueue(),
+		ResourceName:  resourceName,
+	})
+}
+
 func TestAccSQSQueue_Name_generated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[string]string
@@ -826,6 +858,10 @@ func testAccCheckQueuePolicyAttribute(queueAttributes *map[string]string, rName,
 }
 
 func testAccCheckQueueExists(ctx context.Context, resourceName string, v *map[string]string) resource.TestCheckFunc {
+	return testAccCheckQueueExistsWithProvider(ctx, resourceName, v, func() *schema.Provider { return acctest.Provider })
+}
+
+func testAccCheckQueueExistsWithProvider(ctx context.Context, resourceName string, v *map[string]string, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -836,7 +872,7 @@ func testAccCheckQueueExists(ctx context.Context, resourceName string, v *map[st
 			return fmt.Errorf("No SQS Queue URL is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn()
+		conn := providerF().Meta().(*conns.AWSClient).SQSConn()
 
 		output, err := tfsqs.FindQueueAttributesByURL(ctx, conn, rs.Primary.ID)
 
@@ -851,8 +887,12 @@ func testAccCheckQueueExists(ctx context.Context, resourceName string, v *map[st
 }
 
 func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn()
+	return func(s *terraform.State) error { return testAccCheckQueueDestroyWithProvider(ctx)(s, acctest.Provider) }
+}
+
+func testAccCheckQueueDestroyWithProvider(ctx context.Context) acctest.TestCheckWithProviderFunc {
+	return func(s *terraform.State, provider *schema.Provider) error {
+		conn := provider.Meta().(*conns.AWSClient).SQSConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_sqs_queue" {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -282,6 +283,35 @@ func TestAccSSMParameter_disappears(t *testing.T) {
 	})
 }
 
+func TestSSMParameter_fakeAWS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param ssm.Parameter
+	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	acctest.FakeAWSLifecycleTest(ctx, t, acctest.FakeAWSLifecycle{
+		CheckDestroy: testAccCheckParameterDestroyWithProvider(ctx),
+		CheckExists: func(providerF func() *schema.Provider) resource.TestCheckFunc {
+			return testAccCheckParameterExistsWithProvider(ctx, resourceName, &param, providerF)
+		},
+		Config: testAccParameterConfig_basic(name, "String", "test1"),
+		Checks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "name", name),
+			resource.TestCheckResourceAttr(resourceName, "type", "String"),
+			resource.TestCheckResourceAttr(resourceName, "value", "test1"),
+			resource.TestCheckResourceAttr(resourceName, "version", "1"),
+		},
+		ImportStateVerifyIgnore: []string{"overwrite"},
+		UpdateConfig:            testAccParameterConfig_basic(name, "String", "test2"),
+		UpdateChecks: []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "value", "test2"),
+			resource.TestCheckResourceAttr(resourceName, "version", "2"),
+		},
+		Resource:     tfssm.ResourceParameter(),
+		ResourceName: resourceName,
+	})
+}
+
 func TestAccSSMParameter_Overwrite_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var param ssm.Parameter
@@ -900,6 +930,10 @@ func testAccCheckParameterRecreated(t *testing.T,
 }
 
 func testAccCheckParameterExists(ctx context.Context, n string, param *ssm.Parameter) resource.TestCheckFunc {
+	return testAccCheckParameterExistsWithProvider(ctx, n, param, func() *schema.Provider { return acctest.Provider })
+}
+
+func testAccCheckParameterExistsWithProvider(ctx context.Context, n string, param *ssm.Parameter, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -910,7 +944,7 @@ func testAccCheckParameterExists(ctx context.Context, n string, param *ssm.Param
 			return fmt.Errorf("No SSM Parameter ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+		conn := providerF().Meta().(*conns.AWSClient).SSMConn()
 
 		paramInput := &ssm.GetParametersInput{
 			Names: []*string{
@@ -936,7 +970,13 @@ func testAccCheckParameterExists(ctx context.Context, n string, param *ssm.Param
 
 func testAccCheckParameterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+		return testAccCheckParameterDestroyWithProvider(ctx)(s, acctest.Provider)
+	}
+}
+
+func testAccCheckParameterDestroyWithProvider(ctx context.Context) acctest.TestCheckWithProviderFunc {
+	return func(s *terraform.State, provider *schema.Provider) error {
+		conn := provider.Meta().(*conns.AWSClient).SSMConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ssm_parameter" {
@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -49,7 +48,7 @@ func init() {
 }
 
 func sweepResourceDefaultPatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ssm_default_patch_baseline")
 	c, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -116,7 +115,7 @@ func (s defaultPatchBaselineSweeper) Delete(ctx context.Context, timeout time.Du
 }
 
 func sweepMaintenanceWindows(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ssm_maintenance_window")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -125,7 +124,7 @@ func sweepMaintenanceWindows(region string) error {
 
 	conn := client.(*conns.AWSClient).SSMConn()
 	input := &ssm.DescribeMaintenanceWindowsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for {
 		output, err := conn.DescribeMaintenanceWindowsWithContext(ctx, input)
@@ -140,25 +139,11 @@ func sweepMaintenanceWindows(region string) error {
 		}
 
 		for _, window := range output.WindowIdentities {
-			id := aws.ToString(window.WindowId)
-			input := &ssm.DeleteMaintenanceWindowInput{
-				WindowId: window.WindowId,
-			}
-
-			log.Printf("[INFO] Deleting SSM Maintenance Window: %s", id)
-
-			_, err := conn.DeleteMaintenanceWindowWithContext(ctx, input)
-
-			if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDoesNotExistException) {
-				continue
-			}
+			r := ResourceMaintenanceWindow()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(window.WindowId))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting SSM Maintenance Window (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(aws.ToString(window.Name))))
 		}
 
 		if aws.ToString(output.NextToken) == "" {
@@ -168,11 +153,17 @@ func sweepMaintenanceWindows(region string) error {
 		input.NextToken = output.NextToken
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SSM Maintenance Windows (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepResourcePatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ssm_patch_baseline")
 	c, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -216,7 +207,7 @@ func sweepResourcePatchBaselines(region string) error {
 }
 
 func sweepResourceDataSyncs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ssm_resource_data_sync")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -33,7 +33,7 @@ func init() {
 }
 
 func sweepAccountAssignments(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ssoadmin_account_assignment")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -138,7 +138,7 @@ func sweepAccountAssignments(region string) error {
 }
 
 func sweepPermissionSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_ssoadmin_permission_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -14,6 +14,8 @@ import (
 type servicePackage struct {
 	frameworkDataSourceFactories []func(context.Context) (datasource.DataSourceWithConfigure, error)
 	frameworkResourceFactories   []func(context.Context) (resource.ResourceWithConfigure, error)
+	resourceImports              map[string]*intf.ResourceImport
+	resourceTags                 map[string]*intf.ResourceTags
 	sdkDataSourceFactories       []struct {
 		TypeName string
 		Factory  func() *schema.Resource
@@ -36,6 +38,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.
 	return p.frameworkResourceFactories
 }
 
+func (p *servicePackage) ResourceImport(typeName string) *intf.ResourceImport {
+	return p.resourceImports[typeName]
+}
+
+func (p *servicePackage) ResourceTags(typeName string) *intf.ResourceTags {
+	return p.resourceTags[typeName]
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []struct {
 	TypeName string
 	Factory  func() *schema.Resource
@@ -62,6 +72,20 @@ func (p *servicePackage) registerFrameworkResourceFactory(factory func(context.C
 	p.frameworkResourceFactories = append(p.frameworkResourceFactories, factory)
 }
 
+func (p *servicePackage) registerResourceImport(typeName string, v *intf.ResourceImport) {
+	if p.resourceImports == nil {
+		p.resourceImports = make(map[string]*intf.ResourceImport)
+	}
+	p.resourceImports[typeName] = v
+}
+
+func (p *servicePackage) registerResourceTags(typeName, identifierAttribute string) {
+	if p.resourceTags == nil {
+		p.resourceTags = make(map[string]*intf.ResourceTags)
+	}
+	p.resourceTags[typeName] = &intf.ResourceTags{IdentifierAttribute: identifierAttribute}
+}
+
 func (p *servicePackage) registerSDKDataSourceFactory(typeName string, factory func() *schema.Resource) {
 	p.sdkDataSourceFactories = append(p.sdkDataSourceFactories, struct {
 		TypeName string
@@ -35,7 +35,7 @@ func init() {
 }
 
 func sweepGateways(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_storagegateway_gateway")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -79,7 +79,7 @@ func sweepGateways(region string) error {
 }
 
 func sweepTapePools(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_storagegateway_tape_pool")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -123,7 +123,7 @@ func sweepTapePools(region string) error {
 }
 
 func sweepFileSystemAssociations(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_storagegateway_file_system_association")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -28,7 +28,7 @@ func init() {
 }
 
 func sweepCanaries(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_synthetics_canary")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -28,7 +28,7 @@ func init() {
 }
 
 func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_timestreamwrite_database")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -72,7 +72,7 @@ func sweepDatabases(region string) error {
 }
 
 func sweepTables(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_timestreamwrite_table")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
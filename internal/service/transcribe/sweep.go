@@ -51,7 +51,7 @@ func init() {
 }
 
 func sweepLanguageModels(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_transcribe_language_model")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -101,7 +101,7 @@ func sweepLanguageModels(region string) error {
 }
 
 func sweepMedicalVocabularies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_transcribe_medical_vocabulary")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -152,7 +152,7 @@ func sweepMedicalVocabularies(region string) error {
 }
 
 func sweepVocabularies(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_transcribe_vocabulary")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
@@ -203,7 +203,7 @@ func sweepVocabularies(region string) error {
 }
 
 func sweepVocabularyFilters(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_transcribe_vocabulary_filter")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		fmt.Errorf("error getting client: %s", err)
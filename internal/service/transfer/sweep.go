@@ -30,7 +30,7 @@ func init() {
 }
 
 func sweepServers(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_transfer_server")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -76,7 +76,7 @@ func sweepServers(region string) error {
 }
 
 func sweepWorkflows(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_transfer_workflow")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -130,7 +130,7 @@ func init() {
 }
 
 func sweepByteMatchSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_byte_match_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -205,7 +205,7 @@ func sweepByteMatchSet(region string) error {
 }
 
 func sweepGeoMatchSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_geo_match_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -280,7 +280,7 @@ func sweepGeoMatchSet(region string) error {
 }
 
 func sweepIPSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_ipset")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -355,7 +355,7 @@ func sweepIPSet(region string) error {
 }
 
 func sweepRateBasedRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_rate_based_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -430,7 +430,7 @@ func sweepRateBasedRules(region string) error {
 }
 
 func sweepRegexMatchSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_regex_match_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -505,7 +505,7 @@ func sweepRegexMatchSet(region string) error {
 }
 
 func sweepRegexPatternSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_regex_pattern_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -580,7 +580,7 @@ func sweepRegexPatternSet(region string) error {
 }
 
 func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_rule_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -655,7 +655,7 @@ func sweepRuleGroups(region string) error {
 }
 
 func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -734,7 +734,7 @@ func sweepRules(region string) error {
 }
 
 func sweepSizeConstraintSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_size_constraint_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -809,7 +809,7 @@ func sweepSizeConstraintSet(region string) error {
 }
 
 func sweepSQLInjectionMatchSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_sql_injection_match_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -884,7 +884,7 @@ func sweepSQLInjectionMatchSet(region string) error {
 }
 
 func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_web_acl")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
@@ -963,7 +963,7 @@ func sweepWebACLs(region string) error {
 }
 
 func sweepXSSMatchSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_waf_xss_match_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
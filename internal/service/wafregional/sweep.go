@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfwaf "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
@@ -53,12 +52,13 @@ func init() {
 }
 
 func sweepRateBasedRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafregional_rate_based_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &waf.ListRateBasedRulesInput{}
 
@@ -75,65 +75,9 @@ func sweepRateBasedRules(region string) error {
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRateBasedRuleInput{
-				RuleId: rule.RuleId,
-			}
 			id := aws.StringValue(rule.RuleId)
-			wr := NewRetryer(conn, region)
-
-			_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-				return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
-			})
-
-			if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
-				getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
-					RuleId: rule.RuleId,
-				}
-
-				getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRuleWithContext(ctx, getRateBasedRuleInput)
-
-				if getRateBasedRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rate-Based Rule (%s): %s", id, getRateBasedRuleErr)
-				}
-
-				var updates []*waf.RuleUpdate
-				updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
-					RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
-					RuleId:    rule.RuleId,
-					Updates:   updates,
-				}
-
-				for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
-					}
 
-					updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
-				}
-
-				_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					updateRateBasedRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
-					return conn.UpdateRateBasedRuleWithContext(ctx, updateRateBasedRuleInput)
-				})
-
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rate-Based Rule (%s): %s", id, updateWebACLErr)
-				}
-
-				_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-					return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
-				})
-			}
-
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rate-Based Rule (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, func(ctx context.Context) error { return sweepRateBasedRule(ctx, conn, region, id) }, sweep.WithName(aws.StringValue(rule.Name))))
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -143,18 +87,23 @@ func sweepRateBasedRules(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WAF Regional Rate-Based Rules (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepRegexMatchSet(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafregional_regex_match_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn()
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = listRegexMatchSetsPages(ctx, conn, &waf.ListRegexMatchSetsInput{}, func(page *waf.ListRegexMatchSetsOutput, lastPage bool) bool {
 		if page == nil {
@@ -164,19 +113,22 @@ func sweepRegexMatchSet(region string) error {
 		for _, r := range page.RegexMatchSets {
 			id := aws.StringValue(r.RegexMatchSetId)
 
-			set, err := FindRegexMatchSetByID(ctx, conn, id)
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving WAF Regional Regex Match Set (%s): %w", id, err))
-				continue
-			}
+			deleteRegexMatchSet := func(ctx context.Context) error {
+				set, err := FindRegexMatchSetByID(ctx, conn, id)
+				if err != nil {
+					return fmt.Errorf("error retrieving WAF Regional Regex Match Set (%s): %w", id, err)
+				}
 
-			err = DeleteRegexMatchSetResource(ctx, conn, region, region, id, GetRegexMatchTuplesFromAPIResource(set))
-			if err != nil {
-				if !tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonexistentItemException) {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting WAF Regional Regex Match Set (%s): %w", id, err))
+				err = DeleteRegexMatchSetResource(ctx, conn, region, region, id, GetRegexMatchTuplesFromAPIResource(set))
+
+				if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonexistentItemException) {
+					return nil
 				}
-				continue
+
+				return err
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, deleteRegexMatchSet, sweep.WithName(aws.StringValue(r.Name))))
 		}
 
 		return !lastPage
@@ -184,17 +136,23 @@ func sweepRegexMatchSet(region string) error {
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAF Regional Regex Match Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
 	}
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAF Regional Regex Match Sets: %w", err))
+		return fmt.Errorf("error describing WAF Regional Regex Match Sets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WAF Regional Regex Match Sets (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafregional_rule_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -211,35 +169,43 @@ func sweepRuleGroups(region string) error {
 		return fmt.Errorf("Error describing WAF Regional Rule Groups: %s", err)
 	}
 
-	if len(resp.RuleGroups) == 0 {
-		log.Print("[DEBUG] No AWS WAF Regional Rule Groups to sweep")
-		return nil
-	}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	for _, group := range resp.RuleGroups {
-		rResp, err := conn.ListActivatedRulesInRuleGroupWithContext(ctx, &waf.ListActivatedRulesInRuleGroupInput{
-			RuleGroupId: group.RuleGroupId,
-		})
-		if err != nil {
-			return err
-		}
-		oldRules := tfwaf.FlattenActivatedRules(rResp.ActivatedRules)
-		err = DeleteRuleGroup(ctx, *group.RuleGroupId, oldRules, conn, region)
-		if err != nil {
-			return err
+		id := aws.StringValue(group.RuleGroupId)
+
+		deleteRuleGroup := func(ctx context.Context) error {
+			rResp, err := conn.ListActivatedRulesInRuleGroupWithContext(ctx, &waf.ListActivatedRulesInRuleGroupInput{
+				RuleGroupId: aws.String(id),
+			})
+			if err != nil {
+				return err
+			}
+			oldRules := tfwaf.FlattenActivatedRules(rResp.ActivatedRules)
+
+			return DeleteRuleGroup(ctx, id, oldRules, conn, region)
 		}
+
+		sweepResources = append(sweepResources, sweep.NewSweepFunc(id, deleteRuleGroup, sweep.WithName(aws.StringValue(group.Name))))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WAF Regional Rule Groups (%s): %w", region, err)
 	}
 
 	return nil
 }
 
 func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafregional_rule")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &waf.ListRulesInput{}
 
@@ -256,64 +222,9 @@ func sweepRules(region string) error {
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRuleInput{
-				RuleId: rule.RuleId,
-			}
 			id := aws.StringValue(rule.RuleId)
-			wr := NewRetryer(conn, region)
-
-			_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-				return conn.DeleteRuleWithContext(ctx, deleteInput)
-			})
-
-			if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
-				getRuleInput := &waf.GetRuleInput{
-					RuleId: rule.RuleId,
-				}
-
-				getRuleOutput, getRuleErr := conn.GetRuleWithContext(ctx, getRuleInput)
-
-				if getRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rule (%s): %s", id, getRuleErr)
-				}
 
-				var updates []*waf.RuleUpdate
-				updateRuleInput := &waf.UpdateRuleInput{
-					RuleId:  rule.RuleId,
-					Updates: updates,
-				}
-
-				for _, predicate := range getRuleOutput.Rule.Predicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
-					}
-
-					updateRuleInput.Updates = append(updateRuleInput.Updates, update)
-				}
-
-				_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					updateRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
-					return conn.UpdateRuleWithContext(ctx, updateRuleInput)
-				})
-
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rule (%s): %s", id, updateWebACLErr)
-				}
-
-				_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-					return conn.DeleteRuleWithContext(ctx, deleteInput)
-				})
-			}
-
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rule (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, func(ctx context.Context) error { return sweepRule(ctx, conn, region, id) }, sweep.WithName(aws.StringValue(rule.Name))))
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -323,16 +234,23 @@ func sweepRules(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WAF Regional Rules (%s): %w", region, err)
+	}
+
 	return nil
 }
 
 func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafregional_web_acl")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &waf.ListWebACLsInput{}
 
@@ -349,72 +267,213 @@ func sweepWebACLs(region string) error {
 		}
 
 		for _, webACL := range output.WebACLs {
-			deleteInput := &waf.DeleteWebACLInput{
-				WebACLId: webACL.WebACLId,
-			}
 			id := aws.StringValue(webACL.WebACLId)
-			wr := NewRetryer(conn, region)
 
-			_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-				return conn.DeleteWebACLWithContext(ctx, deleteInput)
-			})
+			sweepResources = append(sweepResources, sweep.NewSweepFunc(id, func(ctx context.Context) error { return sweepWebACL(ctx, conn, region, id) }, sweep.WithName(aws.StringValue(webACL.Name))))
+		}
 
-			if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
-				getWebACLInput := &waf.GetWebACLInput{
-					WebACLId: webACL.WebACLId,
-				}
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
 
-				getWebACLOutput, getWebACLErr := conn.GetWebACLWithContext(ctx, getWebACLInput)
+		input.NextMarker = output.NextMarker
+	}
 
-				if getWebACLErr != nil {
-					return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
-				}
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-				var updates []*waf.WebACLUpdate
-				updateWebACLInput := &waf.UpdateWebACLInput{
-					DefaultAction: getWebACLOutput.WebACL.DefaultAction,
-					Updates:       updates,
-					WebACLId:      webACL.WebACLId,
-				}
+	if err != nil {
+		return fmt.Errorf("error sweeping WAF Regional Web ACLs (%s): %w", region, err)
+	}
 
-				for _, rule := range getWebACLOutput.WebACL.Rules {
-					update := &waf.WebACLUpdate{
-						Action:        aws.String(waf.ChangeActionDelete),
-						ActivatedRule: rule,
-					}
+	return nil
+}
 
-					updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
-				}
+// sweepRateBasedRule removes any predicates from the specified WAF Regional Rate-Based Rule and then deletes it.
+func sweepRateBasedRule(ctx context.Context, conn *wafregional.WAFRegional, region, id string) error {
+	deleteInput := &waf.DeleteRateBasedRuleInput{
+		RuleId: aws.String(id),
+	}
+	wr := NewRetryer(conn, region)
 
-				_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					updateWebACLInput.ChangeToken = token
-					log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
-					return conn.UpdateWebACLWithContext(ctx, updateWebACLInput)
-				})
+	_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+		deleteInput.ChangeToken = token
+		log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
+		return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
+	})
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
-				}
+	if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
+		getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
+			RuleId: aws.String(id),
+		}
+
+		getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRuleWithContext(ctx, getRateBasedRuleInput)
 
-				_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-					return conn.DeleteWebACLWithContext(ctx, deleteInput)
-				})
+		if getRateBasedRuleErr != nil {
+			return fmt.Errorf("error getting WAF Regional Rate-Based Rule (%s): %s", id, getRateBasedRuleErr)
+		}
+
+		var updates []*waf.RuleUpdate
+		updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
+			RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
+			RuleId:    aws.String(id),
+			Updates:   updates,
+		}
+
+		for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
+			update := &waf.RuleUpdate{
+				Action:    aws.String(waf.ChangeActionDelete),
+				Predicate: predicate,
 			}
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Web ACL (%s): %s", id, err)
+			updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
+		}
+
+		_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+			updateRateBasedRuleInput.ChangeToken = token
+			log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
+			return conn.UpdateRateBasedRuleWithContext(ctx, updateRateBasedRuleInput)
+		})
+
+		if updateWebACLErr != nil {
+			return fmt.Errorf("error removing predicates from WAF Regional Rate-Based Rule (%s): %s", id, updateWebACLErr)
+		}
+
+		_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+			deleteInput.ChangeToken = token
+			log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
+			return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WAF Regional Rate-Based Rule (%s): %s", id, err)
+	}
+
+	return nil
+}
+
+// sweepRule removes any predicates from the specified WAF Regional Rule and then deletes it.
+func sweepRule(ctx context.Context, conn *wafregional.WAFRegional, region, id string) error {
+	deleteInput := &waf.DeleteRuleInput{
+		RuleId: aws.String(id),
+	}
+	wr := NewRetryer(conn, region)
+
+	_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+		deleteInput.ChangeToken = token
+		log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
+		return conn.DeleteRuleWithContext(ctx, deleteInput)
+	})
+
+	if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
+		getRuleInput := &waf.GetRuleInput{
+			RuleId: aws.String(id),
+		}
+
+		getRuleOutput, getRuleErr := conn.GetRuleWithContext(ctx, getRuleInput)
+
+		if getRuleErr != nil {
+			return fmt.Errorf("error getting WAF Regional Rule (%s): %s", id, getRuleErr)
+		}
+
+		var updates []*waf.RuleUpdate
+		updateRuleInput := &waf.UpdateRuleInput{
+			RuleId:  aws.String(id),
+			Updates: updates,
+		}
+
+		for _, predicate := range getRuleOutput.Rule.Predicates {
+			update := &waf.RuleUpdate{
+				Action:    aws.String(waf.ChangeActionDelete),
+				Predicate: predicate,
 			}
+
+			updateRuleInput.Updates = append(updateRuleInput.Updates, update)
 		}
 
-		if aws.StringValue(output.NextMarker) == "" {
-			break
+		_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+			updateRuleInput.ChangeToken = token
+			log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
+			return conn.UpdateRuleWithContext(ctx, updateRuleInput)
+		})
+
+		if updateWebACLErr != nil {
+			return fmt.Errorf("error removing predicates from WAF Regional Rule (%s): %s", id, updateWebACLErr)
 		}
 
-		input.NextMarker = output.NextMarker
+		_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+			deleteInput.ChangeToken = token
+			log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
+			return conn.DeleteRuleWithContext(ctx, deleteInput)
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WAF Regional Rule (%s): %s", id, err)
+	}
+
+	return nil
+}
+
+// sweepWebACL removes any rules from the specified WAF Regional Web ACL and then deletes it.
+func sweepWebACL(ctx context.Context, conn *wafregional.WAFRegional, region, id string) error {
+	deleteInput := &waf.DeleteWebACLInput{
+		WebACLId: aws.String(id),
+	}
+	wr := NewRetryer(conn, region)
+
+	_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+		deleteInput.ChangeToken = token
+		log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
+		return conn.DeleteWebACLWithContext(ctx, deleteInput)
+	})
+
+	if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
+		getWebACLInput := &waf.GetWebACLInput{
+			WebACLId: aws.String(id),
+		}
+
+		getWebACLOutput, getWebACLErr := conn.GetWebACLWithContext(ctx, getWebACLInput)
+
+		if getWebACLErr != nil {
+			return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
+		}
+
+		var updates []*waf.WebACLUpdate
+		updateWebACLInput := &waf.UpdateWebACLInput{
+			DefaultAction: getWebACLOutput.WebACL.DefaultAction,
+			Updates:       updates,
+			WebACLId:      aws.String(id),
+		}
+
+		for _, rule := range getWebACLOutput.WebACL.Rules {
+			update := &waf.WebACLUpdate{
+				Action:        aws.String(waf.ChangeActionDelete),
+				ActivatedRule: rule,
+			}
+
+			updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
+		}
+
+		_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+			updateWebACLInput.ChangeToken = token
+			log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
+			return conn.UpdateWebACLWithContext(ctx, updateWebACLInput)
+		})
+
+		if updateWebACLErr != nil {
+			return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
+		}
+
+		_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+			deleteInput.ChangeToken = token
+			log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
+			return conn.DeleteWebACLWithContext(ctx, deleteInput)
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WAF Regional Web ACL (%s): %s", id, err)
 	}
 
 	return nil
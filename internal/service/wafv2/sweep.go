@@ -49,7 +49,7 @@ func init() {
 }
 
 func sweepIPSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafv2_ip_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -98,7 +98,7 @@ func sweepIPSets(region string) error {
 }
 
 func sweepRegexPatternSets(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafv2_regex_pattern_set")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -147,7 +147,7 @@ func sweepRegexPatternSets(region string) error {
 }
 
 func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafv2_rule_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -196,7 +196,7 @@ func sweepRuleGroups(region string) error {
 }
 
 func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_wafv2_web_acl")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -34,7 +33,7 @@ func init() {
 }
 
 func sweepDirectories(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_workspaces_directory")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -78,7 +77,7 @@ func sweepDirectories(region string) error {
 }
 
 func sweepIPGroups(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_workspaces_ip_group")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -122,32 +121,45 @@ func sweepIPGroups(region string) error {
 }
 
 func sweepWorkspace(region string) error {
-	ctx := sweep.Context(region)
+	ctx := sweep.Context(region, "aws_workspaces_workspace")
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).WorkSpacesConn()
+	sweepResources := make([]sweep.Sweepable, 0)
 
-	var errors error
 	input := &workspaces.DescribeWorkspacesInput{}
-	err = conn.DescribeWorkspacesPagesWithContext(ctx, input, func(resp *workspaces.DescribeWorkspacesOutput, _ bool) bool {
-		for _, workspace := range resp.Workspaces {
-			err := WorkspaceDelete(ctx, conn, aws.StringValue(workspace.WorkspaceId), WorkspaceTerminatedTimeout)
-			if err != nil {
-				errors = multierror.Append(errors, err)
-			}
+	err = conn.DescribeWorkspacesPagesWithContext(ctx, input, func(page *workspaces.DescribeWorkspacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
+		for _, workspace := range page.Workspaces {
+			r := ResourceWorkspace()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(workspace.WorkspaceId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return true
+
+		return !lastPage
 	})
+
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping workspaces sweep for %s: %s", region, err)
-		return errors // In case we have completed some pages, but had errors
+		return nil
 	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error listing workspaces: %s", err))
+		return fmt.Errorf("error listing workspaces: %s", err)
 	}
 
-	return errors
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping workspaces (%s): %w", region, err)
+	}
+
+	return nil
 }
@@ -2,6 +2,17 @@ package sweep
 
 import "context"
 
-func Context(region string) context.Context {
-	return context.Background()
+type sweeperNameKey struct{}
+
+// Context returns the context used by the named sweeper's function.
+// The context carries the sweeper's name, which is used in sweep summaries and dry-run reports.
+func Context(region, name string) context.Context {
+	return context.WithValue(context.Background(), sweeperNameKey{}, name)
+}
+
+// sweeperName returns the name of the sweeper running with the specified context.
+func sweeperName(ctx context.Context) string {
+	v, _ := ctx.Value(sweeperNameKey{}).(string)
+
+	return v
 }
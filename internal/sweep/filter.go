@@ -0,0 +1,337 @@
+package sweep
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// ResourceMetadata describes a resource to be swept.
+// It is used to filter the resources to be deleted and in dry-run reports.
+type ResourceMetadata struct {
+	ID           string
+	Name         string
+	Tags         map[string]string
+	CreationTime time.Time
+}
+
+// Describable is implemented by Sweepables that can describe the resource they delete.
+type Describable interface {
+	Metadata(ctx context.Context) ResourceMetadata
+}
+
+type MetadataOptionsFunc func(*ResourceMetadata)
+
+// WithName sets the name of a resource to be swept.
+func WithName(name string) MetadataOptionsFunc {
+	return func(m *ResourceMetadata) {
+		m.Name = name
+	}
+}
+
+// WithTags sets the tags of a resource to be swept.
+func WithTags(tags map[string]string) MetadataOptionsFunc {
+	return func(m *ResourceMetadata) {
+		m.Tags = tags
+	}
+}
+
+// WithCreationTime sets the creation time of a resource to be swept.
+func WithCreationTime(creationTime time.Time) MetadataOptionsFunc {
+	return func(m *ResourceMetadata) {
+		m.CreationTime = creationTime
+	}
+}
+
+const (
+	OutputFormatCSV  = "csv"
+	OutputFormatJSON = "json"
+)
+
+// FilterConfig selects which resources are deleted by sweepers.
+// An empty FilterConfig selects all resources.
+type FilterConfig struct {
+	NamePrefixes []string
+	Tags         map[string]string // Value "" matches any value.
+	ExcludeTags  map[string]string // Value "" matches any value.
+	MinAge       time.Duration
+}
+
+// IsEmpty returns whether no filters are configured.
+func (c FilterConfig) IsEmpty() bool {
+	return len(c.NamePrefixes) == 0 && len(c.Tags) == 0 && len(c.ExcludeTags) == 0 && c.MinAge == 0
+}
+
+// Match returns whether the resource is selected by the filters.
+// If the resource is not selected the reason is also returned.
+// Resources missing the metadata needed by a configured filter are never selected.
+func (c FilterConfig) Match(m ResourceMetadata, now time.Time) (bool, string) {
+	if len(c.NamePrefixes) > 0 {
+		if m.Name == "" {
+			return false, "name unknown"
+		}
+
+		var ok bool
+		for _, prefix := range c.NamePrefixes {
+			if strings.HasPrefix(m.Name, prefix) {
+				ok = true
+				break
+			}
+		}
+
+		if !ok {
+			return false, "name does not match prefix"
+		}
+	}
+
+	if len(c.Tags) > 0 || len(c.ExcludeTags) > 0 {
+		if m.Tags == nil {
+			return false, "tags unknown"
+		}
+
+		for k, v := range c.Tags {
+			if !tagMatches(m.Tags, k, v) {
+				return false, fmt.Sprintf("tag %q does not match", k)
+			}
+		}
+
+		for k, v := range c.ExcludeTags {
+			if tagMatches(m.Tags, k, v) {
+				return false, fmt.Sprintf("excluded by tag %q", k)
+			}
+		}
+	}
+
+	if c.MinAge > 0 {
+		if m.CreationTime.IsZero() {
+			return false, "creation time unknown"
+		}
+
+		if now.Sub(m.CreationTime) < c.MinAge {
+			return false, "younger than minimum age"
+		}
+	}
+
+	return true, ""
+}
+
+func tagMatches(tags map[string]string, key, value string) bool {
+	v, ok := tags[key]
+
+	if !ok {
+		return false
+	}
+
+	return value == "" || v == value
+}
+
+// SweepConfig holds the sweeper dry-run and filter settings.
+type SweepConfig struct {
+	DryRun       bool
+	Filter       FilterConfig
+	OutputFile   string
+	OutputFormat string
+}
+
+// ConfigFromEnv reads the sweeper dry-run and filter settings from environment variables.
+func ConfigFromEnv() (*SweepConfig, error) {
+	config := &SweepConfig{
+		OutputFile:   os.Getenv(envvar.SweepOutputFile),
+		OutputFormat: strings.ToLower(envvar.GetWithDefault(envvar.SweepOutputFormat, OutputFormatJSON)),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+
+		config.DryRun = dryRun
+	}
+
+	switch config.OutputFormat {
+	case OutputFormatCSV, OutputFormatJSON:
+	default:
+		return nil, fmt.Errorf("environment variable %s: unsupported format %q", envvar.SweepOutputFormat, config.OutputFormat)
+	}
+
+	if v := os.Getenv(envvar.SweepNamePrefix); v != "" {
+		config.Filter.NamePrefixes = strings.Split(v, ",")
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		config.Filter.Tags = parseTagFilter(v)
+	}
+
+	if v := os.Getenv(envvar.SweepExcludeTags); v != "" {
+		config.Filter.ExcludeTags = parseTagFilter(v)
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+
+		config.Filter.MinAge = minAge
+	}
+
+	return config, nil
+}
+
+// parseTagFilter parses a comma-separated list of "key=value" or "key" tag filters.
+func parseTagFilter(s string) map[string]string {
+	tags := make(map[string]string)
+
+	for _, v := range strings.Split(s, ",") {
+		key, value, _ := strings.Cut(v, "=")
+		tags[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return tags
+}
+
+const (
+	reportActionDelete  = "delete"
+	reportActionExclude = "exclude"
+)
+
+type reportRecord struct {
+	ResourceType string            `json:"resource_type,omitempty"`
+	ID           string            `json:"id"`
+	Name         string            `json:"name,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	CreationTime *time.Time        `json:"creation_time,omitempty"`
+	Action       string            `json:"action"`
+	Reason       string            `json:"reason,omitempty"`
+}
+
+func newReportRecord(resourceType string, m ResourceMetadata, action, reason string) reportRecord {
+	record := reportRecord{
+		ResourceType: resourceType,
+		ID:           m.ID,
+		Name:         m.Name,
+		Tags:         m.Tags,
+		Action:       action,
+		Reason:       reason,
+	}
+
+	if !m.CreationTime.IsZero() {
+		t := m.CreationTime.UTC()
+		record.CreationTime = &t
+	}
+
+	return record
+}
+
+var reportHeader = []string{"resource_type", "id", "name", "tags", "creation_time", "action", "reason"}
+
+func (r reportRecord) csv() []string {
+	keys := make([]string, 0, len(r.Tags))
+	for k := range r.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]string, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, k+"="+r.Tags[k])
+	}
+
+	var creationTime string
+	if r.CreationTime != nil {
+		creationTime = r.CreationTime.Format(time.RFC3339)
+	}
+
+	return []string{r.ResourceType, r.ID, r.Name, strings.Join(tags, ";"), creationTime, r.Action, r.Reason}
+}
+
+// reportWriter writes dry-run records as JSON Lines or CSV.
+// A single writer is shared by all sweepers in the process.
+type reportWriter struct {
+	mu            sync.Mutex
+	w             io.Writer
+	format        string
+	headerWritten bool
+}
+
+var (
+	reports   = make(map[string]*reportWriter)
+	reportsMu sync.Mutex
+)
+
+// reportWriterFor returns the shared writer for the configured output, opening the output file on first use.
+func reportWriterFor(config *SweepConfig) (*reportWriter, error) {
+	reportsMu.Lock()
+	defer reportsMu.Unlock()
+
+	key := config.OutputFile + "|" + config.OutputFormat
+
+	if w, ok := reports[key]; ok {
+		return w, nil
+	}
+
+	w := &reportWriter{
+		format: config.OutputFormat,
+		w:      os.Stdout,
+	}
+
+	if config.OutputFile != "" {
+		f, err := os.OpenFile(config.OutputFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+
+		if err != nil {
+			return nil, fmt.Errorf("opening sweeper output file (%s): %w", config.OutputFile, err)
+		}
+
+		info, err := f.Stat()
+
+		if err != nil {
+			return nil, fmt.Errorf("opening sweeper output file (%s): %w", config.OutputFile, err)
+		}
+
+		w.w = f
+		w.headerWritten = info.Size() > 0
+	}
+
+	reports[key] = w
+
+	return w, nil
+}
+
+func (w *reportWriter) write(record reportRecord) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.format == OutputFormatCSV {
+		cw := csv.NewWriter(w.w)
+
+		if !w.headerWritten {
+			if err := cw.Write(reportHeader); err != nil {
+				return err
+			}
+			w.headerWritten = true
+		}
+
+		if err := cw.Write(record.csv()); err != nil {
+			return err
+		}
+
+		cw.Flush()
+
+		return cw.Error()
+	}
+
+	return json.NewEncoder(w.w).Encode(record)
+}
@@ -0,0 +1,267 @@
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFilterConfigMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.January, 20, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		config   FilterConfig
+		metadata ResourceMetadata
+		expected bool
+	}{
+		"empty": {
+			metadata: ResourceMetadata{ID: "id-1"},
+			expected: true,
+		},
+		"name prefix match": {
+			config:   FilterConfig{NamePrefixes: []string{"tf-acc-test", "tf-test"}},
+			metadata: ResourceMetadata{ID: "id-1", Name: "tf-test-123"},
+			expected: true,
+		},
+		"name prefix no match": {
+			config:   FilterConfig{NamePrefixes: []string{"tf-acc-test"}},
+			metadata: ResourceMetadata{ID: "id-1", Name: "production"},
+		},
+		"name prefix unknown name": {
+			config:   FilterConfig{NamePrefixes: []string{"tf-acc-test"}},
+			metadata: ResourceMetadata{ID: "id-1"},
+		},
+		"tag key match": {
+			config:   FilterConfig{Tags: map[string]string{"Team": ""}},
+			metadata: ResourceMetadata{ID: "id-1", Tags: map[string]string{"Team": "sandbox"}},
+			expected: true,
+		},
+		"tag value match": {
+			config:   FilterConfig{Tags: map[string]string{"Team": "sandbox"}},
+			metadata: ResourceMetadata{ID: "id-1", Tags: map[string]string{"Team": "sandbox"}},
+			expected: true,
+		},
+		"tag value no match": {
+			config:   FilterConfig{Tags: map[string]string{"Team": "sandbox"}},
+			metadata: ResourceMetadata{ID: "id-1", Tags: map[string]string{"Team": "platform"}},
+		},
+		"tags unknown": {
+			config:   FilterConfig{Tags: map[string]string{"Team": "sandbox"}},
+			metadata: ResourceMetadata{ID: "id-1"},
+		},
+		"exclude tag match": {
+			config:   FilterConfig{ExcludeTags: map[string]string{"Team": "platform"}},
+			metadata: ResourceMetadata{ID: "id-1", Tags: map[string]string{"Team": "platform"}},
+		},
+		"exclude tag no match": {
+			config:   FilterConfig{ExcludeTags: map[string]string{"Team": "platform"}},
+			metadata: ResourceMetadata{ID: "id-1", Tags: map[string]string{}},
+			expected: true,
+		},
+		"min age old enough": {
+			config:   FilterConfig{MinAge: 24 * time.Hour},
+			metadata: ResourceMetadata{ID: "id-1", CreationTime: now.Add(-48 * time.Hour)},
+			expected: true,
+		},
+		"min age too young": {
+			config:   FilterConfig{MinAge: 24 * time.Hour},
+			metadata: ResourceMetadata{ID: "id-1", CreationTime: now.Add(-1 * time.Hour)},
+		},
+		"min age unknown creation time": {
+			config:   FilterConfig{MinAge: 24 * time.Hour},
+			metadata: ResourceMetadata{ID: "id-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.config.Match(testCase.metadata, now)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t (%s)", testCase.expected, got, reason)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("TF_AWS_SWEEP_DRY_RUN", "true")
+	t.Setenv("TF_AWS_SWEEP_OUTPUT_FORMAT", "CSV")
+	t.Setenv("TF_AWS_SWEEP_NAME_PREFIX", "tf-acc-test,tf-test")
+	t.Setenv("TF_AWS_SWEEP_TAGS", "Team=sandbox, Environment")
+	t.Setenv("TF_AWS_SWEEP_EXCLUDE_TAGS", "Owner=platform")
+	t.Setenv("TF_AWS_SWEEP_MIN_AGE", "6h")
+
+	got, err := ConfigFromEnv()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &SweepConfig{
+		DryRun: true,
+		Filter: FilterConfig{
+			NamePrefixes: []string{"tf-acc-test", "tf-test"},
+			Tags:         map[string]string{"Team": "sandbox", "Environment": ""},
+			ExcludeTags:  map[string]string{"Owner": "platform"},
+			MinAge:       6 * time.Hour,
+		},
+		OutputFormat: OutputFormatCSV,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	t.Setenv("TF_AWS_SWEEP_OUTPUT_FORMAT", "yaml")
+
+	if _, err := ConfigFromEnv(); err == nil {
+		t.Error("expected error for unsupported output format, got none")
+	}
+}
+
+func TestReportWriter(t *testing.T) {
+	t.Parallel()
+
+	creationTime := time.Date(2023, time.January, 20, 12, 0, 0, 0, time.UTC)
+	metadata := ResourceMetadata{
+		ID:           "vpc-12345678",
+		Name:         "tf-acc-test",
+		Tags:         map[string]string{"Name": "tf-acc-test", "Team": "sandbox"},
+		CreationTime: creationTime,
+	}
+
+	testCases := map[string]struct {
+		format   string
+		expected string
+	}{
+		"json": {
+			format:   OutputFormatJSON,
+			expected: `{"resource_type":"aws_vpc","id":"vpc-12345678","name":"tf-acc-test","tags":{"Name":"tf-acc-test","Team":"sandbox"},"creation_time":"2023-01-20T12:00:00Z","action":"delete"}` + "\n",
+		},
+		"csv": {
+			format: OutputFormatCSV,
+			expected: "resource_type,id,name,tags,creation_time,action,reason\n" +
+				"aws_vpc,vpc-12345678,tf-acc-test,Name=tf-acc-test;Team=sandbox,2023-01-20T12:00:00Z,delete,\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w := &reportWriter{w: &buf, format: testCase.format}
+
+			if err := w.write(newReportRecord("aws_vpc", metadata, reportActionDelete, "")); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := buf.String(); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+type testDescribableSweepable struct {
+	metadata ResourceMetadata
+	deleted  *bool
+}
+
+func (s testDescribableSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	*s.deleted = true
+
+	return nil
+}
+
+func (s testDescribableSweepable) Metadata(ctx context.Context) ResourceMetadata {
+	return s.metadata
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "sweep.json")
+
+	t.Setenv("TF_AWS_SWEEP_DRY_RUN", "1")
+	t.Setenv("TF_AWS_SWEEP_OUTPUT_FILE", output)
+	t.Setenv("TF_AWS_SWEEP_TAGS", "Team=sandbox")
+
+	var deleted bool
+	sweepables := []Sweepable{
+		testDescribableSweepable{metadata: ResourceMetadata{ID: "id-1", Tags: map[string]string{"Team": "sandbox"}}, deleted: &deleted},
+		testDescribableSweepable{metadata: ResourceMetadata{ID: "id-2", Tags: map[string]string{"Team": "platform"}}, deleted: &deleted},
+	}
+
+	ctx := Context("us-west-2", "aws_example_thing")
+
+	if err := SweepOrchestratorWithContext(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if deleted {
+		t.Error("expected no resources to be deleted in dry-run mode")
+	}
+
+	b, err := os.ReadFile(output)
+
+	if err != nil {
+		t.Fatalf("reading output: %s", err)
+	}
+
+	got := string(b)
+
+	if !strings.Contains(got, `"resource_type":"aws_example_thing","id":"id-1","tags":{"Team":"sandbox"},"action":"delete"`) {
+		t.Errorf("expected id-1 to be listed for deletion, got %s", got)
+	}
+
+	if !strings.Contains(got, `"resource_type":"aws_example_thing","id":"id-2","tags":{"Team":"platform"},"action":"exclude"`) {
+		t.Errorf("expected id-2 to be excluded, got %s", got)
+	}
+}
+
+func TestSweepFuncFiltered(t *testing.T) {
+	t.Setenv("TF_AWS_SWEEP_NAME_PREFIX", "tf-acc-test-")
+
+	var deleted []string
+	var mu sync.Mutex
+	newSweepFunc := func(id string, optFns ...MetadataOptionsFunc) Sweepable {
+		return NewSweepFunc(id, func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			deleted = append(deleted, id)
+
+			return nil
+		}, optFns...)
+	}
+
+	sweepables := []Sweepable{
+		newSweepFunc("id-1", WithName("tf-acc-test-1")),
+		newSweepFunc("id-2", WithName("production")),
+		newSweepFunc("id-3"),
+	}
+
+	if err := SweepOrchestratorWithContext(Context("us-west-2", "aws_example_thing"), sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := strings.Join(deleted, ","), "id-1"; got != want {
+		t.Errorf("expected deletions %s, got %s", want, got)
+	}
+}
@@ -3,12 +3,12 @@ package sweep
 import (
 	"context"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
@@ -18,17 +18,60 @@ import (
 // Terraform Plugin Framework variants of sweeper helpers.
 
 type SweepFrameworkResource struct {
-	factory func(context.Context) (fwresource.ResourceWithConfigure, error)
-	id      string // TODO Currently we can only delete a resource if "id" is the only attribute used.
-	meta    interface{}
+	factory  func(context.Context) (fwresource.ResourceWithConfigure, error)
+	id       string // TODO Currently we can only delete a resource if "id" is the only attribute used.
+	meta     interface{}
+	metadata ResourceMetadata
+	read     bool
 }
 
-func NewSweepFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) *SweepFrameworkResource {
-	return &SweepFrameworkResource{
+func NewSweepFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, optFns ...MetadataOptionsFunc) *SweepFrameworkResource {
+	sr := &SweepFrameworkResource{
 		factory: factory,
 		id:      id,
 		meta:    meta,
 	}
+
+	for _, fn := range optFns {
+		fn(&sr.metadata)
+	}
+
+	return sr
+}
+
+// Metadata returns the resource's metadata.
+// Name and tags not set explicitly are taken from the resource's "name" and "tags" attributes,
+// read the first time they are needed.
+func (sr *SweepFrameworkResource) Metadata(ctx context.Context) ResourceMetadata {
+	if !sr.read && (sr.metadata.Name == "" || sr.metadata.Tags == nil) {
+		sr.read = true
+
+		state, err := ReadFrameworkResource(ctx, sr.factory, sr.id, sr.meta)
+
+		if err != nil {
+			log.Printf("[WARN] Reading resource (%s) for sweeper metadata: %s", sr.id, err)
+		} else {
+			if sr.metadata.Name == "" {
+				var name types.String
+				if diags := state.GetAttribute(ctx, path.Root("name"), &name); !diags.HasError() {
+					sr.metadata.Name = name.ValueString()
+				}
+			}
+
+			if sr.metadata.Tags == nil {
+				var tags types.Map
+				if diags := state.GetAttribute(ctx, path.Root("tags"), &tags); !diags.HasError() {
+					sr.metadata.Tags = make(map[string]string)
+					tags.ElementsAs(ctx, &sr.metadata.Tags, false)
+				}
+			}
+		}
+	}
+
+	m := sr.metadata
+	m.ID = sr.id
+
+	return m
 }
 
 func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
@@ -36,7 +79,7 @@ func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Durat
 		err := DeleteFrameworkResource(sr.factory, sr.id, sr.meta)
 
 		if err != nil {
-			if strings.Contains(err.Error(), "Throttling") {
+			if isThrottlingError(err) {
 				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sr.id, err)
 				return resource.RetryableError(err)
 			}
@@ -57,25 +100,58 @@ func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Durat
 func DeleteFrameworkResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) error {
 	ctx := context.Background()
 
-	resource, err := factory(ctx)
+	resource, state, err := newFrameworkResourceState(ctx, factory, id, meta)
 
 	if err != nil {
 		return err
 	}
 
+	response := fwresource.DeleteResponse{}
+	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
+
+	return fwdiag.DiagnosticsError(response.Diagnostics)
+}
+
+// ReadFrameworkResource reads the resource with the specified ID and returns its state.
+func ReadFrameworkResource(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) (tfsdk.State, error) {
+	r, state, err := newFrameworkResourceState(ctx, factory, id, meta)
+
+	if err != nil {
+		return tfsdk.State{}, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return tfsdk.State{}, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return tfsdk.State{}, &resource.NotFoundError{}
+	}
+
+	return response.State, nil
+}
+
+// newFrameworkResourceState returns a configured resource and a simple Terraform State that contains just the resource ID.
+func newFrameworkResourceState(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := factory(ctx)
+
+	if err != nil {
+		return nil, tfsdk.State{}, err
+	}
+
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: meta}, &fwresource.ConfigureResponse{})
 
 	schemaResp := fwresource.SchemaResponse{}
 	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
 
-	// Simple Terraform State that contains just the resource ID.
 	state := tfsdk.State{
 		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
 		Schema: schemaResp.Schema,
 	}
 	state.SetAttribute(ctx, path.Root("id"), id)
-	response := fwresource.DeleteResponse{}
-	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
 
-	return fwdiag.DiagnosticsError(response.Diagnostics)
+	return resource, state, nil
 }
@@ -0,0 +1,321 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// DefaultSweepParallelism is the maximum number of resources deleted concurrently
+// unless overridden by the TF_AWS_SWEEP_PARALLELISM environment variable.
+const DefaultSweepParallelism = 20
+
+// Sweeper is a named group of Sweepables, typically all the resources of a single resource type.
+// Dependencies holds the names of the Sweepers whose resources must be deleted before this Sweeper's,
+// with the same meaning as the Terraform Plugin SDK's resource.Sweeper Dependencies.
+type Sweeper struct {
+	Name         string
+	Dependencies []string
+	Sweepables   []Sweepable
+}
+
+// SweeperSummary records the outcome of sweeping a single Sweeper's resources.
+type SweeperSummary struct {
+	Name    string
+	Deleted int
+	Skipped int
+	Failed  int
+}
+
+// SweepOrchestratorWithDependencies deletes the resources of the specified Sweepers.
+// Sweepers are ordered by their dependencies and deleted in waves, each wave only starting once
+// all the resources in the previous wave have been deleted.
+// Within a wave at most TF_AWS_SWEEP_PARALLELISM resources are deleted concurrently.
+// If any resource of a Sweeper fails to delete, the resources of the Sweepers that depend on it,
+// directly or transitively, are not deleted and are reported as skipped.
+// Dry-run mode and resource filters are configured using environment variables, see ConfigFromEnv.
+// A per-Sweeper summary of deleted, skipped and failed resources is logged once sweeping completes.
+func SweepOrchestratorWithDependencies(ctx context.Context, sweepers []*Sweeper, optFns ...tfresource.OptionsFunc) error {
+	waves, err := sweeperWaves(sweepers)
+
+	if err != nil {
+		return err
+	}
+
+	o, err := newOrchestrator()
+
+	if err != nil {
+		return err
+	}
+
+	summaries, err := o.sweepWaves(ctx, waves, optFns...)
+
+	logSweeperSummaries(summaries, o.config.DryRun)
+
+	return err
+}
+
+// sweepWaves deletes the resources of the specified waves of Sweepers in order and returns the per-Sweeper summaries.
+func (o *orchestrator) sweepWaves(ctx context.Context, waves [][]*Sweeper, optFns ...tfresource.OptionsFunc) (map[string]*SweeperSummary, error) {
+	summaries := make(map[string]*SweeperSummary)
+	for _, wave := range waves {
+		for _, sweeper := range wave {
+			summaries[sweeper.Name] = &SweeperSummary{Name: sweeper.Name}
+		}
+	}
+
+	// blocked holds the names of the Sweepers with failed deletions, and of those that depend on them.
+	blocked := make(map[string]bool)
+	var errs *multierror.Error
+
+	for i, wave := range waves {
+		log.Printf("[DEBUG] Sweeping wave %d of %d (%d sweepers)", i+1, len(waves), len(wave))
+
+		var g multierror.Group
+
+		for _, sweeper := range wave {
+			summary := summaries[sweeper.Name]
+
+			if dependency, ok := blockedDependency(sweeper, blocked); ok {
+				log.Printf("[WARN] Skipping %s resources: dependency %s failed", sweeper.Name, dependency)
+				blocked[sweeper.Name] = true
+				summary.Skipped += len(sweeper.Sweepables)
+				continue
+			}
+
+			for _, sweepable := range sweeper.Sweepables {
+				sweepable := sweepable
+
+				g.Go(func() error {
+					err := o.sweep(ctx, summary, sweepable, optFns...)
+
+					switch {
+					case err == nil:
+						return nil
+					case tfresource.NotFound(err) || SkipSweepError(err):
+						log.Printf("[WARN] Skipping %s resource: %s", summary.Name, err)
+						o.record(summary, outcomeSkipped)
+						return nil
+					default:
+						o.record(summary, outcomeFailed)
+						return err
+					}
+				})
+			}
+		}
+
+		if err := g.Wait(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+
+		for _, sweeper := range wave {
+			if summaries[sweeper.Name].Failed > 0 {
+				blocked[sweeper.Name] = true
+			}
+		}
+	}
+
+	return summaries, errs.ErrorOrNil()
+}
+
+// blockedDependency returns the name of the first of the specified Sweeper's dependencies that is blocked.
+func blockedDependency(sweeper *Sweeper, blocked map[string]bool) (string, bool) {
+	for _, dependency := range sweeper.Dependencies {
+		if blocked[dependency] {
+			return dependency, true
+		}
+	}
+
+	return "", false
+}
+
+type outcome int
+
+const (
+	outcomeDeleted outcome = iota
+	outcomeSkipped
+	outcomeFailed
+)
+
+// orchestrator deletes, or in dry-run mode reports, individual Sweepables.
+type orchestrator struct {
+	config    *SweepConfig
+	mu        sync.Mutex
+	report    *reportWriter
+	semaphore tfsync.Semaphore
+}
+
+func newOrchestrator() (*orchestrator, error) {
+	config, err := ConfigFromEnv()
+
+	if err != nil {
+		return nil, err
+	}
+
+	o := &orchestrator{
+		config:    config,
+		semaphore: newSweepSemaphore(),
+	}
+
+	if config.DryRun {
+		o.report, err = reportWriterFor(config)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// sweep deletes the specified Sweepable unless it is excluded by the configured filters or dry-run mode is enabled.
+// Deleted and excluded resources are recorded in the summary, any deletion error is returned to the caller.
+func (o *orchestrator) sweep(ctx context.Context, summary *SweeperSummary, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if !o.config.Filter.IsEmpty() || o.config.DryRun {
+		var m ResourceMetadata
+		if v, ok := sweepable.(Describable); ok {
+			m = v.Metadata(ctx)
+		}
+
+		if ok, reason := o.config.Filter.Match(m, time.Now()); !ok {
+			log.Printf("[DEBUG] Excluding %s resource (%s) from sweep: %s", summary.Name, m.ID, reason)
+			o.record(summary, outcomeSkipped)
+
+			if o.config.DryRun {
+				return o.report.write(newReportRecord(summary.Name, m, reportActionExclude, reason))
+			}
+
+			return nil
+		}
+
+		if o.config.DryRun {
+			o.record(summary, outcomeDeleted)
+
+			return o.report.write(newReportRecord(summary.Name, m, reportActionDelete, ""))
+		}
+	}
+
+	o.semaphore.Wait()
+	defer o.semaphore.Notify()
+
+	if err := sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...); err != nil {
+		return err
+	}
+
+	o.record(summary, outcomeDeleted)
+
+	return nil
+}
+
+func (o *orchestrator) record(summary *SweeperSummary, outcome outcome) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	switch outcome {
+	case outcomeDeleted:
+		summary.Deleted++
+	case outcomeSkipped:
+		summary.Skipped++
+	case outcomeFailed:
+		summary.Failed++
+	}
+}
+
+// sweeperWaves orders the specified Sweepers into waves using a dependency graph.
+// Each Sweeper is placed in the wave immediately after that of its latest dependency.
+// Dependencies on Sweepers not in the list are ignored.
+func sweeperWaves(sweepers []*Sweeper) ([][]*Sweeper, error) {
+	g := depgraph.New()
+	byName := make(map[string]*Sweeper, len(sweepers))
+
+	for _, sweeper := range sweepers {
+		if _, ok := byName[sweeper.Name]; ok {
+			return nil, fmt.Errorf("duplicate sweeper: %s", sweeper.Name)
+		}
+
+		byName[sweeper.Name] = sweeper
+		g.AddNode(sweeper.Name)
+	}
+
+	for _, sweeper := range sweepers {
+		for _, dependency := range sweeper.Dependencies {
+			if !g.HasNode(dependency) {
+				continue
+			}
+
+			if err := g.AddDependency(sweeper.Name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Dependencies come before their dependents in the overall order.
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var waves [][]*Sweeper
+	levels := make(map[string]int, len(order))
+
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		level := 0
+		for _, dependency := range dependencies {
+			if l := levels[dependency] + 1; l > level {
+				level = l
+			}
+		}
+		levels[name] = level
+
+		for len(waves) <= level {
+			waves = append(waves, nil)
+		}
+		waves[level] = append(waves[level], byName[name])
+	}
+
+	return waves, nil
+}
+
+func newSweepSemaphore() tfsync.Semaphore {
+	semaphore := tfsync.InitializeSemaphore(envvar.SweepParallelism, DefaultSweepParallelism)
+
+	if cap(semaphore) < 1 {
+		return make(tfsync.Semaphore, 1)
+	}
+
+	return semaphore
+}
+
+func logSweeperSummaries(summaries map[string]*SweeperSummary, dryRun bool) {
+	var suffix string
+	if dryRun {
+		suffix = " (dry run)"
+	}
+
+	names := make([]string, 0, len(summaries))
+	for name := range summaries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		summary := summaries[name]
+		log.Printf("[INFO] Sweeper %s: %d deleted, %d skipped, %d failed%s", name, summary.Deleted, summary.Skipped, summary.Failed, suffix)
+	}
+}
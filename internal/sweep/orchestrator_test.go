@@ -0,0 +1,244 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestSweeperWaves(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sweepers      []*Sweeper
+		expectedWaves [][]string
+		expectError   bool
+	}{
+		"empty": {},
+		"no dependencies": {
+			sweepers: []*Sweeper{
+				{Name: "aws_vpc"},
+				{Name: "aws_subnet"},
+			},
+			expectedWaves: [][]string{
+				{"aws_vpc", "aws_subnet"},
+			},
+		},
+		"chain": {
+			sweepers: []*Sweeper{
+				{Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+				{Name: "aws_subnet", Dependencies: []string{"aws_network_interface"}},
+				{Name: "aws_network_interface"},
+			},
+			expectedWaves: [][]string{
+				{"aws_network_interface"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		"diamond": {
+			sweepers: []*Sweeper{
+				{Name: "aws_vpc", Dependencies: []string{"aws_subnet", "aws_security_group"}},
+				{Name: "aws_subnet", Dependencies: []string{"aws_instance"}},
+				{Name: "aws_security_group", Dependencies: []string{"aws_instance"}},
+				{Name: "aws_instance"},
+			},
+			expectedWaves: [][]string{
+				{"aws_instance"},
+				{"aws_subnet", "aws_security_group"},
+				{"aws_vpc"},
+			},
+		},
+		"unknown dependency": {
+			sweepers: []*Sweeper{
+				{Name: "aws_vpc", Dependencies: []string{"aws_vpn_gateway"}},
+			},
+			expectedWaves: [][]string{
+				{"aws_vpc"},
+			},
+		},
+		"duplicate": {
+			sweepers: []*Sweeper{
+				{Name: "aws_vpc"},
+				{Name: "aws_vpc"},
+			},
+			expectError: true,
+		},
+		"cycle": {
+			sweepers: []*Sweeper{
+				{Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+				{Name: "aws_subnet", Dependencies: []string{"aws_vpc"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			waves, err := sweeperWaves(testCase.sweepers)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("expected error %t, got %t (%v)", want, got, err)
+			}
+
+			var got [][]string
+			for _, wave := range waves {
+				var names []string
+				for _, sweeper := range wave {
+					names = append(names, sweeper.Name)
+				}
+				got = append(got, names)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedWaves); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+type testSweepable struct {
+	name  string
+	err   error
+	order *[]string
+	mu    *sync.Mutex
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	*s.order = append(*s.order, s.name)
+
+	return s.err
+}
+
+func TestSweepOrchestratorWithDependencies(t *testing.T) {
+	t.Setenv("TF_AWS_SWEEP_PARALLELISM", "1")
+
+	var order []string
+	var mu sync.Mutex
+
+	newSweepable := func(name string, err error) Sweepable {
+		return testSweepable{name: name, err: err, order: &order, mu: &mu}
+	}
+
+	sweepers := []*Sweeper{
+		{
+			Name:         "aws_vpc",
+			Dependencies: []string{"aws_subnet"},
+			Sweepables:   []Sweepable{newSweepable("vpc-1", nil)},
+		},
+		{
+			Name: "aws_subnet",
+			Sweepables: []Sweepable{
+				newSweepable("subnet-1", nil),
+				newSweepable("subnet-2", &resource.NotFoundError{}),
+				newSweepable("subnet-3", errors.New("DependencyViolation")),
+			},
+		},
+	}
+
+	err := SweepOrchestratorWithDependencies(context.Background(), sweepers)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	// The failed subnet deletion blocks the VPC's.
+	if got, want := len(order), 3; got != want {
+		t.Fatalf("expected %d deletions, got %d", want, got)
+	}
+
+	for _, name := range order {
+		if name == "vpc-1" {
+			t.Errorf("expected no deletion of %s", name)
+		}
+	}
+}
+
+func TestSweepOrchestratorWithDependenciesSkipsDependents(t *testing.T) {
+	t.Setenv("TF_AWS_SWEEP_PARALLELISM", "1")
+
+	var order []string
+	var mu sync.Mutex
+
+	newSweepable := func(name string, err error) Sweepable {
+		return testSweepable{name: name, err: err, order: &order, mu: &mu}
+	}
+
+	sweepers := []*Sweeper{
+		{
+			Name:         "aws_vpc",
+			Dependencies: []string{"aws_subnet"},
+			Sweepables:   []Sweepable{newSweepable("vpc-1", nil)},
+		},
+		{
+			Name:         "aws_subnet",
+			Dependencies: []string{"aws_instance"},
+			Sweepables: []Sweepable{
+				newSweepable("subnet-1", nil),
+				newSweepable("subnet-2", nil),
+			},
+		},
+		{
+			Name: "aws_instance",
+			Sweepables: []Sweepable{
+				newSweepable("i-1", nil),
+				newSweepable("i-2", errors.New("IncorrectInstanceState")),
+			},
+		},
+		{
+			Name:       "aws_s3_bucket",
+			Sweepables: []Sweepable{newSweepable("bucket-1", nil)},
+		},
+	}
+
+	waves, err := sweeperWaves(sweepers)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	o, err := newOrchestrator()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	summaries, err := o.sweepWaves(context.Background(), waves)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	got := make(map[string]SweeperSummary, len(summaries))
+	for name, summary := range summaries {
+		got[name] = *summary
+	}
+
+	want := map[string]SweeperSummary{
+		"aws_instance":  {Name: "aws_instance", Deleted: 1, Failed: 1},
+		"aws_s3_bucket": {Name: "aws_s3_bucket", Deleted: 1},
+		"aws_subnet":    {Name: "aws_subnet", Skipped: 2},
+		"aws_vpc":       {Name: "aws_vpc", Skipped: 1},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := len(order), 3; got != want {
+		t.Errorf("expected %d deletions, got %d", want, got)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -38,7 +39,7 @@ var SweeperClients map[string]interface{}
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
-	return SharedRegionalSweepClientWithContext(Context(region), region)
+	return SharedRegionalSweepClientWithContext(context.Background(), region)
 }
 
 func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (interface{}, error) {
@@ -65,24 +66,28 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -104,15 +109,122 @@ type Sweepable interface {
 type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
+	metadata ResourceMetadata
+	read     bool
+	readOK   bool
 	resource *schema.Resource
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
-	return &SweepResource{
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}, optFns ...MetadataOptionsFunc) *SweepResource {
+	sr := &SweepResource{
 		d:        d,
 		meta:     meta,
 		resource: resource,
 	}
+
+	for _, fn := range optFns {
+		fn(&sr.metadata)
+	}
+
+	return sr
+}
+
+// creationTimeAttributes are the names of attributes commonly holding a resource's RFC 3339 creation time.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+}
+
+// Metadata returns the resource's metadata.
+// Name, tags and creation time not set explicitly are taken from the resource's "name", "tags" and creation time attributes.
+// The resource is read the first time these are needed so that the attributes are populated.
+func (sr *SweepResource) Metadata(ctx context.Context) ResourceMetadata {
+	m := sr.metadata
+
+	if !sr.read && sr.needsRead() {
+		sr.read = true
+		id := sr.d.Id()
+
+		if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+			log.Printf("[WARN] Reading resource (%s) for sweeper metadata: %s", id, err)
+		} else {
+			sr.readOK = true
+		}
+
+		// Keep deleting by the original ID if the read found no resource.
+		if sr.d.Id() == "" {
+			sr.d.SetId(id)
+		}
+	}
+
+	m.ID = sr.d.Id()
+
+	if _, ok := sr.resource.Schema["name"]; ok && m.Name == "" {
+		if v, ok := sr.d.Get("name").(string); ok {
+			m.Name = v
+		}
+	}
+
+	if _, ok := sr.resource.Schema["tags"]; ok && m.Tags == nil {
+		// A resource that has been read successfully and has no tags is known to be untagged.
+		if v, ok := sr.d.GetOk("tags"); ok || sr.readOK {
+			m.Tags = make(map[string]string)
+			if v, ok := v.(map[string]interface{}); ok {
+				for k, v := range v {
+					m.Tags[k] = v.(string)
+				}
+			}
+		}
+	}
+
+	if m.CreationTime.IsZero() {
+		for _, k := range creationTimeAttributes {
+			if _, ok := sr.resource.Schema[k]; !ok {
+				continue
+			}
+
+			if v, ok := sr.d.Get(k).(string); ok && v != "" {
+				if t, err := time.Parse(time.RFC3339, v); err == nil {
+					m.CreationTime = t
+					break
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+// needsRead returns whether any metadata not set explicitly could be populated by reading the resource.
+func (sr *SweepResource) needsRead() bool {
+	if sr.resource.Read == nil && sr.resource.ReadContext == nil && sr.resource.ReadWithoutTimeout == nil {
+		return false
+	}
+
+	if _, ok := sr.resource.Schema["name"]; ok && sr.metadata.Name == "" && sr.d.Get("name") == "" {
+		return true
+	}
+
+	if _, ok := sr.resource.Schema["tags"]; ok && sr.metadata.Tags == nil {
+		if _, ok := sr.d.GetOk("tags"); !ok {
+			return true
+		}
+	}
+
+	if sr.metadata.CreationTime.IsZero() {
+		for _, k := range creationTimeAttributes {
+			if _, ok := sr.resource.Schema[k]; ok && sr.d.Get(k) == "" {
+				return true
+			}
+		}
+	}
+
+	return false
 }
 
 func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
@@ -120,7 +232,7 @@ func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 		err := DeleteResource(ctx, sr.resource, sr.d, sr.meta)
 
 		if err != nil {
-			if strings.Contains(err.Error(), "Throttling") {
+			if isThrottlingError(err) {
 				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sr.d.Id(), err)
 				return resource.RetryableError(err)
 			}
@@ -138,22 +250,98 @@ func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// SweepFunc is a Sweepable that deletes a resource by calling a function.
+// It is used for resources that cannot be deleted using only their Terraform resource's Delete,
+// for example because other API calls must be made first.
+type SweepFunc struct {
+	delete   func(context.Context) error
+	metadata ResourceMetadata
+}
+
+func NewSweepFunc(id string, delete func(context.Context) error, optFns ...MetadataOptionsFunc) *SweepFunc {
+	sf := &SweepFunc{
+		delete: delete,
+		metadata: ResourceMetadata{
+			ID: id,
+		},
+	}
+
+	for _, fn := range optFns {
+		fn(&sf.metadata)
+	}
+
+	return sf
+}
+
+// Metadata returns the resource's metadata.
+// Only the metadata set explicitly is available.
+func (sf *SweepFunc) Metadata(ctx context.Context) ResourceMetadata {
+	return sf.metadata
+}
+
+func (sf *SweepFunc) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	err := tfresource.Retry(ctx, timeout, func() *resource.RetryError {
+		err := sf.delete(ctx)
+
+		if err != nil {
+			if isThrottlingError(err) {
+				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sf.metadata.ID, err)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	}, optFns...)
+
+	if tfresource.TimedOut(err) {
+		err = sf.delete(ctx)
+	}
+
+	return err
+}
+
 func SweepOrchestrator(sweepables []Sweepable) error {
 	return SweepOrchestratorWithContext(context.Background(), sweepables)
 }
 
 func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	o, err := newOrchestrator()
+
+	if err != nil {
+		return err
+	}
+
 	var g multierror.Group
+	summary := &SweeperSummary{Name: sweeperName(ctx)}
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			err := o.sweep(ctx, summary, sweepable, optFns...)
+
+			if err != nil {
+				o.record(summary, outcomeFailed)
+			}
+
+			return err
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err = g.Wait().ErrorOrNil()
+
+	if summary.Name != "" {
+		logSweeperSummaries(map[string]*SweeperSummary{summary.Name: summary}, o.config.DryRun)
+	}
+
+	return err
+}
+
+// isThrottlingError returns whether a resource deletion error is due to API rate limiting and can be retried.
+func isThrottlingError(err error) bool {
+	return strings.Contains(err.Error(), "Throttling") || strings.Contains(err.Error(), "TooManyRequestsException")
 }
 
 // Check sweeper API call error for reasons to skip sweeping
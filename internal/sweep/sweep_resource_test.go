@@ -0,0 +1,97 @@
+package sweep
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSweepResourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	creationTime := time.Date(2023, time.January, 20, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		optFns   []MetadataOptionsFunc
+		tags     map[string]interface{}
+		expected ResourceMetadata
+	}{
+		"read": {
+			tags: map[string]interface{}{"Team": "sandbox"},
+			expected: ResourceMetadata{
+				ID:           "id-1",
+				Name:         "tf-acc-test-1",
+				Tags:         map[string]string{"Team": "sandbox"},
+				CreationTime: creationTime,
+			},
+		},
+		"read untagged": {
+			expected: ResourceMetadata{
+				ID:           "id-1",
+				Name:         "tf-acc-test-1",
+				Tags:         map[string]string{},
+				CreationTime: creationTime,
+			},
+		},
+		"explicit": {
+			optFns: []MetadataOptionsFunc{
+				WithName("explicit"),
+				WithTags(map[string]string{"Team": "platform"}),
+				WithCreationTime(creationTime.Add(time.Hour)),
+			},
+			expected: ResourceMetadata{
+				ID:           "id-1",
+				Name:         "explicit",
+				Tags:         map[string]string{"Team": "platform"},
+				CreationTime: creationTime.Add(time.Hour),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var reads int
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"creation_date": {Type: schema.TypeString, Computed: true},
+					"name":          {Type: schema.TypeString, Optional: true},
+					"tags":          {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+				ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					reads++
+					d.Set("creation_date", creationTime.Format(time.RFC3339))
+					d.Set("name", "tf-acc-test-1")
+					d.Set("tags", testCase.tags)
+
+					return nil
+				},
+			}
+			d := r.Data(nil)
+			d.SetId("id-1")
+
+			sr := NewSweepResource(r, d, nil, testCase.optFns...)
+
+			for i := 0; i < 2; i++ {
+				if diff := cmp.Diff(sr.Metadata(context.Background()), testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+
+			if testCase.optFns == nil && reads != 1 {
+				t.Errorf("expected resource to be read once, got %d", reads)
+			}
+
+			if testCase.optFns != nil && reads != 0 {
+				t.Errorf("expected resource not to be read, got %d", reads)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package tags
+
+import (
+	"context"
+)
+
+// InContext represents the tagging information kept in Context.
+// It is set up by the provider's transparent tagging layer around each operation on a resource that declares its tags.
+type InContext struct {
+	DefaultConfig *DefaultConfig
+	IgnoreConfig  *IgnoreConfig
+	// TagsIn holds the tags specified in configuration, merged with any default tags.
+	// Set before a resource's Create handler is called.
+	TagsIn KeyValueTags
+	// TagsOut holds the tags returned by AWS, including any ignored or default tags.
+	// Set by a resource's Read handler if the tags are returned by the resource's Describe/Get API,
+	// otherwise the tags are listed by the provider.
+	TagsOut    KeyValueTags
+	tagsOutSet bool
+}
+
+type inContextKey struct{}
+
+// NewContext returns a Context enhanced with tagging information.
+func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) context.Context {
+	v := &InContext{
+		DefaultConfig: defaultConfig,
+		IgnoreConfig:  ignoreConfig,
+	}
+
+	return context.WithValue(ctx, inContextKey{}, v)
+}
+
+// FromContext returns the tagging information kept in Context, if any.
+func FromContext(ctx context.Context) (*InContext, bool) {
+	v, ok := ctx.Value(inContextKey{}).(*InContext)
+
+	return v, ok
+}
+
+// GetTagsIn returns the tags specified in configuration, merged with any default tags.
+// The returned tags are empty if there is no tagging information in Context.
+func GetTagsIn(ctx context.Context) KeyValueTags {
+	if inContext, ok := FromContext(ctx); ok {
+		return New(inContext.TagsIn)
+	}
+
+	return New(nil)
+}
+
+// SetTagsOut records the tags returned by AWS, saving the provider from listing them.
+func SetTagsOut(ctx context.Context, tags KeyValueTags) {
+	if inContext, ok := FromContext(ctx); ok {
+		inContext.TagsOut = tags
+		inContext.tagsOutSet = true
+	}
+}
+
+// GetTagsOut returns the tags recorded by SetTagsOut and whether any were.
+func (c *InContext) GetTagsOut() (KeyValueTags, bool) {
+	return c.TagsOut, c.tagsOutSet
+}
@@ -0,0 +1,48 @@
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTagsInContext(t *testing.T) {
+	ctx := context.Background()
+
+	if got := GetTagsIn(ctx); len(got) != 0 {
+		t.Errorf("GetTagsIn without tagging information: got %v, want none", got)
+	}
+
+	// No tagging information in Context, no panic.
+	SetTagsOut(ctx, New(map[string]string{"key1": "value1"}))
+
+	defaultConfig := &DefaultConfig{Tags: New(map[string]string{"key1": "default"})}
+	ignoreConfig := &IgnoreConfig{Keys: New([]string{"key2"})}
+	ctx = NewContext(ctx, defaultConfig, ignoreConfig)
+
+	inContext, ok := FromContext(ctx)
+
+	if !ok {
+		t.Fatal("FromContext: no tagging information")
+	}
+
+	if inContext.DefaultConfig != defaultConfig || inContext.IgnoreConfig != ignoreConfig {
+		t.Errorf("FromContext: unexpected configuration")
+	}
+
+	inContext.TagsIn = defaultConfig.MergeTags(New(map[string]string{"key3": "value3"}))
+
+	if got, want := GetTagsIn(ctx), New(map[string]string{"key1": "default", "key3": "value3"}); !got.Equal(want) {
+		t.Errorf("GetTagsIn: got %v, want %v", got, want)
+	}
+
+	if _, ok := inContext.GetTagsOut(); ok {
+		t.Errorf("GetTagsOut: got tags, want none")
+	}
+
+	want := New(map[string]string{"key4": "value4"})
+	SetTagsOut(ctx, want)
+
+	if got, ok := inContext.GetTagsOut(); !ok || !got.Equal(want) {
+		t.Errorf("GetTagsOut: got %v, want %v", got, want)
+	}
+}
@@ -425,6 +425,34 @@ func (tags KeyValueTags) ContainsAll(target KeyValueTags) bool {
 	return true
 }
 
+// MatchPatterns returns the tags whose key equals a pattern's key and whose value matches the pattern's value.
+// A "*" in a pattern value matches any sequence of characters.
+func (tags KeyValueTags) MatchPatterns(patterns KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, pattern := range patterns {
+		v, ok := tags[k]
+
+		if !ok {
+			continue
+		}
+
+		var patternValue, value string
+		if pattern != nil && pattern.Value != nil {
+			patternValue = *pattern.Value
+		}
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+
+		if wildcardMatch(patternValue, value) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // Equal returns whether or two sets of key-value tags are equal.
 func (tags KeyValueTags) Equal(other KeyValueTags) bool {
 	if tags == nil && other == nil {
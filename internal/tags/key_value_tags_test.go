@@ -1840,6 +1840,65 @@ func TestKeyValueTagsChunks(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsMatchPatterns(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		tags     KeyValueTags
+		patterns KeyValueTags
+		want     map[string]string
+	}{
+		{
+			name:     "empty patterns",
+			tags:     New(map[string]string{"key1": "value1"}),
+			patterns: New(map[string]string{}),
+			want:     map[string]string{},
+		},
+		{
+			name:     "exact match",
+			tags:     New(map[string]string{"key1": "value1", "key2": "value2"}),
+			patterns: New(map[string]string{"key1": "value1"}),
+			want:     map[string]string{"key1": "value1"},
+		},
+		{
+			name:     "value mismatch",
+			tags:     New(map[string]string{"key1": "value1"}),
+			patterns: New(map[string]string{"key1": "value"}),
+			want:     map[string]string{},
+		},
+		{
+			name:     "key mismatch",
+			tags:     New(map[string]string{"key1": "value1"}),
+			patterns: New(map[string]string{"key2": "*"}),
+			want:     map[string]string{},
+		},
+		{
+			name:     "any value",
+			tags:     New(map[string]string{"key1": "value1"}),
+			patterns: New(map[string]string{"key1": "*"}),
+			want:     map[string]string{"key1": "value1"},
+		},
+		{
+			name:     "wildcard",
+			tags:     New(map[string]string{"env": "prod-eu", "team": "a.b"}),
+			patterns: New(map[string]string{"env": "prod*", "team": "a?b"}),
+			want:     map[string]string{"env": "prod-eu"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.MatchPatterns(testCase.patterns)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsContainsAll(t *testing.T) {
 	t.Parallel()
 
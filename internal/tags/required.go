@@ -0,0 +1,109 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RequiredConfig contains the tags required on resources.
+type RequiredConfig struct {
+	Tags []RequiredTag
+}
+
+// RequiredTag is a tag key that resources must be tagged with.
+type RequiredTag struct {
+	// Key is the required tag key.
+	Key string
+	// ValueRegex, if set, must match the tag's entire value.
+	ValueRegex *regexp.Regexp
+	// ResourceTypes, if set, match the resource types that require the tag, see WildcardRegexp.
+	ResourceTypes []*regexp.Regexp
+	// ExcludeResourceTypes match the resource types that don't require the tag, see WildcardRegexp.
+	ExcludeResourceTypes []*regexp.Regexp
+	// Warn is whether a missing or invalid tag is reported as a warning instead of an error.
+	Warn bool
+}
+
+// appliesTo returns whether the tag is required on the specified resource type.
+func (rt RequiredTag) appliesTo(typeName string) bool {
+	for _, v := range rt.ExcludeResourceTypes {
+		if v.MatchString(typeName) {
+			return false
+		}
+	}
+
+	if len(rt.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, v := range rt.ResourceTypes {
+		if v.MatchString(typeName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check returns the violations of the required tags by a resource's tags.
+// Violations of required tags configured to warn are returned separately.
+func (rc *RequiredConfig) Check(typeName string, tags KeyValueTags) (errs []error, warnings []error) {
+	if rc == nil {
+		return nil, nil
+	}
+
+	for _, rt := range rc.Tags {
+		if !rt.appliesTo(typeName) {
+			continue
+		}
+
+		var err error
+
+		if v := tags.KeyValue(rt.Key); v == nil && !tags.KeyExists(rt.Key) {
+			err = fmt.Errorf("%s is missing required tag %q", typeName, rt.Key)
+		} else if rt.ValueRegex != nil {
+			var value string
+			if v != nil {
+				value = *v
+			}
+
+			if !rt.ValueRegex.MatchString(value) {
+				err = fmt.Errorf("%s tag %q value %q does not match required pattern %q", typeName, rt.Key, value, rt.ValueRegex.String())
+			}
+		}
+
+		if err == nil {
+			continue
+		}
+
+		if rt.Warn {
+			warnings = append(warnings, err)
+		} else {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs, warnings
+}
+
+// WildcardRegexp returns a regular expression that matches the whole of pattern, in which a "*" matches any sequence of characters.
+func WildcardRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.MustCompile(`^` + strings.Join(parts, `.*`) + `$`)
+}
+
+// AnchoredRegexp compiles a regular expression that must match the whole of a string.
+func AnchoredRegexp(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + expr + `)$`)
+}
+
+// wildcardMatch returns whether s matches pattern, in which a "*" matches any sequence of characters.
+func wildcardMatch(pattern, s string) bool {
+	return WildcardRegexp(pattern).MatchString(s)
+}
@@ -0,0 +1,107 @@
+package tags
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestRequiredConfigCheck(t *testing.T) {
+	t.Parallel()
+
+	anchored, err := AnchoredRegexp(`\d{4}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	config := &RequiredConfig{
+		Tags: []RequiredTag{
+			{
+				Key: "Owner",
+			},
+			{
+				Key:           "CostCenter",
+				ValueRegex:    regexp.MustCompile(`^\d{4}$`),
+				ResourceTypes: []*regexp.Regexp{WildcardRegexp("aws_db_*"), WildcardRegexp("aws_s3_bucket")},
+			},
+			{
+				Key:                  "Environment",
+				ExcludeResourceTypes: []*regexp.Regexp{WildcardRegexp("aws_iam_*")},
+				Warn:                 true,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		config       *RequiredConfig
+		typeName     string
+		tags         KeyValueTags
+		wantErrs     int
+		wantWarnings int
+	}{
+		{
+			name:     "no config",
+			typeName: "aws_db_instance",
+			tags:     New(map[string]string{}),
+		},
+		{
+			name:     "compliant",
+			config:   config,
+			typeName: "aws_db_instance",
+			tags:     New(map[string]string{"Owner": "team", "CostCenter": "1234", "Environment": "prod"}),
+		},
+		{
+			name:         "all missing",
+			config:       config,
+			typeName:     "aws_db_instance",
+			tags:         New(map[string]string{}),
+			wantErrs:     2,
+			wantWarnings: 1,
+		},
+		{
+			name:     "invalid value",
+			config:   config,
+			typeName: "aws_s3_bucket",
+			tags:     New(map[string]string{"Owner": "team", "CostCenter": "abc", "Environment": "prod"}),
+			wantErrs: 1,
+		},
+		{
+			name: "partial value match",
+			config: &RequiredConfig{
+				Tags: []RequiredTag{
+					{
+						Key:        "CostCenter",
+						ValueRegex: anchored,
+					},
+				},
+			},
+			typeName: "aws_s3_bucket",
+			tags:     New(map[string]string{"CostCenter": "x12345"}),
+			wantErrs: 1,
+		},
+		{
+			name:     "not included",
+			config:   config,
+			typeName: "aws_iam_role",
+			tags:     New(map[string]string{}),
+			wantErrs: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs, warnings := testCase.config.Check(testCase.typeName, testCase.tags)
+
+			if got, want := len(errs), testCase.wantErrs; got != want {
+				t.Errorf("errors = %v, want %d", errs, want)
+			}
+
+			if got, want := len(warnings), testCase.wantWarnings; got != want {
+				t.Errorf("warnings = %v, want %d", warnings, want)
+			}
+		})
+	}
+}
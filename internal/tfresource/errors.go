@@ -4,24 +4,25 @@ import (
 	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource/retry"
 )
 
 // NotFound returns true if the error represents a "resource not found" condition.
 // Specifically, NotFound returns true if the error or a wrapped error is of type
-// resource.NotFoundError.
+// resource.NotFoundError or retry.NotFoundError.
 func NotFound(err error) bool {
 	var e *resource.NotFoundError // nosemgrep:ci.is-not-found-error
-	return errors.As(err, &e)
+	return errors.As(err, &e) || retry.NotFound(err)
 }
 
 // TimedOut returns true if the error represents a "wait timed out" condition.
 // Specifically, TimedOut returns true if the error matches all these conditions:
-//   - err is of type resource.TimeoutError
+//   - err is of type resource.TimeoutError or retry.TimeoutError
 //   - TimeoutError.LastError is nil
 func TimedOut(err error) bool {
 	// This explicitly does *not* match wrapped TimeoutErrors
 	timeoutErr, ok := err.(*resource.TimeoutError) //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
-	return ok && timeoutErr.LastError == nil
+	return (ok && timeoutErr.LastError == nil) || retry.TimedOut(err)
 }
 
 // SetLastError sets the LastError field on the error if supported.
@@ -37,5 +38,8 @@ func SetLastError(err, lastErr error) {
 		if err.LastError == nil {
 			err.LastError = lastErr
 		}
+
+	default:
+		retry.SetLastError(err, lastErr)
 	}
 }
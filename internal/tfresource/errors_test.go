@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource/retry"
 )
 
 func TestNotFound(t *testing.T) {
@@ -40,6 +41,11 @@ func TestNotFound(t *testing.T) {
 			Err:      fmt.Errorf("test: %w", &resource.NotFoundError{LastError: errors.New("test")}),
 			Expected: true,
 		},
+		{
+			Name:     "retry not found error",
+			Err:      &retry.NotFoundError{LastError: errors.New("test")},
+			Expected: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -93,6 +99,15 @@ func TestTimedOut(t *testing.T) {
 			Name: "wrapped timeout error non-nil last error",
 			Err:  fmt.Errorf("test: %w", &resource.TimeoutError{LastError: errors.New("test")}),
 		},
+		{
+			Name:     "retry timeout error",
+			Err:      &retry.TimeoutError{},
+			Expected: true,
+		},
+		{
+			Name: "retry timeout error non-nil last error",
+			Err:  &retry.TimeoutError{LastError: errors.New("test")},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource/retry"
 )
 
 type EmptyResultError struct {
@@ -29,17 +30,25 @@ func (e *EmptyResultError) Is(err error) bool {
 }
 
 func (e *EmptyResultError) As(target interface{}) bool {
-	t, ok := target.(**resource.NotFoundError)
-	if !ok {
-		return false
-	}
+	switch t := target.(type) {
+	case **resource.NotFoundError:
+		*t = &resource.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
+
+		return true
+
+	case **retry.NotFoundError:
+		*t = &retry.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
 
-	*t = &resource.NotFoundError{
-		Message:     e.Error(),
-		LastRequest: e.LastRequest,
+		return true
 	}
 
-	return true
+	return false
 }
 
 type TooManyResultsError struct {
@@ -66,17 +75,25 @@ func (e *TooManyResultsError) Is(err error) bool {
 }
 
 func (e *TooManyResultsError) As(target interface{}) bool {
-	t, ok := target.(**resource.NotFoundError)
-	if !ok {
-		return false
-	}
+	switch t := target.(type) {
+	case **resource.NotFoundError:
+		*t = &resource.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
+
+		return true
+
+	case **retry.NotFoundError:
+		*t = &retry.NotFoundError{
+			Message:     e.Error(),
+			LastRequest: e.LastRequest,
+		}
 
-	*t = &resource.NotFoundError{
-		Message:     e.Error(),
-		LastRequest: e.LastRequest,
+		return true
 	}
 
-	return true
+	return false
 }
 
 // SingularDataSourceFindError returns a standard error message for a singular data source's non-nil resource find error.
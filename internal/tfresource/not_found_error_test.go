@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource/retry"
 )
 
 func TestEmptyResultErrorAsNotFoundError(t *testing.T) {
@@ -28,6 +29,26 @@ func TestEmptyResultErrorAsNotFoundError(t *testing.T) {
 	}
 }
 
+func TestEmptyResultErrorAsRetryNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	lastRequest := 123
+	err := NewEmptyResultError(lastRequest)
+
+	var nfe *retry.NotFoundError
+	ok := errors.As(err, &nfe)
+
+	if !ok {
+		t.Fatal("expected errors.As() to return true")
+	}
+	if nfe.Message != "empty result" {
+		t.Errorf(`expected Message to be "empty result", got %q`, nfe.Message)
+	}
+	if nfe.LastRequest != lastRequest {
+		t.Errorf("unexpected value for LastRequest")
+	}
+}
+
 func TestEmptyResultErrorIs(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,121 @@
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NotFoundError is returned when a resource is not found.
+// tfresource.NotFound also recognizes it.
+type NotFoundError struct {
+	LastError   error
+	LastRequest interface{}
+	Message     string
+	Retries     int
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	if e.Retries > 0 {
+		return fmt.Sprintf("couldn't find resource (%d retries)", e.Retries)
+	}
+
+	return "couldn't find resource"
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.LastError
+}
+
+// NotFound returns true if the error or a wrapped error is of type NotFoundError.
+func NotFound(err error) bool {
+	var e *NotFoundError
+	return errors.As(err, &e)
+}
+
+// TimeoutError is returned when a wait or retry times out.
+type TimeoutError struct {
+	ExpectedState []string
+	LastError     error
+	LastState     string
+	Timeout       time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	expectedState := "resource to be gone"
+	if len(e.ExpectedState) > 0 {
+		expectedState = fmt.Sprintf("state to become '%s'", strings.Join(e.ExpectedState, ", "))
+	}
+
+	extraInfo := make([]string, 0)
+	if e.LastState != "" {
+		extraInfo = append(extraInfo, fmt.Sprintf("last state: '%s'", e.LastState))
+	}
+	if e.Timeout > 0 {
+		extraInfo = append(extraInfo, fmt.Sprintf("timeout: %s", e.Timeout.String()))
+	}
+
+	suffix := ""
+	if len(extraInfo) > 0 {
+		suffix = fmt.Sprintf(" (%s)", strings.Join(extraInfo, ", "))
+	}
+
+	if e.LastError != nil {
+		return fmt.Sprintf("timeout while waiting for %s%s: %s", expectedState, suffix, e.LastError)
+	}
+
+	return fmt.Sprintf("timeout while waiting for %s%s", expectedState, suffix)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.LastError
+}
+
+// TimedOut returns true if the error is a TimeoutError with no LastError.
+// Like tfresource.TimedOut, it does not match wrapped errors.
+func TimedOut(err error) bool {
+	timeoutErr, ok := err.(*TimeoutError) //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
+	return ok && timeoutErr.LastError == nil
+}
+
+// UnexpectedStateError is returned when a refresh returns a state that is neither pending nor target.
+type UnexpectedStateError struct {
+	ExpectedState []string
+	LastError     error
+	State         string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	message := fmt.Sprintf("unexpected state '%s', wanted target '%s'", e.State, strings.Join(e.ExpectedState, ", "))
+
+	if e.LastError != nil {
+		message += fmt.Sprintf(". last error: %s", e.LastError)
+	}
+
+	return message
+}
+
+func (e *UnexpectedStateError) Unwrap() error {
+	return e.LastError
+}
+
+// SetLastError sets the LastError field on the error if supported.
+// If lastErr is nil it is ignored.
+func SetLastError(err, lastErr error) {
+	switch err := err.(type) {
+	case *TimeoutError:
+		if err.LastError == nil {
+			err.LastError = lastErr
+		}
+
+	case *UnexpectedStateError:
+		if err.LastError == nil {
+			err.LastError = lastErr
+		}
+	}
+}
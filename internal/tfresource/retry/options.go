@@ -0,0 +1,165 @@
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+const (
+	defaultMaxPollInterval = 10 * time.Second
+	defaultNotFoundChecks  = 20
+	initialPollInterval    = 100 * time.Millisecond
+)
+
+// PollFunc is called after each poll with the 1-based attempt number and the refreshed state and error.
+// It is typically used for logging.
+type PollFunc func(ctx context.Context, attempt int, state string, err error)
+
+type Options struct {
+	ContinuousTargetOccurence int           // Number of times the target state has to occur continuously.
+	Delay                     time.Duration // Wait this time before starting checks.
+	Jitter                    float64       // Randomly shorten each backoff interval by up to this fraction (0 to 1).
+	MaxPollInterval           time.Duration // Largest time to wait between refreshes when backing off.
+	MinPollInterval           time.Duration // Smallest time to wait between refreshes.
+	NotFoundChecks            int           // Number of times to allow not found (nil result from refresh).
+	OnPoll                    PollFunc      // Called after each refresh.
+	PollInterval              time.Duration // Override MinPollInterval/backoff and only poll this often.
+}
+
+type OptionsFunc func(*Options)
+
+// WaitOpts mirrors tfresource.WaitOpts, allowing existing wait options to be used with this package.
+type WaitOpts struct {
+	ContinuousTargetOccurence int           // Number of times the target state has to occur continuously.
+	Delay                     time.Duration // Wait this time before starting checks.
+	MinTimeout                time.Duration // Smallest time to wait before refreshes.
+	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often.
+}
+
+func WithContinuousTargetOccurence(continuousTargetOccurence int) OptionsFunc {
+	return func(o *Options) {
+		o.ContinuousTargetOccurence = continuousTargetOccurence
+	}
+}
+
+func WithDelay(delay time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.Delay = delay
+	}
+}
+
+// WithJitter randomly shortens each backoff interval by up to the specified fraction,
+// so that many concurrent waiters do not poll in lockstep.
+func WithJitter(jitter float64) OptionsFunc {
+	return func(o *Options) {
+		o.Jitter = jitter
+	}
+}
+
+func WithMaxPollInterval(maxPollInterval time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.MaxPollInterval = maxPollInterval
+	}
+}
+
+func WithMinPollInterval(minPollInterval time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.MinPollInterval = minPollInterval
+	}
+}
+
+func WithNotFoundChecks(notFoundChecks int) OptionsFunc {
+	return func(o *Options) {
+		o.NotFoundChecks = notFoundChecks
+	}
+}
+
+// WithPollHook sets a function that is called after each refresh.
+func WithPollHook(f PollFunc) OptionsFunc {
+	return func(o *Options) {
+		o.OnPoll = f
+	}
+}
+
+func WithPollInterval(pollInterval time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.PollInterval = pollInterval
+	}
+}
+
+// WithWaitOpts applies the non-zero values of the specified WaitOpts.
+func WithWaitOpts(opts WaitOpts) OptionsFunc {
+	return func(o *Options) {
+		if opts.ContinuousTargetOccurence > 0 {
+			o.ContinuousTargetOccurence = opts.ContinuousTargetOccurence
+		}
+
+		if opts.Delay > 0 {
+			o.Delay = opts.Delay
+		}
+
+		if opts.MinTimeout > 0 {
+			o.MinPollInterval = opts.MinTimeout
+		}
+
+		if opts.PollInterval > 0 {
+			o.PollInterval = opts.PollInterval
+		}
+	}
+}
+
+func newOptions(optFns []OptionsFunc) Options {
+	o := Options{}
+	for _, fn := range optFns {
+		fn(&o)
+	}
+
+	if o.ContinuousTargetOccurence <= 0 {
+		o.ContinuousTargetOccurence = 1
+	}
+
+	if o.MaxPollInterval <= 0 {
+		o.MaxPollInterval = defaultMaxPollInterval
+	}
+
+	if o.NotFoundChecks <= 0 {
+		o.NotFoundChecks = defaultNotFoundChecks
+	}
+
+	return o
+}
+
+// interval returns the time to wait before the next refresh.
+// Unless a fixed PollInterval is set, the interval backs off exponentially from 100ms
+// and is kept between MinPollInterval and MaxPollInterval.
+func (o Options) interval(backoff int) time.Duration {
+	if o.PollInterval > 0 {
+		return o.PollInterval
+	}
+
+	wait := initialPollInterval
+	for i := 0; i < backoff && wait < o.MaxPollInterval; i++ {
+		wait *= 2
+	}
+
+	if wait > o.MaxPollInterval {
+		wait = o.MaxPollInterval
+	}
+
+	if o.Jitter > 0 {
+		wait -= time.Duration(rand.Float64() * o.Jitter * float64(wait)) //nolint:gosec // Jitter doesn't need a cryptographic random number generator.
+	}
+
+	if wait < o.MinPollInterval {
+		wait = o.MinPollInterval
+	}
+
+	return wait
+}
+
+func (o Options) poll(ctx context.Context, attempt int, state string, err error) {
+	if o.OnPoll != nil {
+		o.OnPoll(ctx, attempt, state, err)
+	}
+}
@@ -0,0 +1,127 @@
+// Package retry provides generic, typed retry and wait helpers.
+// Unlike the equivalent functions in tfresource it does not depend on the Terraform Plugin SDK,
+// so it can be used by Terraform Plugin Framework resources.
+package retry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+const (
+	defaultRetryMinPollInterval = 500 * time.Millisecond
+)
+
+// Retryable is a function that is used to decide if a function's error is retryable or not.
+// The error argument can be `nil`.
+// If the error is retryable, returns a bool value of `true` and an error (not necessarily the error passed as the argument).
+// If the error is not retryable, returns a bool value of `false` and either no error (success state) or an error (not necessarily the error passed as the argument).
+type Retryable func(error) (bool, error)
+
+const (
+	stateRetryable = "retryable"
+	stateSuccess   = "success"
+)
+
+// RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
+// `f` is retried until `timeout` expires, after which it is called one last time.
+func RetryWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable, optFns ...OptionsFunc) (T, error) {
+	var output T
+
+	optFns = append([]OptionsFunc{WithMinPollInterval(defaultRetryMinPollInterval)}, optFns...)
+
+	_, err := WaitUntilState(ctx, timeout, func(context.Context) (*T, string, error) {
+		var err error
+		var retry bool
+
+		output, err = f()
+		retry, err = retryable(err)
+
+		if retry {
+			return &output, stateRetryable, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return &output, stateSuccess, nil
+	}, []string{stateRetryable}, []string{stateSuccess}, optFns...)
+
+	if TimedOut(err) {
+		// One last try. A still retryable error is returned as is.
+		output, err = f()
+		_, err = retryable(err)
+	}
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return output, nil
+}
+
+// RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
+func RetryWhenAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func() (T, error), codes ...string) (T, error) { // nosemgrep:ci.aws-in-func-name
+	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, codes...) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenAWSErrMessageContains retries the specified function when it returns an AWS error containing the specified message.
+func RetryWhenAWSErrMessageContains[T any](ctx context.Context, timeout time.Duration, f func() (T, error), code, message string) (T, error) { // nosemgrep:ci.aws-in-func-name
+	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if tfawserr.ErrMessageContains(err, code, message) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+var errFoundResource = errors.New(`found resource`)
+
+// RetryUntilNotFound retries the specified function until it returns a NotFoundError.
+func RetryUntilNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return true, errFoundResource
+	})
+}
+
+// RetryWhenNotFound retries the specified function when it returns a NotFoundError.
+func RetryWhenNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if NotFound(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenNewResourceNotFound retries the specified function when it returns a NotFoundError and `isNewResource` is true.
+func RetryWhenNewResourceNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error), isNewResource bool) (T, error) {
+	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if isNewResource && NotFound(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
@@ -0,0 +1,318 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource/retry"
+)
+
+func TestRetryWhenAWSErrCodeEquals(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		F           func(*int32) func() (*string, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func(*int32) func() (*string, error) {
+				return func() (*string, error) {
+					return aws.String("ok"), nil
+				}
+			},
+		},
+		{
+			Name: "non-retryable other error",
+			F: func(*int32) func() (*string, error) {
+				return func() (*string, error) {
+					return nil, errors.New("TestCode")
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func(*int32) func() (*string, error) {
+				return func() (*string, error) {
+					return nil, awserr.New("Testing", "Testing", nil)
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error timeout",
+			F: func(*int32) func() (*string, error) {
+				return func() (*string, error) {
+					return nil, awserr.New("TestCode1", "TestMessage", nil)
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func(count *int32) func() (*string, error) {
+				return func() (*string, error) {
+					if atomic.AddInt32(count, 1) == 1 {
+						return nil, awserr.New("TestCode2", "TestMessage", nil)
+					}
+
+					return aws.String("ok"), nil
+				}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var count int32
+			output, err := retry.RetryWhenAWSErrCodeEquals(context.Background(), 2*time.Second, testCase.F(&count), "TestCode1", "TestCode2")
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if output != nil {
+					t.Errorf("expected nil output, got %q", *output)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if output == nil || *output != "ok" {
+					t.Errorf("unexpected output: %v", output)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryWhenAWSErrMessageContains(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	var count int32
+	output, err := retry.RetryWhenAWSErrMessageContains(context.Background(), 2*time.Second, func() (string, error) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			return "", awserr.New("TestCode", "TestMessage1", nil)
+		}
+
+		return "ok", nil
+	}, "TestCode", "TestMessage1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if output != "ok" {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
+
+func TestRetryWhenNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		F           func(*int32) func() (int, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func(*int32) func() (int, error) {
+				return func() (int, error) {
+					return 1, nil
+				}
+			},
+		},
+		{
+			Name: "other error",
+			F: func(*int32) func() (int, error) {
+				return func() (int, error) {
+					return 0, errors.New("TestCode")
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "not found error timeout",
+			F: func(*int32) func() (int, error) {
+				return func() (int, error) {
+					return 0, &retry.NotFoundError{}
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable not found error",
+			F: func(count *int32) func() (int, error) {
+				return func() (int, error) {
+					if atomic.AddInt32(count, 1) == 1 {
+						return 0, &retry.NotFoundError{}
+					}
+
+					return 1, nil
+				}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var count int32
+			output, err := retry.RetryWhenNotFound(context.Background(), 2*time.Second, testCase.F(&count))
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if output != 1 {
+					t.Errorf("unexpected output: %d", output)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryWhenNewResourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	f := func(count *int32) func() (int, error) {
+		return func() (int, error) {
+			if atomic.AddInt32(count, 1) == 1 {
+				return 0, &retry.NotFoundError{}
+			}
+
+			return 1, nil
+		}
+	}
+
+	var count int32
+	if _, err := retry.RetryWhenNewResourceNotFound(context.Background(), 2*time.Second, f(&count), true); err != nil {
+		t.Errorf("new resource: unexpected error: %s", err)
+	}
+
+	count = 0
+	if _, err := retry.RetryWhenNewResourceNotFound(context.Background(), 2*time.Second, f(&count), false); !retry.NotFound(err) {
+		t.Errorf("existing resource: unexpected error: %v", err)
+	}
+}
+
+func TestRetryUntilNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		F           func(*int32) func() (any, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func(*int32) func() (any, error) {
+				return func() (any, error) {
+					return nil, nil
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "other error",
+			F: func(*int32) func() (any, error) {
+				return func() (any, error) {
+					return nil, errors.New("TestCode")
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "not found error",
+			F: func(*int32) func() (any, error) {
+				return func() (any, error) {
+					return nil, &retry.NotFoundError{}
+				}
+			},
+		},
+		{
+			Name: "retryable not found error",
+			F: func(count *int32) func() (any, error) {
+				return func() (any, error) {
+					if atomic.AddInt32(count, 1) == 1 {
+						return nil, nil
+					}
+
+					return nil, &retry.NotFoundError{}
+				}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var count int32
+			_, err := retry.RetryUntilNotFound(context.Background(), 2*time.Second, testCase.F(&count))
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestOptionsInterval(t *testing.T) {
+	t.Parallel()
+
+	var intervals []time.Duration
+	var last time.Time
+	hook := func(context.Context, int, string, error) {
+		now := time.Now()
+		if !last.IsZero() {
+			intervals = append(intervals, now.Sub(last))
+		}
+		last = now
+	}
+
+	var count int32
+	_, err := retry.WaitUntilState(context.Background(), 5*time.Second, func(context.Context) (*testValue, string, error) {
+		if atomic.AddInt32(&count, 1) < 5 {
+			return &testValue{}, "creating", nil
+		}
+
+		return &testValue{}, "available", nil
+	}, []string{"creating"}, []string{"available"},
+		retry.WithJitter(0.5),
+		retry.WithMinPollInterval(20*time.Millisecond),
+		retry.WithMaxPollInterval(200*time.Millisecond),
+		retry.WithPollHook(hook),
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i, interval := range intervals {
+		if interval < 20*time.Millisecond {
+			t.Errorf("interval %d: %s is less than the minimum poll interval", i, interval)
+		}
+		// Allow some scheduling slack above the maximum poll interval.
+		if interval > 400*time.Millisecond {
+			t.Errorf("interval %d: %s is greater than the maximum poll interval", i, interval)
+		}
+	}
+}
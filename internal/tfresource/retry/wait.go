@@ -0,0 +1,175 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// StateRefreshFunc returns the current value and state of the resource being waited on.
+// A nil value indicates that the resource was not found.
+// An error aborts the wait.
+type StateRefreshFunc[T any] func(context.Context) (*T, string, error)
+
+// WaitUntilState waits for the value returned by `refresh` to reach one of the `target` states.
+// While the state is one of the `pending` states the wait continues; any other state is an UnexpectedStateError.
+// If `target` is empty, WaitUntilState waits for the resource to be not found.
+// The last refreshed value is returned, even on error.
+// Waits between refreshes using exponential backoff, except when waiting for the target state to reoccur.
+func WaitUntilState[T any](ctx context.Context, timeout time.Duration, refresh StateRefreshFunc[T], pending, target []string, optFns ...OptionsFunc) (*T, error) {
+	opts := newOptions(optFns)
+	deadline := time.Now().Add(timeout)
+
+	var lastValue *T
+	var lastState string
+	var notFoundTick, targetOccurence int
+
+	timeoutErr := func() error {
+		return &TimeoutError{
+			ExpectedState: target,
+			LastState:     lastState,
+			Timeout:       timeout,
+		}
+	}
+
+	if err := sleep(ctx, deadline, opts.Delay); err != nil {
+		if errors.Is(err, errDeadlineExceeded) {
+			return nil, timeoutErr()
+		}
+		return nil, err
+	}
+
+	for attempt, backoff := 1, 0; ; attempt++ {
+		value, state, err := refresh(ctx)
+		opts.poll(ctx, attempt, state, err)
+
+		if err != nil {
+			return value, err
+		}
+
+		lastValue = value
+		wait := opts.interval(backoff)
+
+		if value == nil {
+			if len(target) == 0 {
+				// Waiting for the resource to be gone.
+				targetOccurence++
+				if targetOccurence >= opts.ContinuousTargetOccurence {
+					return nil, nil
+				}
+			} else {
+				targetOccurence = 0
+				notFoundTick++
+				if notFoundTick > opts.NotFoundChecks {
+					return nil, &NotFoundError{
+						Retries: notFoundTick,
+					}
+				}
+			}
+		} else {
+			notFoundTick = 0
+			lastState = state
+
+			switch {
+			case contains(target, state):
+				targetOccurence++
+				if targetOccurence >= opts.ContinuousTargetOccurence {
+					return value, nil
+				}
+
+				// Reset the backoff while waiting for the target state to reoccur.
+				backoff = 0
+				wait = opts.interval(backoff)
+			case contains(pending, state):
+				targetOccurence = 0
+			default:
+				return value, &UnexpectedStateError{
+					ExpectedState: target,
+					State:         state,
+				}
+			}
+		}
+
+		backoff++
+
+		if err := sleep(ctx, deadline, wait); err != nil {
+			if errors.Is(err, errDeadlineExceeded) {
+				return lastValue, timeoutErr()
+			}
+			return lastValue, err
+		}
+	}
+}
+
+type boolValue struct{}
+
+// WaitUntil waits for the function `f` to return `true`.
+// If `f` returns an error, return immediately with that error.
+// If `timeout` is exceeded before `f` returns `true`, return an error.
+// Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
+func WaitUntil(ctx context.Context, timeout time.Duration, f func(context.Context) (bool, error), optFns ...OptionsFunc) error {
+	const (
+		stateFalse = "FALSE"
+		stateTrue  = "TRUE"
+	)
+
+	_, err := WaitUntilState(ctx, timeout, func(ctx context.Context) (*boolValue, string, error) {
+		done, err := f(ctx)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if done {
+			return &boolValue{}, stateTrue, nil
+		}
+
+		return &boolValue{}, stateFalse, nil
+	}, []string{stateFalse}, []string{stateTrue}, optFns...)
+
+	return err
+}
+
+var errDeadlineExceeded = errors.New("deadline exceeded")
+
+// sleep waits for the specified duration, returning errDeadlineExceeded if the deadline would be passed
+// or the Context's error if it is done first.
+func sleep(ctx context.Context, deadline time.Time, d time.Duration) error {
+	remaining := time.Until(deadline)
+
+	if remaining <= 0 {
+		return errDeadlineExceeded
+	}
+
+	timedOut := d >= remaining
+	if timedOut {
+		d = remaining
+	}
+
+	if d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if timedOut {
+		return errDeadlineExceeded
+	}
+
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
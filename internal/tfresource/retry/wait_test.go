@@ -0,0 +1,262 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource/retry"
+)
+
+type testValue struct {
+	State string
+}
+
+func TestWaitUntilState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		States      []string // One per refresh; "" means not found. The last state repeats.
+		Target      []string
+		OptFns      []retry.OptionsFunc
+		ExpectError func(error) bool
+	}{
+		{
+			Name:   "immediate target",
+			States: []string{"available"},
+			Target: []string{"available"},
+		},
+		{
+			Name:   "pending then target",
+			States: []string{"creating", "creating", "available"},
+			Target: []string{"available"},
+		},
+		{
+			Name:        "unexpected state",
+			States:      []string{"creating", "failed"},
+			Target:      []string{"available"},
+			ExpectError: isUnexpectedStateError,
+		},
+		{
+			Name:        "never reaches target",
+			States:      []string{"creating"},
+			Target:      []string{"available"},
+			ExpectError: retry.TimedOut,
+		},
+		{
+			Name:        "not found checks exceeded",
+			States:      []string{""},
+			Target:      []string{"available"},
+			OptFns:      []retry.OptionsFunc{retry.WithNotFoundChecks(2)},
+			ExpectError: retry.NotFound,
+		},
+		{
+			Name:   "not found then target",
+			States: []string{"", "", "available"},
+			Target: []string{"available"},
+			OptFns: []retry.OptionsFunc{retry.WithNotFoundChecks(2)},
+		},
+		{
+			Name:   "continuous target occurence",
+			States: []string{"available", "creating", "available", "available"},
+			Target: []string{"available"},
+			OptFns: []retry.OptionsFunc{retry.WithContinuousTargetOccurence(2)},
+		},
+		{
+			Name:        "continuous target occurence not reached",
+			States:      []string{"available", "creating"},
+			Target:      []string{"available"},
+			OptFns:      []retry.OptionsFunc{retry.WithContinuousTargetOccurence(2)},
+			ExpectError: retry.TimedOut,
+		},
+		{
+			Name:   "gone",
+			States: []string{"deleting", "deleting", ""},
+		},
+		{
+			Name:        "never gone",
+			States:      []string{"deleting"},
+			ExpectError: retry.TimedOut,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var i int
+			refresh := func(context.Context) (*testValue, string, error) {
+				state := testCase.States[i]
+				if i < len(testCase.States)-1 {
+					i++
+				}
+
+				if state == "" {
+					return nil, "", nil
+				}
+
+				return &testValue{State: state}, state, nil
+			}
+
+			optFns := append([]retry.OptionsFunc{retry.WithPollInterval(10 * time.Millisecond)}, testCase.OptFns...)
+			value, err := retry.WaitUntilState(context.Background(), 1*time.Second, refresh, []string{"creating", "deleting"}, testCase.Target, optFns...)
+
+			if testCase.ExpectError != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !testCase.ExpectError(err) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(testCase.Target) == 0 {
+				if value != nil {
+					t.Errorf("expected nil value, got %v", value)
+				}
+			} else if value == nil || value.State != testCase.Target[0] {
+				t.Errorf("unexpected value: %v", value)
+			}
+		})
+	}
+}
+
+func TestWaitUntilState_error(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test")
+	_, err := retry.WaitUntilState(context.Background(), 1*time.Second, func(context.Context) (*testValue, string, error) {
+		return nil, "", testErr
+	}, []string{"creating"}, []string{"available"})
+
+	if !errors.Is(err, testErr) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestWaitUntilState_context(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := retry.WaitUntilState(ctx, 5*time.Second, func(context.Context) (*testValue, string, error) {
+		return &testValue{}, "creating", nil
+	}, []string{"creating"}, []string{"available"})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestWaitUntilState_pollHook(t *testing.T) {
+	t.Parallel()
+
+	var attempts []int
+	var states []string
+	hook := func(_ context.Context, attempt int, state string, err error) {
+		attempts = append(attempts, attempt)
+		states = append(states, state)
+	}
+
+	var count int32
+	_, err := retry.WaitUntilState(context.Background(), 1*time.Second, func(context.Context) (*testValue, string, error) {
+		if atomic.AddInt32(&count, 1) < 3 {
+			return &testValue{}, "creating", nil
+		}
+
+		return &testValue{}, "available", nil
+	}, []string{"creating"}, []string{"available"}, retry.WithPollInterval(10*time.Millisecond), retry.WithPollHook(hook))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(attempts), 3; got != expected {
+		t.Fatalf("got %d polls, expected %d", got, expected)
+	}
+	for i, attempt := range attempts {
+		if attempt != i+1 {
+			t.Errorf("poll %d: got attempt %d", i, attempt)
+		}
+	}
+	if got, expected := states[2], "available"; got != expected {
+		t.Errorf("got last state %q, expected %q", got, expected)
+	}
+}
+
+func TestWaitUntil(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		F           func(*int32) func(context.Context) (bool, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func(*int32) func(context.Context) (bool, error) {
+				return func(context.Context) (bool, error) {
+					return true, nil
+				}
+			},
+		},
+		{
+			Name: "immediate error",
+			F: func(*int32) func(context.Context) (bool, error) {
+				return func(context.Context) (bool, error) {
+					return false, errors.New("TestCode")
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "never reaches state",
+			F: func(*int32) func(context.Context) (bool, error) {
+				return func(context.Context) (bool, error) {
+					return false, nil
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retry then success",
+			F: func(count *int32) func(context.Context) (bool, error) {
+				return func(context.Context) (bool, error) {
+					return atomic.AddInt32(count, 1) > 1, nil
+				}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var count int32
+			err := retry.WaitUntil(context.Background(), 1*time.Second, testCase.F(&count))
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func isUnexpectedStateError(err error) bool {
+	var e *retry.UnexpectedStateError
+	return errors.As(err, &e)
+}
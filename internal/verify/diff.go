@@ -7,6 +7,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-cty/cty"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -20,7 +24,11 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+//
+// Resource tags are also checked against the provider's required_tags configuration.
+// Violations of required tags configured to warn are returned as plan warnings via the Context,
+// or logged if the Context can't record them.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -30,6 +38,26 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
+	if diff.NewValueKnown("tags") {
+		errs, warnings := meta.(*conns.AWSClient).CheckRequiredTags(ctx, defaultTagsConfig.MergeTags(resourceTags))
+
+		for _, v := range warnings {
+			warning := diag.Diagnostic{
+				Summary:       "Required tags",
+				Detail:        v.Error(),
+				AttributePath: cty.GetAttrPath("tags"),
+			}
+
+			if !conns.AddPlanWarning(ctx, warning) {
+				tflog.Warn(ctx, v.Error())
+			}
+		}
+
+		if len(errs) > 0 {
+			return multierror.Append(nil, errs...)
+		}
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
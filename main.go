@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -35,6 +37,14 @@ func main() {
 		serveOpts...,
 	)
 
+	// Terraform only waits briefly for the provider to exit.
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := conns.FlushAPIMetrics(ctx); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
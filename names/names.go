@@ -43,6 +43,36 @@ const (
 	TranscribeEndpointID           = "transcribe"
 )
 
+// globalServices are the provider service packages whose resources are not associated with an AWS Region.
+// Their resources and data sources have no per-resource `region` argument.
+var globalServices = map[string]struct{}{
+	Account:                      {},
+	Budgets:                      {},
+	CE:                           {},
+	CloudFront:                   {},
+	CUR:                          {},
+	GlobalAccelerator:            {},
+	IAM:                          {},
+	"meta":                       {},
+	NetworkManager:               {},
+	Organizations:                {},
+	Pricing:                      {},
+	Route53:                      {},
+	Route53Domains:               {},
+	Route53RecoveryControlConfig: {},
+	Route53RecoveryReadiness:     {},
+	Shield:                       {},
+	WAF:                          {},
+}
+
+// IsGlobalService returns whether the resources of the specified provider service package are global,
+// i.e. are not associated with an AWS Region.
+func IsGlobalService(providerPackage string) bool {
+	_, ok := globalServices[providerPackage]
+
+	return ok
+}
+
 // Type ServiceDatum corresponds closely to columns in `names_data.csv` and are
 // described in detail in README.md.
 type ServiceDatum struct {
@@ -55,6 +85,7 @@ type ServiceDatum struct {
 	GoV2Package        string
 	HumanFriendly      string
 	ProviderNameUpper  string
+	SDKID              string
 }
 
 // serviceData key is the AWS provider service package
@@ -111,6 +142,7 @@ func readCSVIntoServiceData() error {
 			GoV2Package:        l[ColGoV2Package],
 			HumanFriendly:      l[ColHumanFriendly],
 			ProviderNameUpper:  l[ColProviderNameUpper],
+			SDKID:              l[ColSDKID],
 		}
 
 		a := []string{p}
@@ -183,6 +215,15 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// SDKID returns the service ID shared by the AWS SDKs for the specified provider service package.
+func SDKID(service string) string {
+	if v, ok := serviceData[service]; ok {
+		return v.SDKID
+	}
+
+	return ""
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+	"golang.org/x/exp/slices"
+)
+
+// registryEntry declares how existing resources of a Terraform resource type are discovered and imported.
+type registryEntry struct {
+	*intf.ResourceImport
+	TypeName string
+}
+
+type registry []registryEntry
+
+// newRegistry returns the resource types that service packages have registered for bulk import.
+// Only resource types that support import are included.
+func newRegistry(ctx context.Context, p *schema.Provider, servicePackages []intf.ServicePackage) registry {
+	var typeNames []string
+	for typeName, r := range p.ResourcesMap {
+		if r.Importer != nil {
+			typeNames = append(typeNames, typeName)
+		}
+	}
+	sort.Strings(typeNames)
+
+	var reg registry
+	for _, typeName := range typeNames {
+		for _, sp := range servicePackages {
+			if v := sp.ResourceImport(typeName); v != nil {
+				reg = append(reg, registryEntry{ResourceImport: v, TypeName: typeName})
+				break
+			}
+		}
+	}
+
+	return reg
+}
+
+// filter returns the registry entries for the specified resource types.
+func (reg registry) filter(typeNames []string) registry {
+	var filtered registry
+	for _, v := range reg {
+		if slices.Contains(typeNames, v.TypeName) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
+
+// match returns the registry entry for the resource with the specified ARN.
+// Where several entries match, the one with the longest ARN resource prefix wins.
+func (reg registry) match(v arn.ARN) (registryEntry, bool) {
+	var (
+		found bool
+		entry registryEntry
+	)
+
+	for _, e := range reg {
+		if e.ARNService == "" || e.ARNService != v.Service {
+			continue
+		}
+
+		if e.ARNResourcePrefix == "" {
+			// The ARN's resource is a bare name, e.g. an S3 bucket.
+			if strings.ContainsAny(v.Resource, ":/") {
+				continue
+			}
+		} else if !strings.HasPrefix(v.Resource, e.ARNResourcePrefix) {
+			continue
+		}
+
+		if !found || len(e.ARNResourcePrefix) > len(entry.ARNResourcePrefix) {
+			found = true
+			entry = e
+		}
+	}
+
+	return entry, found
+}
+
+// importID returns the import ID of the resource with the specified ARN.
+func (e registryEntry) importID(v arn.ARN) string {
+	if e.ImportIDIsARN {
+		return v.String()
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(v.Resource, e.ARNResourcePrefix), ":*")
+}
+
+type discoveredResource struct {
+	ImportID string
+	TypeName string
+}
+
+type discoverer struct {
+	client   *conns.AWSClient
+	registry registry
+}
+
+// discoverTagged discovers resources using the Resource Groups Tagging API.
+func (d *discoverer) discoverTagged(ctx context.Context) ([]discoveredResource, error) {
+	conn := d.client.ResourceGroupsTaggingAPIConn()
+
+	var discovered []discoveredResource
+
+	err := conn.GetResourcesPagesWithContext(ctx, &resourcegroupstaggingapi.GetResourcesInput{}, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			resourceARN, err := arn.Parse(aws.StringValue(v.ResourceARN))
+
+			if err != nil {
+				continue
+			}
+
+			e, ok := d.registry.match(resourceARN)
+
+			if !ok {
+				continue
+			}
+
+			discovered = append(discovered, discoveredResource{
+				ImportID: e.importID(resourceARN),
+				TypeName: e.TypeName,
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return discovered, nil
+}
+
+// discoverCloudControl discovers resources using the Cloud Control API.
+// Cloud Control API resource identifiers are used as import IDs.
+func (d *discoverer) discoverCloudControl(ctx context.Context) ([]discoveredResource, []error) {
+	conn := d.client.CloudControlClient()
+
+	var (
+		discovered []discoveredResource
+		errs       []error
+	)
+
+	for _, e := range d.registry {
+		if e.CloudControlTypeName == "" {
+			continue
+		}
+
+		pages := cloudcontrol.NewListResourcesPaginator(conn, &cloudcontrol.ListResourcesInput{
+			TypeName: aws_sdkv2.String(e.CloudControlTypeName),
+		})
+
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listing %s: %w", e.CloudControlTypeName, err))
+				break
+			}
+
+			for _, v := range page.ResourceDescriptions {
+				if id := aws_sdkv2.ToString(v.Identifier); id != "" {
+					discovered = append(discovered, discoveredResource{
+						ImportID: id,
+						TypeName: e.TypeName,
+					})
+				}
+			}
+		}
+	}
+
+	return discovered, errs
+}
+
+func deduplicate(discovered []discoveredResource) []discoveredResource {
+	seen := make(map[discoveredResource]bool)
+
+	var unique []discoveredResource
+	for _, v := range discovered {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+
+	return unique
+}
+
+// readResource imports and reads the resource with the specified import ID using the resource's own Importer and Read functions.
+func readResource(ctx context.Context, r *schema.Resource, importID string, meta any) (*terraform.InstanceState, error) {
+	d := r.Data(nil)
+	d.SetId(importID)
+
+	data := []*schema.ResourceData{d}
+
+	var err error
+	switch {
+	case r.Importer.StateContext != nil:
+		data, err = r.Importer.StateContext(ctx, d, meta)
+	case r.Importer.State != nil: //nolint:staticcheck // Some resources still use the deprecated field.
+		data, err = r.Importer.State(d, meta) //nolint:staticcheck
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("importing: %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("importing: no resources returned")
+	}
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, data[0].State(), meta)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading: %v", diags)
+	}
+
+	if state == nil || state.ID == "" {
+		return nil, fmt.Errorf("reading: resource not found")
+	}
+
+	return state, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+)
+
+func TestRegistryMatch(t *testing.T) {
+	t.Parallel()
+
+	reg := registry{
+		{TypeName: "aws_s3_bucket", ResourceImport: &intf.ResourceImport{ARNService: "s3"}},
+		{TypeName: "aws_cloudwatch_log_group", ResourceImport: &intf.ResourceImport{ARNService: "logs", ARNResourcePrefix: "log-group:"}},
+		{TypeName: "aws_sns_topic", ResourceImport: &intf.ResourceImport{ARNService: "sns", ImportIDIsARN: true}},
+		{TypeName: "aws_vpc", ResourceImport: &intf.ResourceImport{ARNService: "ec2", ARNResourcePrefix: "vpc/"}},
+		{TypeName: "aws_vpc_endpoint", ResourceImport: &intf.ResourceImport{ARNService: "ec2", ARNResourcePrefix: "vpc-endpoint/"}},
+	}
+
+	testCases := []struct {
+		arn          string
+		wantTypeName string
+		wantImportID string
+	}{
+		{
+			arn:          "arn:aws:s3:::my-bucket",
+			wantTypeName: "aws_s3_bucket",
+			wantImportID: "my-bucket",
+		},
+		{
+			arn: "arn:aws:s3:us-west-2:123456789012:accesspoint/example",
+		},
+		{
+			arn:          "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/example:*",
+			wantTypeName: "aws_cloudwatch_log_group",
+			wantImportID: "/aws/lambda/example",
+		},
+		{
+			arn:          "arn:aws:sns:us-west-2:123456789012:alerts",
+			wantTypeName: "aws_sns_topic",
+			wantImportID: "arn:aws:sns:us-west-2:123456789012:alerts",
+		},
+		{
+			arn:          "arn:aws:ec2:us-west-2:123456789012:vpc-endpoint/vpce-0123456789abcdef0",
+			wantTypeName: "aws_vpc_endpoint",
+			wantImportID: "vpce-0123456789abcdef0",
+		},
+		{
+			arn: "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-0123456789abcdef0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		v, err := arn.Parse(testCase.arn)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		e, ok := reg.match(v)
+
+		if got, want := ok, testCase.wantTypeName != ""; got != want {
+			t.Errorf("match(%s) = %t, want %t", testCase.arn, got, want)
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		if got, want := e.TypeName, testCase.wantTypeName; got != want {
+			t.Errorf("match(%s) type = %s, want %s", testCase.arn, got, want)
+		}
+
+		if got, want := e.importID(v), testCase.wantImportID; got != want {
+			t.Errorf("importID(%s) = %s, want %s", testCase.arn, got, want)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// emitter generates Terraform configuration and import blocks for resources.
+type emitter struct {
+	count int
+	file  *hclwrite.File
+	names map[string]int
+}
+
+func newEmitter() *emitter {
+	return &emitter{
+		file:  hclwrite.NewEmptyFile(),
+		names: make(map[string]int),
+	}
+}
+
+func (e *emitter) bytes() []byte {
+	return hclwrite.Format(e.file.Bytes())
+}
+
+// emitResource generates an import block and a resource block for the specified resource state.
+// Only arguments that can be set in configuration are generated; computed-only, deprecated and `tags_all` attributes are omitted.
+func (e *emitter) emitResource(typeName, importID string, r *schema.Resource, state *terraform.InstanceState) {
+	name := e.resourceName(typeName, importID)
+	body := e.file.Body()
+
+	if e.count > 0 {
+		body.AppendNewline()
+	}
+
+	block := body.AppendNewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: name},
+	})
+	block.Body().SetAttributeValue("id", cty.StringVal(importID))
+
+	body.AppendNewline()
+
+	d := r.Data(state)
+	values := make(map[string]interface{})
+	for k := range r.Schema {
+		values[k] = d.Get(k)
+	}
+
+	block = body.AppendNewBlock("resource", []string{typeName, name})
+	emitBody(block.Body(), r.Schema, values)
+
+	e.count++
+}
+
+// resourceName returns a unique Terraform resource name derived from the specified import ID.
+func (e *emitter) resourceName(typeName, importID string) string {
+	name := resourceName(importID)
+
+	key := typeName + "." + name
+	e.names[key]++
+
+	if n := e.names[key]; n > 1 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+
+	return name
+}
+
+// resourceName returns a valid Terraform resource name derived from the specified import ID.
+func resourceName(importID string) string {
+	if v, err := arn.Parse(importID); err == nil {
+		importID = v.Resource
+		if i := strings.LastIndexAny(importID, ":/"); i >= 0 {
+			importID = importID[i+1:]
+		}
+	}
+
+	var sb strings.Builder
+	for _, r := range strings.ToLower(importID) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	name := strings.Trim(sb.String(), "_-")
+
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	return name
+}
+
+// emitBody writes the configurable arguments in the specified values to an HCL body.
+// It returns the number of arguments and blocks written.
+func emitBody(body *hclwrite.Body, schemaMap map[string]*schema.Schema, values map[string]interface{}) int {
+	var keys []string
+	for k := range schemaMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	n := 0
+	for _, k := range keys {
+		s := schemaMap[k]
+
+		if !configurable(k, s) {
+			continue
+		}
+
+		v, ok := values[k]
+
+		if !ok || isZero(v) {
+			continue
+		}
+
+		if elem, ok := s.Elem.(*schema.Resource); ok && s.ConfigMode != schema.SchemaConfigModeAttr && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			for _, tfMapRaw := range listValues(v) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				block := hclwrite.NewBlock(k, nil)
+
+				if emitBody(block.Body(), elem.Schema, tfMap) > 0 {
+					body.AppendBlock(block)
+					n++
+				}
+			}
+
+			continue
+		}
+
+		if val, ok := ctyValue(s, v); ok {
+			body.SetAttributeValue(k, val)
+			n++
+		}
+	}
+
+	return n
+}
+
+// configurable returns whether the specified attribute can be set in configuration.
+func configurable(k string, s *schema.Schema) bool {
+	if !s.Optional && !s.Required {
+		return false
+	}
+
+	if s.Deprecated != "" {
+		return false
+	}
+
+	switch k {
+	case "id", "tags_all":
+		return false
+	}
+
+	return true
+}
+
+// ctyValue converts a Plugin SDK attribute value to a cty.Value suitable for hclwrite.
+func ctyValue(s *schema.Schema, v interface{}) (cty.Value, bool) {
+	switch s.Type {
+	case schema.TypeBool:
+		if v, ok := v.(bool); ok {
+			return cty.BoolVal(v), true
+		}
+	case schema.TypeFloat:
+		if v, ok := v.(float64); ok {
+			return cty.NumberFloatVal(v), true
+		}
+	case schema.TypeInt:
+		if v, ok := v.(int); ok {
+			return cty.NumberIntVal(int64(v)), true
+		}
+	case schema.TypeString:
+		if v, ok := v.(string); ok {
+			return cty.StringVal(v), true
+		}
+	case schema.TypeList, schema.TypeSet:
+		var vals []cty.Value
+		for _, v := range listValues(v) {
+			if val, ok := elemValue(s.Elem, v); ok {
+				vals = append(vals, val)
+			}
+		}
+
+		if len(vals) > 0 {
+			return cty.TupleVal(vals), true
+		}
+	case schema.TypeMap:
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			break
+		}
+
+		vals := make(map[string]cty.Value)
+		for k, v := range tfMap {
+			if val, ok := elemValue(s.Elem, v); ok {
+				vals[k] = val
+			}
+		}
+
+		if len(vals) > 0 {
+			return cty.ObjectVal(vals), true
+		}
+	}
+
+	return cty.NilVal, false
+}
+
+// elemValue converts a list, set or map element to a cty.Value.
+func elemValue(elem interface{}, v interface{}) (cty.Value, bool) {
+	switch elem := elem.(type) {
+	case *schema.Schema:
+		return ctyValue(elem, v)
+	case *schema.Resource:
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			return cty.NilVal, false
+		}
+
+		vals := make(map[string]cty.Value)
+		for k, s := range elem.Schema {
+			if !configurable(k, s) || isZero(tfMap[k]) {
+				continue
+			}
+
+			if val, ok := ctyValue(s, tfMap[k]); ok {
+				vals[k] = val
+			}
+		}
+
+		if len(vals) > 0 {
+			return cty.ObjectVal(vals), true
+		}
+	default:
+		return ctyValue(&schema.Schema{Type: schema.TypeString}, v)
+	}
+
+	return cty.NilVal, false
+}
+
+func listValues(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+
+	return nil
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case int:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+
+	return false
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"my-bucket":             "my-bucket",
+		"vpc-0123456789abcdef0": "vpc-0123456789abcdef0",
+		"/aws/lambda/Example":   "aws_lambda_example",
+		"123456789012":          "r_123456789012",
+		"arn:aws:sns:us-west-2:123456789012:alerts":        "alerts",
+		"arn:aws:kms:us-west-2:123456789012:key/abcd-1234": "abcd-1234",
+	}
+
+	for importID, want := range testCases {
+		if got := resourceName(importID); got != want {
+			t.Errorf("resourceName(%q) = %q, want %q", importID, got, want)
+		}
+	}
+}
+
+func TestEmitResource(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"retention": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"arn":            "arn:aws:test:us-west-2:123456789012:example",
+			"id":             "example",
+			"name":           "example",
+			"retention":      "0",
+			"rule.#":         "1",
+			"rule.0.enabled": "true",
+			"tags.%":         "1",
+			"tags.Name":      "example",
+			"tags_all.%":     "1",
+			"tags_all.Name":  "example",
+		},
+	}
+
+	e := newEmitter()
+	e.emitResource("aws_test", "example", r, state)
+	e.emitResource("aws_test", "example", r, state)
+
+	got := string(e.bytes())
+
+	for _, want := range []string{
+		"to = aws_test.example\n",
+		"to = aws_test.example_2\n",
+		`id = "example"`,
+		`resource "aws_test" "example" {`,
+		`name = "example"`,
+		"rule {\n    enabled = true\n  }",
+		`Name = "example"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated configuration does not contain %q:\n%s", want, got)
+		}
+	}
+
+	for _, notWant := range []string{"arn", "retention", "tags_all"} {
+		if strings.Contains(got, notWant) {
+			t.Errorf("generated configuration contains %q:\n%s", notWant, got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+var (
+	cloudControl = flag.Bool("cloudcontrol", true, "Discover resources using the Cloud Control API")
+	profile      = flag.String("profile", "", "AWS shared configuration profile")
+	region       = flag.String("region", "", "AWS Region")
+	tagging      = flag.Bool("tagging", true, "Discover resources using the Resource Groups Tagging API")
+	types        = flag.String("types", "", "Comma-separated list of resource types to discover (default all supported)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\timportgen [-region <region>] [-profile <profile>] [-types <resource-type>,...] [-cloudcontrol=false] [-tagging=false] <generated-file>\n\n")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+
+	if len(args) < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	outputFilename := args[0]
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		g.Fatalf(err.Error())
+	}
+
+	providerConfig := map[string]interface{}{}
+	if v := *profile; v != "" {
+		providerConfig["profile"] = v
+	}
+	if v := *region; v != "" {
+		providerConfig["region"] = v
+	}
+
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(providerConfig)); diags.HasError() {
+		g.Fatalf("configuring provider: %v", diags)
+	}
+
+	client := p.Meta().(*conns.AWSClient)
+
+	registry := newRegistry(ctx, p, client.ServicePackages)
+
+	if v := *types; v != "" {
+		registry = registry.filter(strings.Split(v, ","))
+	}
+
+	g.Infof("Discovering %d resource types in %s", len(registry), client.Region)
+
+	d := &discoverer{
+		client:   client,
+		registry: registry,
+	}
+
+	var discovered []discoveredResource
+
+	if *tagging {
+		v, err := d.discoverTagged(ctx)
+
+		if err != nil {
+			g.Fatalf("discovering resources with the Resource Groups Tagging API: %s", err)
+		}
+
+		discovered = append(discovered, v...)
+	}
+
+	if *cloudControl {
+		v, errs := d.discoverCloudControl(ctx)
+
+		for _, err := range errs {
+			g.Warnf("discovering resources with the Cloud Control API: %s", err)
+		}
+
+		discovered = append(discovered, v...)
+	}
+
+	discovered = deduplicate(discovered)
+
+	sort.Slice(discovered, func(i, j int) bool {
+		if discovered[i].TypeName != discovered[j].TypeName {
+			return discovered[i].TypeName < discovered[j].TypeName
+		}
+		return discovered[i].ImportID < discovered[j].ImportID
+	})
+
+	g.Infof("Reading %d resources", len(discovered))
+
+	e := newEmitter()
+
+	for _, v := range discovered {
+		r := p.ResourcesMap[v.TypeName]
+		state, err := readResource(ctx, r, v.ImportID, client)
+
+		if err != nil {
+			g.Warnf("%s (%s): %s", v.TypeName, v.ImportID, err)
+			continue
+		}
+
+		e.emitResource(v.TypeName, v.ImportID, r, state)
+	}
+
+	g.Infof("Generating %d resources into %q", e.count, outputFilename)
+
+	f := g.NewUnformattedFileDestination(outputFilename)
+
+	if err := f.WriteBytes(e.bytes()); err != nil {
+		g.Fatalf("generating file (%s): %s", outputFilename, err)
+	}
+
+	if err := f.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", outputFilename, err)
+	}
+}